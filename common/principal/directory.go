@@ -0,0 +1,129 @@
+package principal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/organizationmanager/v1/saml"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const listPageSize = 1000
+
+// directory looks principals up by their human-readable attributes.
+// All methods return errors wrapping ErrNotFound if the principal does not exist.
+type directory interface {
+	// organizationUsers returns canonical members of the organization users by their e-mails.
+	organizationUsers(ctx context.Context, organizationID string) (map[string]string, error)
+	userAccountByLogin(ctx context.Context, login string) (string, error)
+	federationID(ctx context.Context, organizationID, name string) (string, error)
+	federatedUserID(ctx context.Context, federationID, nameID string) (string, error)
+	groupID(ctx context.Context, organizationID, name string) (string, error)
+	serviceAccountID(ctx context.Context, folderID, name string) (string, error)
+}
+
+type sdkDirectory struct {
+	sdk *ycsdk.SDK
+}
+
+func (d *sdkDirectory) organizationUsers(ctx context.Context, organizationID string) (map[string]string, error) {
+	users := map[string]string{}
+	pageToken := ""
+	for {
+		resp, err := d.sdk.OrganizationManager().User().ListMembers(ctx, &organizationmanager.ListMembersRequest{
+			OrganizationId: organizationID,
+			PageSize:       listPageSize,
+			PageToken:      pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing users of organization %q: %w", organizationID, err)
+		}
+
+		for _, user := range resp.Users {
+			claims := user.GetSubjectClaims()
+			if claims.GetEmail() == "" {
+				continue
+			}
+			memberType := TypeUserAccount
+			if claims.GetFederation() != nil {
+				memberType = TypeFederatedUser
+			}
+			users[claims.GetEmail()] = memberType + ":" + claims.GetSub()
+		}
+
+		if resp.NextPageToken == "" {
+			return users, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (d *sdkDirectory) userAccountByLogin(ctx context.Context, login string) (string, error) {
+	account, err := d.sdk.IAM().YandexPassportUserAccount().GetByLogin(ctx, &iam.GetUserAccountByLoginRequest{
+		Login: login,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", fmt.Errorf("%w: user with login %q", ErrNotFound, login)
+		}
+		return "", fmt.Errorf("error getting user with login %q: %w", login, err)
+	}
+	return account.Id, nil
+}
+
+func (d *sdkDirectory) federationID(ctx context.Context, organizationID, name string) (string, error) {
+	return d.resolve(ctx, sdkresolvers.OrganizationSamlFederationResolver(name, sdkresolvers.OrganizationID(organizationID)))
+}
+
+func (d *sdkDirectory) federatedUserID(ctx context.Context, federationID, nameID string) (string, error) {
+	pageToken := ""
+	for {
+		resp, err := d.sdk.OrganizationManagerSAML().Federation().ListUserAccounts(ctx, &saml.ListFederatedUserAccountsRequest{
+			FederationId: federationID,
+			PageSize:     listPageSize,
+			PageToken:    pageToken,
+		})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return "", fmt.Errorf("%w: federation %q", ErrNotFound, federationID)
+			}
+			return "", fmt.Errorf("error listing users of federation %q: %w", federationID, err)
+		}
+
+		for _, account := range resp.UserAccounts {
+			if account.GetSamlUserAccount().GetNameId() == nameID {
+				return account.Id, nil
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			return "", fmt.Errorf("%w: user with name ID %q in federation %q", ErrNotFound, nameID, federationID)
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
+func (d *sdkDirectory) groupID(ctx context.Context, organizationID, name string) (string, error) {
+	return d.resolve(ctx, sdkresolvers.OrganizationGroupResolver(name, sdkresolvers.OrganizationID(organizationID)))
+}
+
+func (d *sdkDirectory) serviceAccountID(ctx context.Context, folderID, name string) (string, error) {
+	return d.resolve(ctx, sdkresolvers.ServiceAccountResolver(name, sdkresolvers.FolderID(folderID)))
+}
+
+func (d *sdkDirectory) resolve(ctx context.Context, resolver ycsdk.Resolver) (string, error) {
+	if err := resolver.Run(ctx, d.sdk); err != nil {
+		var notFound *sdkresolvers.ErrNotFound
+		if errors.As(err, &notFound) {
+			return "", fmt.Errorf("%w: %s", ErrNotFound, notFound.Error())
+		}
+		return "", err
+	}
+	return resolver.ID(), nil
+}
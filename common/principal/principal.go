@@ -0,0 +1,270 @@
+// Package principal resolves IAM principals given in a human-readable form, like an e-mail or
+// a service account name, to the canonical {type}:{id} members used in access bindings.
+package principal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+const (
+	TypeEmail          = "email"
+	TypeFederatedUser  = "federatedUser"
+	TypeGroup          = "group"
+	TypeServiceAccount = "serviceAccount"
+	TypeUserAccount    = "userAccount"
+)
+
+// ErrNotFound is returned when a principal does not exist.
+var ErrNotFound = errors.New("principal not found")
+
+// idRegexp matches resource identifiers, e.g. of groups, which are never resolved by name.
+var idRegexp = regexp.MustCompile(`^[a-z][a-z0-9]{19}$`)
+
+// Resolver resolves members in the following forms to canonical ones:
+//
+//   - email:<address> - a user of the organization or a Yandex Passport user with the address;
+//   - federatedUser:<federation>/<name_id> - a user of the federation, given by name or ID, with the name ID;
+//   - group:<name> - a group of the organization with the name;
+//   - serviceAccount:<folder_id>/<name> - a service account of the folder with the name.
+//
+// Other members are considered canonical. Resolved members are cached for the lifetime of the resolver.
+type Resolver struct {
+	directory      directory
+	organizationID string
+
+	mu    sync.Mutex
+	cache map[string]string
+	users map[string]string
+}
+
+// NewResolver returns a resolver looking principals up with the SDK. Users and groups given
+// by e-mail or name are searched for in the organization, if it is not empty.
+func NewResolver(sdk *ycsdk.SDK, organizationID string) *Resolver {
+	return newResolver(&sdkDirectory{sdk: sdk}, organizationID)
+}
+
+func newResolver(directory directory, organizationID string) *Resolver {
+	return &Resolver{
+		directory:      directory,
+		organizationID: organizationID,
+		cache:          map[string]string{},
+	}
+}
+
+// NeedsResolution reports whether the member is not in the canonical form.
+func NeedsResolution(member string) bool {
+	memberType, id, ok := strings.Cut(member, ":")
+	if !ok {
+		return false
+	}
+
+	switch memberType {
+	case TypeEmail:
+		return true
+	case TypeFederatedUser, TypeServiceAccount:
+		return strings.Contains(id, "/")
+	case TypeGroup:
+		return !idRegexp.MatchString(id)
+	}
+	return false
+}
+
+// Validate checks that the member is either canonical or in one of the forms the resolver understands.
+func Validate(member string) error {
+	memberType, id, ok := strings.Cut(member, ":")
+	if !ok || memberType == "" || id == "" {
+		return fmt.Errorf("member %q should be in TYPE:ID format", member)
+	}
+
+	switch memberType {
+	case TypeEmail:
+		if local, domain, ok := strings.Cut(id, "@"); !ok || local == "" || domain == "" {
+			return fmt.Errorf("member %q should be in email:<address> format", member)
+		}
+	case TypeFederatedUser:
+		if parent, name, ok := strings.Cut(id, "/"); ok && (parent == "" || name == "") {
+			return fmt.Errorf("member %q should be in federatedUser:<id> or federatedUser:<federation>/<name_id> format", member)
+		}
+	case TypeServiceAccount:
+		if parent, name, ok := strings.Cut(id, "/"); ok && (parent == "" || name == "") {
+			return fmt.Errorf("member %q should be in serviceAccount:<id> or serviceAccount:<folder_id>/<name> format", member)
+		}
+	}
+	return nil
+}
+
+// Resolve returns the canonical form of the member. Errors wrap ErrNotFound if the principal does not exist.
+func (r *Resolver) Resolve(ctx context.Context, member string) (string, error) {
+	if !NeedsResolution(member) {
+		return member, nil
+	}
+	if r == nil {
+		return "", fmt.Errorf("unable to resolve member %q: provider is not configured", member)
+	}
+
+	// the lock is only held around the cache, so that lookups of different members don't wait
+	// for each other, the same member may be looked up more than once concurrently
+	r.mu.Lock()
+	canonical, ok := r.cache[member]
+	r.mu.Unlock()
+	if ok {
+		return canonical, nil
+	}
+
+	memberType, id, _ := strings.Cut(member, ":")
+
+	var err error
+	switch memberType {
+	case TypeEmail:
+		canonical, err = r.resolveEmail(ctx, id)
+	case TypeFederatedUser:
+		canonical, err = r.resolveFederatedUser(ctx, id)
+	case TypeGroup:
+		canonical, err = r.resolveGroup(ctx, id)
+	case TypeServiceAccount:
+		canonical, err = r.resolveServiceAccount(ctx, id)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve member %q: %w", member, err)
+	}
+
+	r.mu.Lock()
+	r.cache[member] = canonical
+	r.mu.Unlock()
+	return canonical, nil
+}
+
+// ResolveAll maps every member to its canonical form. Members of principals which do not exist
+// are left out of the result if ignoreNotFound is set, otherwise an error is returned.
+func (r *Resolver) ResolveAll(ctx context.Context, members []string, ignoreNotFound bool) (map[string]string, error) {
+	resolved := make(map[string]string, len(members))
+	for _, member := range members {
+		canonical, err := r.Resolve(ctx, member)
+		if err != nil {
+			if ignoreNotFound && errors.Is(err, ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		resolved[member] = canonical
+	}
+	return resolved, nil
+}
+
+// Restore replaces canonical members, as returned by the API, with the members resolved to them,
+// so that the form given in the configuration is kept in the state and produces no diff.
+func Restore(canonical []string, resolved map[string]string) []string {
+	given := make(map[string]string, len(resolved))
+	for member, c := range resolved {
+		if member == c {
+			given[c] = member
+			continue
+		}
+		if _, ok := given[c]; !ok {
+			given[c] = member
+		}
+	}
+
+	result := make([]string, 0, len(canonical))
+	for _, c := range canonical {
+		if member, ok := given[c]; ok {
+			result = append(result, member)
+			continue
+		}
+		result = append(result, c)
+	}
+	return result
+}
+
+func (r *Resolver) resolveEmail(ctx context.Context, email string) (string, error) {
+	if r.organizationID != "" {
+		users, err := r.organizationUsers(ctx)
+		if err != nil {
+			return "", err
+		}
+		if member, ok := users[strings.ToLower(email)]; ok {
+			return member, nil
+		}
+	}
+
+	id, err := r.directory.userAccountByLogin(ctx, email)
+	if err != nil {
+		return "", err
+	}
+	return TypeUserAccount + ":" + id, nil
+}
+
+// organizationUsers returns the members of the organization users by lowercase e-mail, they are
+// listed once for the lifetime of the resolver.
+func (r *Resolver) organizationUsers(ctx context.Context) (map[string]string, error) {
+	r.mu.Lock()
+	users := r.users
+	r.mu.Unlock()
+	if users != nil {
+		return users, nil
+	}
+
+	listed, err := r.directory.organizationUsers(ctx, r.organizationID)
+	if err != nil {
+		return nil, err
+	}
+	users = make(map[string]string, len(listed))
+	for address, member := range listed {
+		users[strings.ToLower(address)] = member
+	}
+
+	r.mu.Lock()
+	r.users = users
+	r.mu.Unlock()
+	return users, nil
+}
+
+func (r *Resolver) resolveFederatedUser(ctx context.Context, id string) (string, error) {
+	federation, nameID, _ := strings.Cut(id, "/")
+
+	federationID := federation
+	if r.organizationID != "" {
+		resolvedID, err := r.directory.federationID(ctx, r.organizationID, federation)
+		switch {
+		case err == nil:
+			federationID = resolvedID
+		case !errors.Is(err, ErrNotFound):
+			return "", err
+		}
+	}
+
+	userID, err := r.directory.federatedUserID(ctx, federationID, nameID)
+	if err != nil {
+		return "", err
+	}
+	return TypeFederatedUser + ":" + userID, nil
+}
+
+func (r *Resolver) resolveGroup(ctx context.Context, name string) (string, error) {
+	if r.organizationID == "" {
+		return "", fmt.Errorf("organization_id should be set in the provider configuration to resolve groups by name")
+	}
+
+	id, err := r.directory.groupID(ctx, r.organizationID, name)
+	if err != nil {
+		return "", err
+	}
+	return TypeGroup + ":" + id, nil
+}
+
+func (r *Resolver) resolveServiceAccount(ctx context.Context, id string) (string, error) {
+	folderID, name, _ := strings.Cut(id, "/")
+
+	accountID, err := r.directory.serviceAccountID(ctx, folderID, name)
+	if err != nil {
+		return "", err
+	}
+	return TypeServiceAccount + ":" + accountID, nil
+}
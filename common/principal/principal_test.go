@@ -0,0 +1,292 @@
+package principal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+type testDirectory struct {
+	users           map[string]string
+	logins          map[string]string
+	federations     map[string]string
+	federatedUsers  map[string]string
+	groups          map[string]string
+	serviceAccounts map[string]string
+
+	calls int
+}
+
+func lookup(m map[string]string, key string) (string, error) {
+	if v, ok := m[key]; ok {
+		return v, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrNotFound, key)
+}
+
+func (d *testDirectory) organizationUsers(_ context.Context, _ string) (map[string]string, error) {
+	d.calls++
+	return d.users, nil
+}
+
+func (d *testDirectory) userAccountByLogin(_ context.Context, login string) (string, error) {
+	d.calls++
+	return lookup(d.logins, login)
+}
+
+func (d *testDirectory) federationID(_ context.Context, _, name string) (string, error) {
+	d.calls++
+	return lookup(d.federations, name)
+}
+
+func (d *testDirectory) federatedUserID(_ context.Context, federationID, nameID string) (string, error) {
+	d.calls++
+	return lookup(d.federatedUsers, federationID+"/"+nameID)
+}
+
+func (d *testDirectory) groupID(_ context.Context, _, name string) (string, error) {
+	d.calls++
+	return lookup(d.groups, name)
+}
+
+func (d *testDirectory) serviceAccountID(_ context.Context, folderID, name string) (string, error) {
+	d.calls++
+	return lookup(d.serviceAccounts, folderID+"/"+name)
+}
+
+func newTestDirectory() *testDirectory {
+	return &testDirectory{
+		users: map[string]string{
+			"Alice@corp.example": "federatedUser:aje00000000000000alice",
+		},
+		logins: map[string]string{
+			"bob@yandex.ru": "aje000000000000000bob",
+		},
+		federations: map[string]string{
+			"corp": "bpf0000000000000corp",
+		},
+		federatedUsers: map[string]string{
+			"bpf0000000000000corp/carol@corp.example": "aje0000000000000carol",
+		},
+		groups: map[string]string{
+			"admins": "aje00000000000admins",
+		},
+		serviceAccounts: map[string]string{
+			"b1g0000000000000folder/deployer": "aje00000000deployer",
+		},
+	}
+}
+
+func TestNeedsResolution(t *testing.T) {
+	cases := map[string]bool{
+		"userAccount:aje000000000000000bob":            false,
+		"system:allUsers":                              false,
+		"email:bob@yandex.ru":                          true,
+		"federatedUser:aje0000000000000carol":          false,
+		"federatedUser:corp/carol@corp.example":        true,
+		"group:aje00000000000admins":                   false,
+		"group:admins":                                 true,
+		"serviceAccount:aje00000000deployer":           false,
+		"serviceAccount:b1g0000000000000folder/deploy": true,
+	}
+
+	for member, expected := range cases {
+		if actual := NeedsResolution(member); actual != expected {
+			t.Errorf("NeedsResolution(%q) = %v, expected %v", member, actual, expected)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := []string{
+		"userAccount:aje000000000000000bob",
+		"email:bob@yandex.ru",
+		"federatedUser:corp/carol@corp.example",
+		"serviceAccount:b1g0000000000000folder/deployer",
+		"group:admins",
+	}
+	invalid := []string{
+		"bob",
+		"userAccount:",
+		"email:bob",
+		"email:@yandex.ru",
+		"federatedUser:/carol",
+		"serviceAccount:b1g0000000000000folder/",
+	}
+
+	for _, member := range valid {
+		if err := Validate(member); err != nil {
+			t.Errorf("Validate(%q) returned unexpected error: %s", member, err)
+		}
+	}
+	for _, member := range invalid {
+		if err := Validate(member); err == nil {
+			t.Errorf("Validate(%q) expected to fail", member)
+		}
+	}
+}
+
+func TestResolverResolve(t *testing.T) {
+	cases := map[string]string{
+		"userAccount:aje000000000000000bob":                     "userAccount:aje000000000000000bob",
+		"email:alice@corp.example":                              "federatedUser:aje00000000000000alice",
+		"email:bob@yandex.ru":                                   "userAccount:aje000000000000000bob",
+		"federatedUser:corp/carol@corp.example":                 "federatedUser:aje0000000000000carol",
+		"federatedUser:bpf0000000000000corp/carol@corp.example": "federatedUser:aje0000000000000carol",
+		"group:admins":                                          "group:aje00000000000admins",
+		"serviceAccount:b1g0000000000000folder/deployer":        "serviceAccount:aje00000000deployer",
+	}
+
+	r := newResolver(newTestDirectory(), "bpf00000000000000org")
+	for member, expected := range cases {
+		actual, err := r.Resolve(context.Background(), member)
+		if err != nil {
+			t.Errorf("Resolve(%q) returned unexpected error: %s", member, err)
+			continue
+		}
+		if actual != expected {
+			t.Errorf("Resolve(%q) = %q, expected %q", member, actual, expected)
+		}
+	}
+}
+
+func TestResolverResolveNotFound(t *testing.T) {
+	r := newResolver(newTestDirectory(), "bpf00000000000000org")
+
+	for _, member := range []string{
+		"email:nobody@corp.example",
+		"federatedUser:corp/nobody",
+		"group:nobody",
+		"serviceAccount:b1g0000000000000folder/nobody",
+	} {
+		_, err := r.Resolve(context.Background(), member)
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("Resolve(%q) expected to return ErrNotFound, got: %v", member, err)
+		}
+	}
+}
+
+func TestResolverResolveGroupWithoutOrganization(t *testing.T) {
+	r := newResolver(newTestDirectory(), "")
+
+	_, err := r.Resolve(context.Background(), "group:admins")
+	if err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a configuration error, got: %v", err)
+	}
+}
+
+func TestResolverCache(t *testing.T) {
+	d := newTestDirectory()
+	r := newResolver(d, "bpf00000000000000org")
+
+	for i := 0; i < 3; i++ {
+		if _, err := r.Resolve(context.Background(), "email:alice@corp.example"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if _, err := r.Resolve(context.Background(), "serviceAccount:b1g0000000000000folder/deployer"); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if d.calls != 2 {
+		t.Errorf("expected principals to be looked up once, got %d calls", d.calls)
+	}
+}
+
+func TestResolverResolveAll(t *testing.T) {
+	r := newResolver(newTestDirectory(), "bpf00000000000000org")
+	members := []string{"email:alice@corp.example", "group:nobody", "system:allUsers"}
+
+	_, err := r.ResolveAll(context.Background(), members, false)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got: %v", err)
+	}
+
+	resolved, err := r.ResolveAll(context.Background(), members, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := map[string]string{
+		"email:alice@corp.example": "federatedUser:aje00000000000000alice",
+		"system:allUsers":          "system:allUsers",
+	}
+	if !reflect.DeepEqual(resolved, expected) {
+		t.Errorf("ResolveAll() = %v, expected %v", resolved, expected)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	resolved := map[string]string{
+		"email:alice@corp.example":                       "federatedUser:aje00000000000000alice",
+		"serviceAccount:b1g0000000000000folder/deployer": "serviceAccount:aje00000000deployer",
+		"serviceAccount:aje00000000deployer":             "serviceAccount:aje00000000deployer",
+	}
+	canonical := []string{
+		"federatedUser:aje00000000000000alice",
+		"serviceAccount:aje00000000deployer",
+		"userAccount:aje000000000000000bob",
+	}
+
+	actual := Restore(canonical, resolved)
+	sort.Strings(actual)
+	expected := []string{
+		"email:alice@corp.example",
+		"serviceAccount:aje00000000deployer",
+		"userAccount:aje000000000000000bob",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Restore() = %v, expected %v", actual, expected)
+	}
+}
+
+type blockingDirectory struct {
+	*testDirectory
+	started chan struct{}
+	release chan struct{}
+}
+
+func (d *blockingDirectory) serviceAccountID(ctx context.Context, folderID, name string) (string, error) {
+	close(d.started)
+	<-d.release
+	return d.testDirectory.serviceAccountID(ctx, folderID, name)
+}
+
+func TestResolverResolveDoesNotBlockOtherMembers(t *testing.T) {
+	d := &blockingDirectory{
+		testDirectory: newTestDirectory(),
+		started:       make(chan struct{}),
+		release:       make(chan struct{}),
+	}
+	r := newResolver(d, "bpf00000000000000org")
+
+	errs := make(chan error, 1)
+	go func() {
+		_, err := r.Resolve(context.Background(), "serviceAccount:b1g0000000000000folder/deployer")
+		errs <- err
+	}()
+	<-d.started
+
+	resolved := make(chan error, 1)
+	go func() {
+		_, err := r.Resolve(context.Background(), "group:admins")
+		resolved <- err
+	}()
+
+	select {
+	case err := <-resolved:
+		if err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("resolving a member waits for the lookup of another one")
+	}
+
+	close(d.release)
+	if err := <-errs; err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
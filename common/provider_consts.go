@@ -6,8 +6,12 @@ const (
 	DefaultStorageEndpoint = "storage.yandexcloud.net"
 	DefaultYMQEndpoint     = "message-queue.api.cloud.yandex.net"
 	DefaultRegion          = "ru-central1"
+
+	DefaultSecurityGroupRulesCheck = "warn"
 )
 
+var SecurityGroupRulesCheckModes = []string{"off", "warn", "error"}
+
 var Descriptions = map[string]string{
 	"endpoint": "The API endpoint for Yandex.Cloud SDK client.",
 
@@ -52,4 +56,7 @@ var Descriptions = map[string]string{
 	"shared_credentials_file": "Path to shared credentials file.",
 
 	"profile": "Profile to use in the shared credentials file. Default value is `default`.",
+
+	"security_group_rules_check": "How to report duplicated, shadowed or invalid security group rules found during plan: \n" +
+		"`off`, `warn` or `error`. Default value is `" + DefaultSecurityGroupRulesCheck + "`.",
 }
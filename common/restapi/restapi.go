@@ -0,0 +1,159 @@
+// Package restapi calls the REST API of the services that are not available in the pinned go-genproto
+// version yet. The REST API is served by the same endpoints as the gRPC one, and errors are converted
+// to gRPC statuses, so that callers may handle them the same way for both.
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Client is safe for concurrent use, a single one is shared by all resources of the provider,
+// so that connections to the API are reused.
+type Client struct {
+	sdk        *ycsdk.SDK
+	httpClient *http.Client
+	userAgent  string
+	plaintext  bool
+}
+
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewClient(sdk *ycsdk.SDK, userAgent string, plaintext, insecure bool) *Client {
+	return &Client{
+		sdk:       sdk,
+		userAgent: userAgent,
+		plaintext: plaintext,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: insecure},
+			},
+		},
+	}
+}
+
+func (c *Client) baseURL(ctx context.Context, serviceID ycsdk.Endpoint) (string, error) {
+	ep, ok := c.sdk.Endpoint(serviceID)
+	if !ok {
+		// endpoints are discovered on the first gRPC call
+		if _, err := c.sdk.CreateIAMToken(ctx); err != nil {
+			return "", err
+		}
+		if ep, ok = c.sdk.Endpoint(serviceID); !ok {
+			return "", fmt.Errorf("API endpoint for %q service is not known", serviceID)
+		}
+	}
+
+	scheme := "https"
+	if c.plaintext {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s", scheme, strings.TrimSuffix(ep.Address, ":443")), nil
+}
+
+// Do calls the REST API of the given service, encoding body and decoding the response into result
+// as JSON, any of them can be nil.
+func (c *Client) Do(ctx context.Context, serviceID ycsdk.Endpoint, method, path string, query url.Values, body, result interface{}) error {
+	if c == nil {
+		return fmt.Errorf("provider is not configured")
+	}
+
+	baseURL, err := c.baseURL(ctx, serviceID)
+	if err != nil {
+		return err
+	}
+
+	u := baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error encoding request body: %s", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+
+	token, err := c.sdk.CreateIAMToken(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting IAM token: %s", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token.IamToken)
+	req.Header.Set("Content-Type", "application/json")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	log.Printf("[DEBUG] %s %s", method, u)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response of %s %s: %s", method, path, err)
+	}
+
+	if resp.StatusCode >= 300 {
+		return StatusError(resp.StatusCode, data)
+	}
+
+	if result == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("error decoding response of %s %s: %s", method, path, err)
+	}
+	return nil
+}
+
+// StatusError converts an error response of the REST API to a gRPC status. The code of the response body
+// is used if there is one, the HTTP status code is mapped otherwise.
+func StatusError(httpCode int, data []byte) error {
+	var apiErr apiError
+	if err := json.Unmarshal(data, &apiErr); err == nil && apiErr.Code != 0 {
+		return status.Error(codes.Code(apiErr.Code), apiErr.Message)
+	}
+
+	code := codes.Unknown
+	switch httpCode {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		code = codes.Unauthenticated
+	case http.StatusForbidden:
+		code = codes.PermissionDenied
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	}
+	return status.Error(code, strings.TrimSpace(string(data)))
+}
@@ -0,0 +1,187 @@
+package accessbinding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/math"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// genericIAMUpdater manages access bindings of a resource of any type from the registry.
+// Only UpdateAccessBindings is used, since not every service supports SetAccessBindings.
+type genericIAMUpdater struct {
+	ResourceType   string
+	ResourceId     string
+	ProviderConfig *provider_config.Config
+
+	nameSuffix string
+}
+
+// NewAccessBinding returns the yandex_iam_access_binding resource.
+func NewAccessBinding() resource.Resource {
+	return NewIamBinding(&genericIAMUpdater{nameSuffix: "iam_access_binding"})
+}
+
+// NewAccessMember returns the yandex_iam_access_member resource.
+func NewAccessMember() resource.Resource {
+	return NewIamMember(&genericIAMUpdater{nameSuffix: "iam_access_member"})
+}
+
+func (u *genericIAMUpdater) client() (AccessBindingsClient, error) {
+	return GetAccessBindingsClient(u.ProviderConfig.SDK, u.ResourceType)
+}
+
+func (u *genericIAMUpdater) GetResourceIamPolicy(ctx context.Context) (*Policy, error) {
+	client, err := u.client()
+	if err != nil {
+		return nil, err
+	}
+
+	var bindings []*access.AccessBinding
+	pageToken := ""
+
+	for {
+		resp, err := client.ListAccessBindings(ctx, &access.ListAccessBindingsRequest{
+			ResourceId: u.ResourceId,
+			PageSize:   DefaultPageSize,
+			PageToken:  pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		bindings = append(bindings, resp.AccessBindings...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+
+		pageToken = resp.NextPageToken
+	}
+	return &Policy{Bindings: bindings}, nil
+}
+
+func (u *genericIAMUpdater) SetResourceIamPolicy(ctx context.Context, policy *Policy) error {
+	current, err := u.GetResourceIamPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	deltas := policyDeltas(current.Bindings, policy.Bindings)
+	if len(deltas) == 0 {
+		return nil
+	}
+
+	return u.UpdateResourceIamPolicy(ctx, &PolicyDelta{Deltas: deltas})
+}
+
+func (u *genericIAMUpdater) UpdateResourceIamPolicy(ctx context.Context, policy *PolicyDelta) error {
+	client, err := u.client()
+	if err != nil {
+		return err
+	}
+
+	var (
+		bSize  = 1000
+		deltas = policy.Deltas
+		dLen   = len(deltas)
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, provider_config.DefaultTimeout)
+	defer cancel()
+
+	for i := 0; i < CountBatches(dLen, bSize); i++ {
+		req := &access.UpdateAccessBindingsRequest{
+			ResourceId:          u.ResourceId,
+			AccessBindingDeltas: deltas[i*bSize : math.Min((i+1)*bSize, dLen)],
+		}
+
+		op, err := u.ProviderConfig.SDK.WrapOperation(client.UpdateAccessBindings(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("error updating access bindings of %s: %w", u.DescribeResource(), err)
+		}
+	}
+
+	return nil
+}
+
+func (u *genericIAMUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-%s-%s", u.ResourceType, u.ResourceId)
+}
+
+func (u *genericIAMUpdater) DescribeResource() string {
+	return fmt.Sprintf("%s '%s'", u.ResourceType, u.ResourceId)
+}
+
+func (u *genericIAMUpdater) GetNameSuffix() string {
+	return u.nameSuffix
+}
+
+func (u *genericIAMUpdater) GetSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"resource_type": schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			Validators:    []validator.String{stringvalidator.OneOf(ResourceTypes()...)},
+		},
+		u.GetIdAlias(): schema.StringAttribute{
+			Required:      true,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		},
+	}
+}
+
+func (u *genericIAMUpdater) GetIdAlias() string {
+	return "resource_id"
+}
+
+func (u *genericIAMUpdater) GetId() string {
+	return u.ResourceId
+}
+
+func (u *genericIAMUpdater) GetIdAttributes() []string {
+	return []string{"resource_type", u.GetIdAlias()}
+}
+
+func (u *genericIAMUpdater) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. "+
+				"Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	u.ProviderConfig = providerConfig
+}
+
+func (u *genericIAMUpdater) Initialize(ctx context.Context, state Extractable, diag *diag.Diagnostics) {
+	var resourceType, id types.String
+
+	diag.Append(state.GetAttribute(ctx, path.Root("resource_type"), &resourceType)...)
+	diag.Append(state.GetAttribute(ctx, path.Root(u.GetIdAlias()), &id)...)
+	u.ResourceType = resourceType.ValueString()
+	u.ResourceId = id.ValueString()
+}
@@ -3,7 +3,6 @@ package accessbinding
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -12,15 +11,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
 	"golang.org/x/exp/maps"
 )
 
 type bindingResource struct {
 	ResourceUpdater ResourceIamUpdater
+
+	principals *principal.Resolver
 }
 
 func NewIamBinding(updater ResourceIamUpdater) resource.Resource {
-	return &bindingResource{updater}
+	return &bindingResource{ResourceUpdater: updater}
 }
 
 func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -30,7 +32,11 @@ func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	policies := getResourceIamBindings(ctx, req.Plan, &resp.Diagnostics)
+	policies := r.getResourceIamBindings(ctx, req.Plan, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(ep *Policy) error {
 		// Creating a binding does not remove existing members if they are not in the provided members list.
 		// This prevents removing existing permission without the user's knowledge.
@@ -56,7 +62,12 @@ func (r *bindingResource) RefreshBindingState(ctx context.Context, req Extractab
 	var role types.String
 	diag.Append(req.GetAttribute(ctx, path.Root("role"), &role)...)
 
-	eBindings := getResourceIamBindings(ctx, req, &diag)
+	members := getResourceIamMembers(ctx, req, &diag)
+	resolved := resolveMembers(ctx, r.principals, members, true, &diag)
+	if diag.HasError() {
+		return
+	}
+	eBindings := membersToBindings(role.ValueString(), members, resolved)
 
 	policy, err := r.ResourceUpdater.GetResourceIamPolicy(ctx)
 	if err != nil {
@@ -95,11 +106,12 @@ func (r *bindingResource) RefreshBindingState(ctx context.Context, req Extractab
 		return
 	}
 
-	mBindingsSet, diags := types.SetValueFrom(ctx, types.StringType, roleToMembersList(role.ValueString(), mBindings))
+	mBindingsSet, diags := types.SetValueFrom(ctx, types.StringType, principal.Restore(roleToMembersList(role.ValueString(), mBindings), resolved))
 	diag.Append(diags...)
 	diag.Append(resp.SetAttribute(ctx, path.Root("members"), mBindingsSet)...)
 	diag.Append(resp.SetAttribute(ctx, path.Root("role"), role)...)
 	diag.Append(resp.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), r.ResourceUpdater.GetId())...)
+	copyIdAttributes(ctx, r.ResourceUpdater, req, resp, &diag)
 }
 
 func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -115,7 +127,10 @@ func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
-	bindings := getResourceIamBindings(ctx, req.Plan, &resp.Diagnostics)
+	bindings := r.getResourceIamBindings(ctx, req.Plan, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	var stateRole types.String
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("role"), &stateRole)...)
@@ -139,8 +154,10 @@ func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest
 func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
 
-	binding := getResourceIamBindings(ctx, req.State, &resp.Diagnostics)
-	if len(binding) == 0 {
+	var role types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("role"), &role)...)
+
+	if len(getResourceIamMembers(ctx, req.State, &resp.Diagnostics)) == 0 {
 		tflog.Debug(ctx,
 			fmt.Sprintf(
 				"Resource %s is missing or deleted, marking policy binding as deleted",
@@ -149,10 +166,9 @@ func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest
 		)
 		return
 	}
-	role := binding[0].RoleId
 
 	err := iamPolicyReadModifySet(ctx, r.ResourceUpdater, func(p *Policy) error {
-		p.Bindings = removeRoleFromBindings(role, p.Bindings)
+		p.Bindings = removeRoleFromBindings(role.ValueString(), p.Bindings)
 		return nil
 	})
 
@@ -173,6 +189,27 @@ func (r *bindingResource) Metadata(_ context.Context, req resource.MetadataReque
 
 func (r *bindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.ResourceUpdater.Configure(ctx, req, resp)
+	r.principals = principalResolver(req)
+}
+
+// ModifyPlan fails the plan early if members given by e-mail, name or federation can not be resolved.
+func (r *bindingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var members types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("members"), &members)...)
+	if resp.Diagnostics.HasError() || members.IsUnknown() || members.IsNull() {
+		return
+	}
+
+	var membersString []string
+	resp.Diagnostics.Append(members.ElementsAs(ctx, &membersString, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resolveMembers(ctx, r.principals, membersString, false, &resp.Diagnostics)
 }
 
 func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -186,35 +223,46 @@ func (r *bindingResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 }
 
 func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	idParts, ok := splitImportId(req.ID, append(idAttributes(r.ResourceUpdater), "role"))
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: {resource_id},{role}. Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", importIdFormat(r.ResourceUpdater, "role"), req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(r.ResourceUpdater.GetIdAlias()), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), idParts[1])...)
+	for attr, value := range idParts {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), value)...)
+	}
 }
 
-// all bindings use same Role
-func getResourceIamBindings(ctx context.Context, state Extractable, diag *diag.Diagnostics) []*access.AccessBinding {
+// all bindings use same Role, members are resolved to the canonical form
+func (r *bindingResource) getResourceIamBindings(ctx context.Context, state Extractable, ignoreNotFound bool, diag *diag.Diagnostics) []*access.AccessBinding {
 	var role types.String
-	var members types.Set
-
 	diag.Append(state.GetAttribute(ctx, path.Root("role"), &role)...)
+
+	members := getResourceIamMembers(ctx, state, diag)
+	resolved := resolveMembers(ctx, r.principals, members, ignoreNotFound, diag)
+	return membersToBindings(role.ValueString(), members, resolved)
+}
+
+func getResourceIamMembers(ctx context.Context, state Extractable, diag *diag.Diagnostics) []string {
+	var members types.Set
 	diag.Append(state.GetAttribute(ctx, path.Root("members"), &members)...)
 
 	membersString := make([]string, 0, len(members.Elements()))
 	diag.Append(members.ElementsAs(ctx, &membersString, false)...)
+	return membersString
+}
 
-	result := make([]*access.AccessBinding, len(membersString))
-
-	for i, member := range membersString {
-		result[i] = roleMemberToAccessBinding(role.ValueString(), member)
+// membersToBindings returns bindings of canonical forms of the members, members missing in resolved are skipped.
+func membersToBindings(role string, members []string, resolved map[string]string) []*access.AccessBinding {
+	result := make([]*access.AccessBinding, 0, len(members))
+	for _, member := range members {
+		if canonical, ok := resolved[member]; ok {
+			result = append(result, roleMemberToAccessBinding(role, canonical))
+		}
 	}
 	return result
 }
@@ -0,0 +1,207 @@
+package accessbinding
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
+	"golang.org/x/exp/maps"
+)
+
+var memberRegexp = regexp.MustCompile(`^[a-zA-Z]+:.+$`)
+
+type memberResource struct {
+	ResourceUpdater ResourceIamUpdater
+
+	principals *principal.Resolver
+}
+
+// NewIamMember returns a resource granting a single role to a single member, other bindings of the resource are kept intact.
+func NewIamMember(updater ResourceIamUpdater) resource.Resource {
+	return &memberResource{ResourceUpdater: updater}
+}
+
+func (r *memberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.Plan, &resp.Diagnostics)
+	binding := r.getResourceIamMember(ctx, req.Plan, false, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.updateMember(ctx, binding, access.AccessBindingAction_ADD)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Add Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while attempting to add member %s with role %s to %s. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", canonicalMember(binding), binding.RoleId, r.ResourceUpdater.DescribeResource(), err),
+		)
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *memberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+	binding := r.getResourceIamMember(ctx, req.State, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if binding == nil {
+		tflog.Debug(ctx, "Member does not exist, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	policy, err := r.ResourceUpdater.GetResourceIamPolicy(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource Policies",
+			fmt.Sprintf("An unexpected error occurred while refreshing resource policies. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", err))
+		return
+	}
+
+	for _, b := range policy.Bindings {
+		if b.RoleId == binding.RoleId && canonicalMember(b) == canonicalMember(binding) {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Member %s with role %s not found in access bindings of %s, removing from state",
+		canonicalMember(binding), binding.RoleId, r.ResourceUpdater.DescribeResource()))
+	resp.State.RemoveResource(ctx)
+}
+
+// Update is never called with changes, since all attributes require replacement.
+func (r *memberResource) Update(_ context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.State.Raw = req.Plan.Raw
+}
+
+func (r *memberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ResourceUpdater.Initialize(ctx, req.State, &resp.Diagnostics)
+	binding := r.getResourceIamMember(ctx, req.State, true, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || binding == nil {
+		return
+	}
+
+	err := r.updateMember(ctx, binding, access.AccessBindingAction_REMOVE)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Remove Resource Policy Member",
+			fmt.Sprintf("An unexpected error occurred while attempting to remove member %s with role %s from %s. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: %s", canonicalMember(binding), binding.RoleId, r.ResourceUpdater.DescribeResource(), err),
+		)
+	}
+}
+
+func (r *memberResource) updateMember(ctx context.Context, binding *access.AccessBinding, action access.AccessBindingAction) error {
+	mutexKey := r.ResourceUpdater.GetMutexKey()
+	mutexKV.Lock(mutexKey)
+	defer mutexKV.Unlock(mutexKey)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating access bindings of %s: %s %s with role %s",
+		r.ResourceUpdater.DescribeResource(), action, canonicalMember(binding), binding.RoleId))
+
+	return r.ResourceUpdater.UpdateResourceIamPolicy(ctx, &PolicyDelta{
+		Deltas: []*access.AccessBindingDelta{
+			{
+				Action:        action,
+				AccessBinding: binding,
+			},
+		},
+	})
+}
+
+func (r *memberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.ResourceUpdater.GetNameSuffix()
+}
+
+func (r *memberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.ResourceUpdater.Configure(ctx, req, resp)
+	r.principals = principalResolver(req)
+}
+
+// ModifyPlan fails the plan early if the member given by e-mail, name or federation can not be resolved.
+func (r *memberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var member types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("member"), &member)...)
+	if resp.Diagnostics.HasError() || member.IsUnknown() || member.IsNull() {
+		return
+	}
+	resolveMembers(ctx, r.principals, []string{member.ValueString()}, false, &resp.Diagnostics)
+}
+
+func (r *memberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"member": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(memberRegexp, "must be in the {type}:{id} format"),
+				},
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, r.ResourceUpdater.GetSchemaAttributes())
+}
+
+func (r *memberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts, ok := splitImportId(req.ID, append(idAttributes(r.ResourceUpdater), "role", "member"))
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", importIdFormat(r.ResourceUpdater, "role", "member"), req.ID),
+		)
+		return
+	}
+
+	for attr, value := range idParts {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attr), value)...)
+	}
+}
+
+// getResourceIamMember returns the access binding of the member in the canonical form,
+// or nil if ignoreNotFound is set and the principal does not exist.
+func (r *memberResource) getResourceIamMember(ctx context.Context, state Extractable, ignoreNotFound bool, diag *diag.Diagnostics) *access.AccessBinding {
+	var role, member types.String
+
+	diag.Append(state.GetAttribute(ctx, path.Root("role"), &role)...)
+	diag.Append(state.GetAttribute(ctx, path.Root("member"), &member)...)
+
+	if !memberRegexp.MatchString(member.ValueString()) {
+		diag.AddError("Invalid Member", fmt.Sprintf("Member %q must be in the {type}:{id} format", member.ValueString()))
+		return nil
+	}
+
+	resolved := resolveMembers(ctx, r.principals, []string{member.ValueString()}, ignoreNotFound, diag)
+	canonical, ok := resolved[member.ValueString()]
+	if !ok {
+		return nil
+	}
+	return roleMemberToAccessBinding(role.ValueString(), canonical)
+}
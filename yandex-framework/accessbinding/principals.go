@@ -0,0 +1,35 @@
+package accessbinding
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// principalResolver returns the provider's resolver of members given by e-mail, name or federation.
+func principalResolver(req resource.ConfigureRequest) *principal.Resolver {
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		return nil
+	}
+	return providerConfig.Principals
+}
+
+// resolveMembers maps members to their canonical {type}:{id} form. Members of principals which
+// no longer exist are left out of the result if ignoreNotFound is set.
+func resolveMembers(ctx context.Context, resolver *principal.Resolver, members []string, ignoreNotFound bool, diags *diag.Diagnostics) map[string]string {
+	resolved, err := resolver.ResolveAll(ctx, members, ignoreNotFound)
+	if err != nil {
+		diags.AddError(
+			"Unable to Resolve Members",
+			"An unexpected error occurred while resolving access binding members. "+
+				"Make sure the principals exist.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return nil
+	}
+	return resolved
+}
@@ -0,0 +1,102 @@
+package accessbinding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc"
+)
+
+// AccessBindingsClient is implemented by API service clients which manage access bindings of their resources.
+type AccessBindingsClient interface {
+	ListAccessBindings(ctx context.Context, in *access.ListAccessBindingsRequest, opts ...grpc.CallOption) (*access.ListAccessBindingsResponse, error)
+	UpdateAccessBindings(ctx context.Context, in *access.UpdateAccessBindingsRequest, opts ...grpc.CallOption) (*operation.Operation, error)
+}
+
+type accessBindingsClientGetter func(sdk *ycsdk.SDK) AccessBindingsClient
+
+// registry maps resource types, as named by IAM, to the API services managing their access bindings.
+var registry = map[string]accessBindingsClientGetter{
+	"audit-trails.trail": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.AuditTrails().Trail() },
+
+	"billing.account": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Billing().BillingAccount() },
+
+	"certificate-manager.certificate": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Certificates().Certificate() },
+
+	"compute.disk":               func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().Disk() },
+	"compute.diskPlacementGroup": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().DiskPlacementGroup() },
+	"compute.filesystem":         func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().Filesystem() },
+	"compute.gpuCluster":         func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().GpuCluster() },
+	"compute.hostGroup":          func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().HostGroup() },
+	"compute.image":              func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().Image() },
+	"compute.instance":           func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().Instance() },
+	"compute.instanceGroup":      func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.InstanceGroup().InstanceGroup() },
+	"compute.placementGroup":     func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().PlacementGroup() },
+	"compute.snapshot":           func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().Snapshot() },
+	"compute.snapshotSchedule":   func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Compute().SnapshotSchedule() },
+
+	"container-registry.registry":   func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.ContainerRegistry().Registry() },
+	"container-registry.repository": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.ContainerRegistry().Repository() },
+
+	"datasphere.community": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Datasphere().Community() },
+	"datasphere.project":   func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Datasphere().Project() },
+
+	"dns.zone": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.DNS().DnsZone() },
+
+	"iam.serviceAccount": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.IAM().ServiceAccount() },
+
+	"kms.asymmetricEncryptionKey": func(sdk *ycsdk.SDK) AccessBindingsClient {
+		return sdk.KMSAsymmetricEncryption().AsymmetricEncryptionKey()
+	},
+	"kms.asymmetricSignatureKey": func(sdk *ycsdk.SDK) AccessBindingsClient {
+		return sdk.KMSAsymmetricSignature().AsymmetricSignatureKey()
+	},
+	"kms.symmetricKey": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.KMS().SymmetricKey() },
+
+	"lockbox.secret": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.LockboxSecret().Secret() },
+
+	"logging.export":   func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Logging().Export() },
+	"logging.logGroup": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Logging().LogGroup() },
+	"logging.sink":     func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Logging().Sink() },
+
+	"organization-manager.group":        func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.OrganizationManager().Group() },
+	"organization-manager.organization": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.OrganizationManager().Organization() },
+
+	"resource-manager.cloud":  func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.ResourceManager().Cloud() },
+	"resource-manager.folder": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.ResourceManager().Folder() },
+
+	"serverless.apiGateway": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Serverless().APIGateway().ApiGateway() },
+	"serverless.containers.container": func(sdk *ycsdk.SDK) AccessBindingsClient {
+		return sdk.Serverless().Containers().Container()
+	},
+	"serverless.functions.function": func(sdk *ycsdk.SDK) AccessBindingsClient {
+		return sdk.Serverless().Functions().Function()
+	},
+	"serverless.mdbProxy": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.Serverless().MDBProxy().Proxy() },
+
+	"ydb.backup":   func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.YDB().Backup() },
+	"ydb.database": func(sdk *ycsdk.SDK) AccessBindingsClient { return sdk.YDB().Database() },
+}
+
+// ResourceTypes returns sorted resource types which access bindings can be managed.
+func ResourceTypes() []string {
+	types := make([]string, 0, len(registry))
+	for t := range registry {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// GetAccessBindingsClient returns the API service client managing access bindings of resources of the given type.
+func GetAccessBindingsClient(sdk *ycsdk.SDK, resourceType string) (AccessBindingsClient, error) {
+	getter, ok := registry[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("access bindings of %q resources are not supported", resourceType)
+	}
+	return getter(sdk), nil
+}
@@ -63,6 +63,13 @@ type ResourceIamUpdater interface {
 	GetId() string
 }
 
+// MultiAttributeIdUpdater is implemented by updaters which resources are identified by several attributes,
+// e.g. a resource type along with the resource id. The attributes are kept in state as is and
+// make up the import identifier, in the given order.
+type MultiAttributeIdUpdater interface {
+	GetIdAttributes() []string
+}
+
 type Extractable interface {
 	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
 }
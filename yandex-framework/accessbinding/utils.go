@@ -1,9 +1,14 @@
 package accessbinding
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
 )
 
@@ -96,3 +101,88 @@ func CountBatches(size, batchSize int) int {
 	}
 	return iterations
 }
+
+// policyDeltas returns the changes turning the current bindings into the desired ones.
+func policyDeltas(current, desired []*access.AccessBinding) []*access.AccessBindingDelta {
+	cm := rolesToMembersMap(current)
+	dm := rolesToMembersMap(desired)
+
+	var deltas []*access.AccessBindingDelta
+	appendDeltas := func(from, to map[string]map[string]bool, action access.AccessBindingAction) {
+		for _, role := range sortedKeys(from) {
+			for _, member := range sortedKeys(from[role]) {
+				if to[role][member] {
+					continue
+				}
+				deltas = append(deltas, &access.AccessBindingDelta{
+					Action:        action,
+					AccessBinding: roleMemberToAccessBinding(role, member),
+				})
+			}
+		}
+	}
+	appendDeltas(cm, dm, access.AccessBindingAction_REMOVE)
+	appendDeltas(dm, cm, access.AccessBindingAction_ADD)
+
+	return deltas
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func idAttributes(updater ResourceIamUpdater) []string {
+	if u, ok := updater.(MultiAttributeIdUpdater); ok {
+		return u.GetIdAttributes()
+	}
+	return []string{updater.GetIdAlias()}
+}
+
+func importIdFormat(updater ResourceIamUpdater, attrs ...string) string {
+	parts := append(idAttributes(updater), attrs...)
+	for i, p := range parts {
+		if p == updater.GetIdAlias() {
+			p = "resource_id"
+		}
+		parts[i] = "{" + p + "}"
+	}
+	return strings.Join(parts, ",")
+}
+
+// splitImportId splits comma separated import identifier into values of the given attributes.
+func splitImportId(id string, attrs []string) (map[string]string, bool) {
+	parts := strings.Split(id, ",")
+	if len(parts) != len(attrs) {
+		return nil, false
+	}
+
+	result := make(map[string]string, len(attrs))
+	for i, attr := range attrs {
+		if parts[i] == "" {
+			return nil, false
+		}
+		result[attr] = parts[i]
+	}
+	return result, true
+}
+
+// copyIdAttributes copies id attributes of multi attribute id updaters, except the id alias, from src to dst.
+func copyIdAttributes(ctx context.Context, updater ResourceIamUpdater, src Extractable, dst Settable, diag *diag.Diagnostics) {
+	if _, ok := updater.(MultiAttributeIdUpdater); !ok {
+		return
+	}
+
+	for _, attr := range idAttributes(updater) {
+		if attr == updater.GetIdAlias() {
+			continue
+		}
+		var value types.String
+		diag.Append(src.GetAttribute(ctx, path.Root(attr), &value)...)
+		diag.Append(dst.SetAttribute(ctx, path.Root(attr), value)...)
+	}
+}
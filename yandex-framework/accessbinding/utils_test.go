@@ -0,0 +1,87 @@
+package accessbinding
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+func TestPolicyDeltas(t *testing.T) {
+	current := []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("viewer", "userAccount:b"),
+		roleMemberToAccessBinding("editor", "serviceAccount:c"),
+	}
+	desired := []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("viewer", "group:d"),
+		roleMemberToAccessBinding("editor", "serviceAccount:c"),
+		roleMemberToAccessBinding("admin", "serviceAccount:c"),
+	}
+
+	expected := []*access.AccessBindingDelta{
+		{Action: access.AccessBindingAction_REMOVE, AccessBinding: roleMemberToAccessBinding("viewer", "userAccount:b")},
+		{Action: access.AccessBindingAction_ADD, AccessBinding: roleMemberToAccessBinding("admin", "serviceAccount:c")},
+		{Action: access.AccessBindingAction_ADD, AccessBinding: roleMemberToAccessBinding("viewer", "group:d")},
+	}
+
+	if got := policyDeltas(current, desired); !reflect.DeepEqual(got, expected) {
+		t.Errorf("policyDeltas() = %v, want %v", got, expected)
+	}
+	if got := policyDeltas(desired, desired); len(got) != 0 {
+		t.Errorf("policyDeltas() of equal bindings = %v, want none", got)
+	}
+}
+
+func TestSplitImportId(t *testing.T) {
+	attrs := []string{"resource_type", "resource_id", "role"}
+
+	cases := []struct {
+		name     string
+		id       string
+		expected map[string]string
+	}{
+		{
+			name: "valid",
+			id:   "dns.zone,dns123,dns.editor",
+			expected: map[string]string{
+				"resource_type": "dns.zone",
+				"resource_id":   "dns123",
+				"role":          "dns.editor",
+			},
+		},
+		{
+			name: "missing part",
+			id:   "dns123,dns.editor",
+		},
+		{
+			name: "empty part",
+			id:   "dns.zone,,dns.editor",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := splitImportId(tc.id, attrs)
+			if ok != (tc.expected != nil) || !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("splitImportId(%q) = %v, %v, want %v", tc.id, got, ok, tc.expected)
+			}
+		})
+	}
+}
+
+func TestGetAccessBindingsClient(t *testing.T) {
+	sdk := &ycsdk.SDK{}
+	for _, resourceType := range ResourceTypes() {
+		client, err := GetAccessBindingsClient(sdk, resourceType)
+		if err != nil || client == nil {
+			t.Errorf("GetAccessBindingsClient(%q) = %v, %v", resourceType, client, err)
+		}
+	}
+
+	if _, err := GetAccessBindingsClient(sdk, "unknown.resource"); err == nil {
+		t.Errorf("GetAccessBindingsClient() expected an error for unknown resource type")
+	}
+}
@@ -27,6 +27,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/restapi"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 )
 
@@ -66,6 +68,8 @@ type State struct {
 
 	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
 	Profile               types.String `tfsdk:"profile"`
+
+	SecurityGroupRulesCheck types.String `tfsdk:"security_group_rules_check"`
 	//
 	//sharedCredentials *SharedCredentials
 	//defaultS3Client   *s3.S3
@@ -77,6 +81,12 @@ type Config struct {
 
 	UserAgent types.String
 	SDK       *ycsdk.SDK
+
+	// Principals resolves access binding members given by e-mail, name or federation.
+	Principals *principal.Resolver
+
+	// REST calls the services that are not available in the pinned go-genproto version.
+	REST *restapi.Client
 }
 
 // Client configures and returns a fully initialized Yandex.Cloud SDK
@@ -135,8 +145,13 @@ func (c *Config) InitAndValidate(ctx context.Context, terraformVersion string, s
 		grpc.WithUserAgent(c.UserAgent.ValueString()),
 		grpc.WithDefaultCallOptions(grpc.Header(&headerMD)),
 		grpc.WithUnaryInterceptor(interceptorChain))
+	if err != nil {
+		return err
+	}
 
-	return err
+	c.REST = restapi.NewClient(c.SDK, c.UserAgent.ValueString(), c.ProviderState.Plaintext.ValueBool(), c.ProviderState.Insecure.ValueBool())
+	c.Principals = principal.NewResolver(c.SDK, c.ProviderState.OrganizationID.ValueString())
+	return nil
 }
 
 func (c *Config) Credentials(ctx context.Context) (ycsdk.Credentials, error) {
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/endpoint"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+)
+
+const testIAMToken = "t1.test.token"
+
+type mockAPIEndpointServer struct {
+	endpoint.UnimplementedApiEndpointServiceServer
+	addr string
+}
+
+func (s *mockAPIEndpointServer) List(context.Context, *endpoint.ListApiEndpointsRequest) (*endpoint.ListApiEndpointsResponse, error) {
+	return &endpoint.ListApiEndpointsResponse{
+		Endpoints: []*endpoint.ApiEndpoint{
+			{Id: "iam", Address: s.addr},
+		},
+	}, nil
+}
+
+type mockServiceAccountServer struct {
+	iam.UnimplementedServiceAccountServiceServer
+	requests []*iam.ListServiceAccountsRequest
+}
+
+func (s *mockServiceAccountServer) List(_ context.Context, req *iam.ListServiceAccountsRequest) (*iam.ListServiceAccountsResponse, error) {
+	s.requests = append(s.requests, req)
+	return &iam.ListServiceAccountsResponse{
+		ServiceAccounts: []*iam.ServiceAccount{
+			{Id: "aje00000000deployer", FolderId: req.GetFolderId(), Name: "deployer"},
+		},
+	}, nil
+}
+
+func TestConfigInitAndValidateResolvesPrincipals(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer()
+	serviceAccounts := &mockServiceAccountServer{}
+	endpoint.RegisterApiEndpointServiceServer(grpcServer, &mockAPIEndpointServer{addr: l.Addr().String()})
+	iam.RegisterServiceAccountServiceServer(grpcServer, serviceAccounts)
+	go func() { _ = grpcServer.Serve(l) }()
+	defer grpcServer.Stop()
+
+	config := &Config{
+		ProviderState: State{
+			Endpoint:  types.StringValue(l.Addr().String()),
+			Token:     types.StringValue(testIAMToken),
+			Plaintext: types.BoolValue(true),
+			Insecure:  types.BoolValue(true),
+		},
+	}
+	require.NoError(t, config.InitAndValidate(context.Background(), "test-terraform", false))
+	require.NotNil(t, config.Principals)
+
+	member, err := config.Principals.Resolve(context.Background(), "serviceAccount:b1g0000000000000folder/deployer")
+	require.NoError(t, err)
+	assert.Equal(t, "serviceAccount:aje00000000deployer", member)
+	require.Len(t, serviceAccounts.requests, 1)
+	assert.Equal(t, "b1g0000000000000folder", serviceAccounts.requests[0].GetFolderId())
+}
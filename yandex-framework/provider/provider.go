@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/accessbinding"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/billing"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute/disk"
@@ -29,8 +31,12 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute/snapshotschedule"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere/community"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/datasphere/project"
+	chdatabase "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/clickhouse/database"
+	chuser "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/clickhouse/user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/mongodb/database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/mongodb/user"
+	sqlserverdatabase "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/sqlserver/database"
+	sqlserveruser "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb/sqlserver/user"
 )
 
 type saKeyValidator struct{}
@@ -168,6 +174,13 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"security_group_rules_check": schema.StringAttribute{
+				Optional:    true,
+				Description: common.Descriptions["security_group_rules_check"],
+				Validators: []validator.String{
+					stringvalidator.OneOf(common.SecurityGroupRulesCheckModes...),
+				},
+			},
 		},
 	}
 }
@@ -210,6 +223,7 @@ func setDefaults(config provider_config.State) provider_config.State {
 	config.YMQEndpoint = setToDefaultIfNeeded(config.YMQEndpoint, "YC_MESSAGE_QUEUE_ENDPOINT", common.DefaultYMQEndpoint)
 	config.YMQAccessKey = setToDefaultIfNeeded(config.YMQAccessKey, "YC_MESSAGE_QUEUE_ACCESS_KEY", "")
 	config.YMQSecretKey = setToDefaultIfNeeded(config.YMQSecretKey, "YC_MESSAGE_QUEUE_SECRET_KEY", "")
+	config.SecurityGroupRulesCheck = setToDefaultIfNeeded(config.SecurityGroupRulesCheck, "YC_SECURITY_GROUP_RULES_CHECK", common.DefaultSecurityGroupRulesCheck)
 
 	config.Insecure = setToDefaultBoolIfNeeded(config.Insecure, "YC_INSECURE", false)
 	config.Plaintext = setToDefaultBoolIfNeeded(config.Plaintext, "YC_PLAINTEXT", false)
@@ -254,6 +268,10 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		community.NewIamBinding,
 		database.NewResource,
 		user.NewResource,
+		chdatabase.NewResource,
+		chuser.NewResource,
+		sqlserverdatabase.NewResource,
+		sqlserveruser.NewResource,
 		disk.NewIamBinding,
 		diskplacementgroup.NewIamBinding,
 		filesystem.NewIamBinding,
@@ -263,6 +281,8 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		placementgroup.NewIamBinding,
 		snapshot.NewIamBinding,
 		snapshotschedule.NewIamBinding,
+		accessbinding.NewAccessBinding,
+		accessbinding.NewAccessMember,
 	}
 }
 
@@ -277,6 +297,10 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		community.NewDataSource,
 		database.NewDataSource,
 		user.NewDataSource,
+		chdatabase.NewDataSource,
+		chuser.NewDataSource,
+		sqlserverdatabase.NewDataSource,
+		sqlserveruser.NewDataSource,
 	}
 }
 
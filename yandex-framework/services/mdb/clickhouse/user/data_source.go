@@ -0,0 +1,141 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+)
+
+type bindingDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewDataSource() datasource.DataSource {
+	return &bindingDataSource{}
+}
+
+func (d *bindingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_clickhouse_user"
+}
+
+func (d *bindingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *bindingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"generate_password": schema.BoolAttribute{
+				Computed: true,
+			},
+			"connection_manager": schema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]schema.Attribute{
+					"connection_id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			"permission": schema.SetNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"database_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"settings": schema.SingleNestedAttribute{
+				Computed:   true,
+				Attributes: settingsDataSourceAttributes(),
+			},
+			"quota": schema.SetNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"interval_duration": schema.Int64Attribute{
+							Computed: true,
+						},
+						"queries": schema.Int64Attribute{
+							Computed: true,
+						},
+						"errors": schema.Int64Attribute{
+							Computed: true,
+						},
+						"result_rows": schema.Int64Attribute{
+							Computed: true,
+						},
+						"read_rows": schema.Int64Attribute{
+							Computed: true,
+						},
+						"execution_time": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state User
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	userName := state.Name.ValueString()
+	user := readUser(ctx, d.providerConfig.SDK, &resp.Diagnostics, cid, userName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Id = types.StringValue(resourceid.Construct(cid, userName))
+
+	resp.Diagnostics.Append(userToState(user, &state)...)
+	settings, diags := settingsToState(user.Settings)
+	resp.Diagnostics.Append(diags...)
+	state.Settings = settings
+
+	connectionID := readUserConnectionID(ctx, d.providerConfig, &resp.Diagnostics, cid, userName)
+	state.GeneratePassword = types.BoolValue(connectionID != "")
+	state.ConnectionManager = connectionManagerToState(connectionID)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
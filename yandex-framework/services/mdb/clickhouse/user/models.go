@@ -0,0 +1,184 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type User struct {
+	Id                types.String `tfsdk:"id"`
+	ClusterID         types.String `tfsdk:"cluster_id"`
+	Name              types.String `tfsdk:"name"`
+	Password          types.String `tfsdk:"password"`
+	GeneratePassword  types.Bool   `tfsdk:"generate_password"`
+	ConnectionManager types.Object `tfsdk:"connection_manager"`
+	Permission        types.Set    `tfsdk:"permission"`
+	Settings          types.Object `tfsdk:"settings"`
+	Quota             types.Set    `tfsdk:"quota"`
+}
+
+type Permission struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+}
+
+type Quota struct {
+	IntervalDuration types.Int64 `tfsdk:"interval_duration"`
+	Queries          types.Int64 `tfsdk:"queries"`
+	Errors           types.Int64 `tfsdk:"errors"`
+	ResultRows       types.Int64 `tfsdk:"result_rows"`
+	ReadRows         types.Int64 `tfsdk:"read_rows"`
+	ExecutionTime    types.Int64 `tfsdk:"execution_time"`
+}
+
+var permissionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"database_name": types.StringType,
+	},
+}
+
+var quotaType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"interval_duration": types.Int64Type,
+		"queries":           types.Int64Type,
+		"errors":            types.Int64Type,
+		"result_rows":       types.Int64Type,
+		"read_rows":         types.Int64Type,
+		"execution_time":    types.Int64Type,
+	},
+}
+
+var connectionManagerType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"connection_id": types.StringType,
+	},
+}
+
+// userToState keeps the permissions of the state if there are none, since a user without permissions is granted
+// access to all databases, which are then returned by the API.
+func userToState(user *clickhouse.User, state *User) diag.Diagnostics {
+	var diags diag.Diagnostics
+	state.Name = types.StringValue(user.Name)
+	state.ClusterID = types.StringValue(user.ClusterId)
+
+	if state.Permission.IsNull() || len(state.Permission.Elements()) > 0 {
+		diags.Append(permissionsToState(user.Permissions, state)...)
+	}
+	if !state.Settings.IsNull() {
+		settings, d := settingsToState(user.Settings)
+		diags.Append(d...)
+		state.Settings = settings
+	}
+	diags.Append(quotasToState(user.Quotas, state)...)
+	return diags
+}
+
+func permissionsToState(permissions []*clickhouse.Permission, state *User) diag.Diagnostics {
+	var diags diag.Diagnostics
+	permissionValues := make([]attr.Value, 0, len(permissions))
+	for _, permission := range permissions {
+		permissionValue, d := types.ObjectValue(permissionType.AttrTypes, map[string]attr.Value{
+			"database_name": types.StringValue(permission.DatabaseName),
+		})
+		diags.Append(d...)
+		permissionValues = append(permissionValues, permissionValue)
+	}
+
+	value, d := types.SetValue(permissionType, permissionValues)
+	diags.Append(d...)
+	state.Permission = value
+	return diags
+}
+
+func quotasToState(quotas []*clickhouse.UserQuota, state *User) diag.Diagnostics {
+	int64Value := func(v *wrapperspb.Int64Value) types.Int64 {
+		if v == nil {
+			return types.Int64Null()
+		}
+		return types.Int64Value(v.Value)
+	}
+
+	var diags diag.Diagnostics
+	quotaValues := make([]attr.Value, 0, len(quotas))
+	for _, quota := range quotas {
+		quotaValue, d := types.ObjectValue(quotaType.AttrTypes, map[string]attr.Value{
+			"interval_duration": int64Value(quota.IntervalDuration),
+			"queries":           int64Value(quota.Queries),
+			"errors":            int64Value(quota.Errors),
+			"result_rows":       int64Value(quota.ResultRows),
+			"read_rows":         int64Value(quota.ReadRows),
+			"execution_time":    int64Value(quota.ExecutionTime),
+		})
+		diags.Append(d...)
+		quotaValues = append(quotaValues, quotaValue)
+	}
+
+	value, d := types.SetValue(quotaType, quotaValues)
+	diags.Append(d...)
+	state.Quota = value
+	return diags
+}
+
+func connectionManagerToState(connectionID string) types.Object {
+	if connectionID == "" {
+		return types.ObjectNull(connectionManagerType.AttrTypes)
+	}
+	return types.ObjectValueMust(connectionManagerType.AttrTypes, map[string]attr.Value{
+		"connection_id": types.StringValue(connectionID),
+	})
+}
+
+func userFromState(ctx context.Context, state *User) (*clickhouse.UserSpec, diag.Diagnostics) {
+	permissions, diags := permissionsFromState(ctx, state)
+	quotas, d := quotasFromState(ctx, state)
+	diags.Append(d...)
+	return &clickhouse.UserSpec{
+		Name:        state.Name.ValueString(),
+		Password:    state.Password.ValueString(),
+		Permissions: permissions,
+		Settings:    settingsFromState(state.Settings),
+		Quotas:      quotas,
+	}, diags
+}
+
+func permissionsFromState(ctx context.Context, state *User) ([]*clickhouse.Permission, diag.Diagnostics) {
+	permissionsType := make([]Permission, 0, len(state.Permission.Elements()))
+	diags := state.Permission.ElementsAs(ctx, &permissionsType, false)
+
+	permissions := make([]*clickhouse.Permission, 0, len(permissionsType))
+	for _, permission := range permissionsType {
+		permissions = append(permissions, &clickhouse.Permission{
+			DatabaseName: permission.DatabaseName.ValueString(),
+		})
+	}
+	return permissions, diags
+}
+
+func quotasFromState(ctx context.Context, state *User) ([]*clickhouse.UserQuota, diag.Diagnostics) {
+	int64Value := func(v types.Int64) *wrapperspb.Int64Value {
+		if v.IsNull() || v.IsUnknown() {
+			return nil
+		}
+		return wrapperspb.Int64(v.ValueInt64())
+	}
+
+	quotasType := make([]Quota, 0, len(state.Quota.Elements()))
+	diags := state.Quota.ElementsAs(ctx, &quotasType, false)
+
+	quotas := make([]*clickhouse.UserQuota, 0, len(quotasType))
+	for _, quota := range quotasType {
+		quotas = append(quotas, &clickhouse.UserQuota{
+			IntervalDuration: int64Value(quota.IntervalDuration),
+			Queries:          int64Value(quota.Queries),
+			Errors:           int64Value(quota.Errors),
+			ResultRows:       int64Value(quota.ResultRows),
+			ReadRows:         int64Value(quota.ReadRows),
+			ExecutionTime:    int64Value(quota.ExecutionTime),
+		})
+	}
+	return quotas, diags
+}
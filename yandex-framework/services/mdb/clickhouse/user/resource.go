@@ -0,0 +1,328 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+)
+
+type bindingResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &bindingResource{}
+}
+
+func (r *bindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_clickhouse_user"
+}
+
+func (r *bindingResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *bindingResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"generate_password": schema.BoolAttribute{
+				Description: "Generate the password with Connection Manager instead of setting it in `password`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_manager": schema.SingleNestedAttribute{
+				Description: "Connection Manager connection keeping the generated password.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"connection_id": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"permission": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"database_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"settings": schema.SingleNestedBlock{
+				Attributes: settingsResourceAttributes(),
+			},
+			"quota": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"interval_duration": schema.Int64Attribute{
+							Required: true,
+						},
+						"queries": schema.Int64Attribute{
+							Optional: true,
+						},
+						"errors": schema.Int64Attribute{
+							Optional: true,
+						},
+						"result_rows": schema.Int64Attribute{
+							Optional: true,
+						},
+						"read_rows": schema.Int64Attribute{
+							Optional: true,
+						},
+						"execution_time": schema.Int64Attribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *bindingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config User
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Password.IsUnknown() || config.GeneratePassword.IsUnknown() {
+		return
+	}
+
+	if config.GeneratePassword.ValueBool() && !config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Conflicting Attributes",
+			"password can not be set together with generate_password",
+		)
+	}
+	if !config.GeneratePassword.ValueBool() && config.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Attribute",
+			"password is required unless generate_password is true",
+		)
+	}
+}
+
+func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state User
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	cid := state.ClusterID.ValueString()
+	userName := state.Name.ValueString()
+	r.refreshState(ctx, &resp.Diagnostics, cid, userName, &state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// refreshState reads the user into the state, the settings are only read if they are in the state.
+func (r *bindingResource) refreshState(ctx context.Context, diag *diag.Diagnostics, cid, userName string, state *User) {
+	user := readUser(ctx, r.providerConfig.SDK, diag, cid, userName)
+	if diag.HasError() {
+		return
+	}
+	diag.Append(userToState(user, state)...)
+
+	connectionID := ""
+	if state.GeneratePassword.ValueBool() {
+		connectionID = readUserConnectionID(ctx, r.providerConfig, diag, cid, userName)
+	}
+	state.ConnectionManager = connectionManagerToState(connectionID)
+	state.Id = types.StringValue(resourceid.Construct(cid, userName))
+}
+
+func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan User
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := plan.ClusterID.ValueString()
+	userPlan, diags := userFromState(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.GeneratePassword.ValueBool() {
+		createUserWithGeneratedPassword(ctx, r.providerConfig, &resp.Diagnostics, cid, userPlan)
+	} else {
+		createUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setAppliedState(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// setAppliedState fills the values left for the server to choose in the plan.
+func (r *bindingResource) setAppliedState(ctx context.Context, diag *diag.Diagnostics, plan *User) {
+	applied := *plan
+	r.refreshState(ctx, diag, plan.ClusterID.ValueString(), plan.Name.ValueString(), &applied)
+	if diag.HasError() {
+		return
+	}
+
+	plan.Id = applied.Id
+	plan.ConnectionManager = applied.ConnectionManager
+	plan.Settings = mergeSettings(plan.Settings, applied.Settings)
+}
+
+func getUpdatePaths(plan, state *clickhouse.UserSpec) []string {
+	var updatePaths []string
+	if state.Password != plan.Password {
+		updatePaths = append(updatePaths, "password")
+	}
+	if fmt.Sprintf("%v", state.Permissions) != fmt.Sprintf("%v", plan.Permissions) {
+		updatePaths = append(updatePaths, "permissions")
+	}
+	if fmt.Sprintf("%v", state.Settings) != fmt.Sprintf("%v", plan.Settings) {
+		updatePaths = append(updatePaths, "settings")
+	}
+	if fmt.Sprintf("%v", state.Quotas) != fmt.Sprintf("%v", plan.Quotas) {
+		updatePaths = append(updatePaths, "quotas")
+	}
+	return updatePaths
+}
+
+func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan User
+	var state User
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := plan.ClusterID.ValueString()
+	userState, diags := userFromState(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	userPlan, diags := userFromState(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updatePaths := getUpdatePaths(userPlan, userState)
+
+	if len(updatePaths) > 0 {
+		updateUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userPlan, updatePaths)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.setAppliedState(ctx, &resp.Diagnostics, &plan)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state User
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	userName := state.Name.ValueString()
+	deleteUser(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, userName)
+}
+
+func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterId, userName, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	// the settings are read once they are configured
+	state := User{
+		Settings: types.ObjectNull(settingsType.AttrTypes),
+	}
+	r.refreshState(ctx, &resp.Diagnostics, clusterId, userName, &state)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if connectionID := readUserConnectionID(ctx, r.providerConfig, &resp.Diagnostics, clusterId, userName); connectionID != "" {
+		state.GeneratePassword = types.BoolValue(true)
+		state.ConnectionManager = connectionManagerToState(connectionID)
+	}
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
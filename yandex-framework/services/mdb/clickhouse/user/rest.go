@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/retry"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Passwords generated by Connection Manager are not available in the pinned go-genproto version, users with
+// generate_password are created and read through the REST API of the same endpoint, see common/restapi.
+
+type restUser struct {
+	ConnectionManager *struct {
+		ConnectionID string `json:"connectionId"`
+	} `json:"connectionManager"`
+}
+
+type restAPIOperation struct {
+	ID string `json:"id"`
+}
+
+func usersPath(cid string) string {
+	return "/managed-clickhouse/v1/clusters/" + url.PathEscape(cid) + "/users"
+}
+
+func createUserWithGeneratedPassword(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, cid string, user *clickhouse.UserSpec) {
+	spec := map[string]interface{}{}
+	data, err := protojson.Marshal(user)
+	if err == nil {
+		err = json.Unmarshal(data, &spec)
+	}
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while encoding ClickHouse user:"+err.Error(),
+		)
+		return
+	}
+	delete(spec, "password")
+	spec["generatePassword"] = true
+
+	sdk := config.SDK
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		restOp := &restAPIOperation{}
+		err := config.REST.Do(ctx, ycsdk.MDBClickhouseServiceID, http.MethodPost, usersPath(cid), nil, map[string]interface{}{"userSpec": spec}, restOp)
+		if err != nil {
+			return nil, err
+		}
+		return sdk.Operation().Get(ctx, &operation.GetOperationRequest{OperationId: restOp.ID})
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to create ClickHouse user:"+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to create ClickHouse user:"+err.Error(),
+		)
+	}
+}
+
+// readUserConnectionID returns the ID of the Connection Manager connection keeping the generated password of the user.
+func readUserConnectionID(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, cid, userName string) string {
+	user := &restUser{}
+	if err := config.REST.Do(ctx, ycsdk.MDBClickhouseServiceID, http.MethodGet, usersPath(cid)+"/"+url.PathEscape(userName), nil, nil, user); err != nil {
+		diag.AddError(
+			"Failed to Read resource",
+			"Error while requesting API to get ClickHouse user:"+err.Error(),
+		)
+		return ""
+	}
+	if user.ConnectionManager == nil {
+		return ""
+	}
+	return user.ConnectionManager.ConnectionID
+}
@@ -0,0 +1,347 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// settingNames are the user settings supported by the user block of yandex_mdb_clickhouse_cluster. The settings
+// are converted by their names in clickhouse.UserSettings, so that the list is the only thing to change to add one.
+var settingNames = []string{
+	"readonly",
+	"allow_ddl",
+	"insert_quorum",
+	"connect_timeout",
+	"receive_timeout",
+	"send_timeout",
+	"insert_quorum_timeout",
+	"select_sequential_consistency",
+	"max_replica_delay_for_distributed_queries",
+	"fallback_to_stale_replicas_for_distributed_queries",
+	"replication_alter_partitions_sync",
+	"distributed_product_mode",
+	"distributed_aggregation_memory_efficient",
+	"distributed_ddl_task_timeout",
+	"skip_unavailable_shards",
+	"compile",
+	"min_count_to_compile",
+	"compile_expressions",
+	"min_count_to_compile_expression",
+	"max_block_size",
+	"min_insert_block_size_rows",
+	"min_insert_block_size_bytes",
+	"max_insert_block_size",
+	"min_bytes_to_use_direct_io",
+	"use_uncompressed_cache",
+	"merge_tree_max_rows_to_use_cache",
+	"merge_tree_max_bytes_to_use_cache",
+	"merge_tree_min_rows_for_concurrent_read",
+	"merge_tree_min_bytes_for_concurrent_read",
+	"max_bytes_before_external_group_by",
+	"max_bytes_before_external_sort",
+	"group_by_two_level_threshold",
+	"group_by_two_level_threshold_bytes",
+	"priority",
+	"max_threads",
+	"max_memory_usage",
+	"max_memory_usage_for_user",
+	"max_network_bandwidth",
+	"max_network_bandwidth_for_user",
+	"force_index_by_date",
+	"force_primary_key",
+	"max_rows_to_read",
+	"max_bytes_to_read",
+	"read_overflow_mode",
+	"max_rows_to_group_by",
+	"group_by_overflow_mode",
+	"max_rows_to_sort",
+	"max_bytes_to_sort",
+	"sort_overflow_mode",
+	"max_result_rows",
+	"max_result_bytes",
+	"result_overflow_mode",
+	"max_rows_in_distinct",
+	"max_bytes_in_distinct",
+	"distinct_overflow_mode",
+	"max_rows_to_transfer",
+	"max_bytes_to_transfer",
+	"transfer_overflow_mode",
+	"max_execution_time",
+	"timeout_overflow_mode",
+	"max_rows_in_set",
+	"max_bytes_in_set",
+	"set_overflow_mode",
+	"max_rows_in_join",
+	"max_bytes_in_join",
+	"join_overflow_mode",
+	"max_columns_to_read",
+	"max_temporary_columns",
+	"max_temporary_non_const_columns",
+	"max_query_size",
+	"max_ast_depth",
+	"max_ast_elements",
+	"max_expanded_ast_elements",
+	"min_execution_speed",
+	"min_execution_speed_bytes",
+	"count_distinct_implementation",
+	"input_format_values_interpret_expressions",
+	"input_format_defaults_for_omitted_fields",
+	"output_format_json_quote_64bit_integers",
+	"output_format_json_quote_denormals",
+	"low_cardinality_allow_in_native_format",
+	"empty_result_for_aggregation_by_empty_set",
+	"joined_subquery_requires_alias",
+	"join_use_nulls",
+	"transform_null_in",
+	"http_connection_timeout",
+	"http_receive_timeout",
+	"http_send_timeout",
+	"enable_http_compression",
+	"send_progress_in_http_headers",
+	"http_headers_progress_interval",
+	"add_http_cors_header",
+	"quota_mode",
+	"max_concurrent_queries_for_user",
+	"memory_profiler_step",
+	"memory_profiler_sample_probability",
+	"insert_null_as_default",
+	"allow_suspicious_low_cardinality_types",
+	"connect_timeout_with_failover",
+	"allow_introspection_functions",
+	"async_insert",
+	"async_insert_threads",
+	"wait_for_async_insert",
+	"wait_for_async_insert_timeout",
+	"async_insert_max_data_size",
+	"async_insert_busy_timeout",
+	"async_insert_stale_timeout",
+	"timeout_before_checking_execution_speed",
+	"cancel_http_readonly_queries_on_client_close",
+	"flatten_nested",
+	"max_http_get_redirects",
+	"input_format_import_nested_json",
+	"input_format_parallel_parsing",
+	"max_final_threads",
+	"max_read_buffer_size",
+	"local_filesystem_read_method",
+	"remote_filesystem_read_method",
+	"insert_keeper_max_retries",
+	"max_temporary_data_on_disk_size_for_user",
+	"max_temporary_data_on_disk_size_for_query",
+	"max_parser_depth",
+	"memory_overcommit_ratio_denominator",
+	"memory_overcommit_ratio_denominator_for_user",
+	"memory_usage_overcommit_max_wait_microseconds",
+}
+
+var settingsDescriptor = (&clickhouse.UserSettings{}).ProtoReflect().Descriptor()
+
+func settingField(name string) protoreflect.FieldDescriptor {
+	fd := settingsDescriptor.Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		panic(fmt.Sprintf("ClickHouse user setting %q is not known", name))
+	}
+	return fd
+}
+
+// settingEnumValues returns the enum values of the setting in lower case and without the enum name prefix,
+// e.g. "throw" for OVERFLOW_MODE_THROW.
+func settingEnumValues(fd protoreflect.FieldDescriptor) []string {
+	values := fd.Enum().Values()
+	prefix := strings.TrimSuffix(string(values.ByNumber(0).Name()), "UNSPECIFIED")
+
+	result := make([]string, 0, values.Len())
+	for i := 0; i < values.Len(); i++ {
+		result = append(result, strings.ToLower(strings.TrimPrefix(string(values.Get(i).Name()), prefix)))
+	}
+	return result
+}
+
+func settingAttrType(fd protoreflect.FieldDescriptor) attr.Type {
+	if fd.Kind() == protoreflect.EnumKind {
+		return types.StringType
+	}
+	switch fd.Message().FullName() {
+	case "google.protobuf.BoolValue":
+		return types.BoolType
+	case "google.protobuf.DoubleValue":
+		return types.Float64Type
+	default:
+		return types.Int64Type
+	}
+}
+
+var settingsType = func() types.ObjectType {
+	attrTypes := make(map[string]attr.Type, len(settingNames))
+	for _, name := range settingNames {
+		attrTypes[name] = settingAttrType(settingField(name))
+	}
+	return types.ObjectType{AttrTypes: attrTypes}
+}()
+
+func settingsResourceAttributes() map[string]resourceschema.Attribute {
+	attributes := make(map[string]resourceschema.Attribute, len(settingNames))
+	for _, name := range settingNames {
+		fd := settingField(name)
+		switch settingAttrType(fd) {
+		case types.StringType:
+			attributes[name] = resourceschema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Validators:    []validator.String{stringvalidator.OneOf(settingEnumValues(fd)...)},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			}
+		case types.BoolType:
+			attributes[name] = resourceschema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			}
+		case types.Float64Type:
+			attributes[name] = resourceschema.Float64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Float64{float64planmodifier.UseStateForUnknown()},
+			}
+		default:
+			attributes[name] = resourceschema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			}
+		}
+	}
+	return attributes
+}
+
+func settingsDataSourceAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute, len(settingNames))
+	for name, t := range settingsType.AttrTypes {
+		switch t {
+		case types.StringType:
+			attributes[name] = schema.StringAttribute{Computed: true}
+		case types.BoolType:
+			attributes[name] = schema.BoolAttribute{Computed: true}
+		case types.Float64Type:
+			attributes[name] = schema.Float64Attribute{Computed: true}
+		default:
+			attributes[name] = schema.Int64Attribute{Computed: true}
+		}
+	}
+	return attributes
+}
+
+func settingsToState(settings *clickhouse.UserSettings) (types.Object, diag.Diagnostics) {
+	if settings == nil {
+		settings = &clickhouse.UserSettings{}
+	}
+	msg := settings.ProtoReflect()
+
+	values := make(map[string]attr.Value, len(settingNames))
+	for _, name := range settingNames {
+		fd := settingField(name)
+		if fd.Kind() == protoreflect.EnumKind {
+			enumValues := settingEnumValues(fd)
+			value := "unspecified"
+			if i := fd.Enum().Values().ByNumber(msg.Get(fd).Enum()); i != nil {
+				value = enumValues[i.Index()]
+			}
+			values[name] = types.StringValue(value)
+			continue
+		}
+
+		if !msg.Has(fd) {
+			values[name] = nullSettingValue(settingAttrType(fd))
+			continue
+		}
+		wrapped := msg.Get(fd).Message()
+		value := wrapped.Get(wrapped.Descriptor().Fields().ByName("value"))
+		switch settingAttrType(fd) {
+		case types.BoolType:
+			values[name] = types.BoolValue(value.Bool())
+		case types.Float64Type:
+			values[name] = types.Float64Value(value.Float())
+		default:
+			values[name] = types.Int64Value(value.Int())
+		}
+	}
+
+	return types.ObjectValue(settingsType.AttrTypes, values)
+}
+
+func nullSettingValue(t attr.Type) attr.Value {
+	switch t {
+	case types.StringType:
+		return types.StringNull()
+	case types.BoolType:
+		return types.BoolNull()
+	case types.Float64Type:
+		return types.Float64Null()
+	default:
+		return types.Int64Null()
+	}
+}
+
+// settingsFromState converts the known settings, the unknown ones are left for the server to choose.
+func settingsFromState(state types.Object) *clickhouse.UserSettings {
+	if state.IsNull() || state.IsUnknown() {
+		return nil
+	}
+
+	settings := &clickhouse.UserSettings{}
+	msg := settings.ProtoReflect()
+	for name, value := range state.Attributes() {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		fd := settingField(name)
+		switch v := value.(type) {
+		case types.String:
+			for i, enumValue := range settingEnumValues(fd) {
+				if enumValue == v.ValueString() {
+					msg.Set(fd, protoreflect.ValueOfEnum(fd.Enum().Values().Get(i).Number()))
+				}
+			}
+		case types.Bool:
+			msg.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Bool(v.ValueBool()).ProtoReflect()))
+		case types.Float64:
+			msg.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Double(v.ValueFloat64()).ProtoReflect()))
+		case types.Int64:
+			msg.Set(fd, protoreflect.ValueOfMessage(wrapperspb.Int64(v.ValueInt64()).ProtoReflect()))
+		}
+	}
+	return settings
+}
+
+// mergeSettings keeps the planned settings and takes the ones left for the server to choose from the actual ones.
+func mergeSettings(planned, actual types.Object) types.Object {
+	if planned.IsNull() || planned.IsUnknown() || actual.IsNull() {
+		return planned
+	}
+
+	values := make(map[string]attr.Value, len(settingNames))
+	for name, value := range planned.Attributes() {
+		if value.IsUnknown() {
+			value = actual.Attributes()[name]
+		}
+		values[name] = value
+	}
+	return types.ObjectValueMust(settingsType.AttrTypes, values)
+}
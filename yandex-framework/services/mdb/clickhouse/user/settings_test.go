@@ -0,0 +1,71 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestSettingEnumValues(t *testing.T) {
+	assert.Equal(t, []string{"unspecified", "throw", "break"}, settingEnumValues(settingField("read_overflow_mode")))
+	assert.Equal(t, []string{"unspecified", "default", "keyed", "keyed_by_ip"}, settingEnumValues(settingField("quota_mode")))
+	assert.Equal(t,
+		[]string{"unspecified", "uniq", "uniq_combined", "uniq_combined_64", "uniq_hll_12", "uniq_exact"},
+		settingEnumValues(settingField("count_distinct_implementation")),
+	)
+}
+
+func TestSettingsRoundTrip(t *testing.T) {
+	settings := &clickhouse.UserSettings{
+		Readonly:                        wrapperspb.Int64(1),
+		AllowDdl:                        wrapperspb.Bool(false),
+		MaxMemoryUsage:                  wrapperspb.Int64(0),
+		MemoryProfilerSampleProbability: wrapperspb.Double(0.5),
+		ReadOverflowMode:                clickhouse.UserSettings_OVERFLOW_MODE_BREAK,
+		QuotaMode:                       clickhouse.UserSettings_QUOTA_MODE_KEYED_BY_IP,
+	}
+
+	state, diags := settingsToState(settings)
+	require.False(t, diags.HasError())
+
+	attributes := state.Attributes()
+	assert.Equal(t, types.Int64Value(1), attributes["readonly"])
+	assert.Equal(t, types.BoolValue(false), attributes["allow_ddl"])
+	assert.Equal(t, types.Int64Value(0), attributes["max_memory_usage"])
+	assert.Equal(t, types.Float64Value(0.5), attributes["memory_profiler_sample_probability"])
+	assert.Equal(t, types.StringValue("break"), attributes["read_overflow_mode"])
+	assert.Equal(t, types.StringValue("keyed_by_ip"), attributes["quota_mode"])
+	assert.Equal(t, types.StringValue("unspecified"), attributes["sort_overflow_mode"])
+	assert.True(t, attributes["max_threads"].IsNull())
+
+	assert.True(t, proto.Equal(settings, settingsFromState(state)))
+}
+
+func TestMergeSettings(t *testing.T) {
+	actual, diags := settingsToState(&clickhouse.UserSettings{
+		Readonly:   wrapperspb.Int64(2),
+		MaxThreads: wrapperspb.Int64(8),
+	})
+	require.False(t, diags.HasError())
+
+	values := map[string]attr.Value{}
+	for name, t := range settingsType.AttrTypes {
+		values[name] = nullSettingValue(t)
+	}
+	values["readonly"] = types.Int64Value(2)
+	values["max_threads"] = types.Int64Unknown()
+	planned := types.ObjectValueMust(settingsType.AttrTypes, values)
+
+	merged := mergeSettings(planned, actual)
+	assert.Equal(t, types.Int64Value(2), merged.Attributes()["readonly"])
+	assert.Equal(t, types.Int64Value(8), merged.Attributes()["max_threads"])
+	assert.True(t, merged.Attributes()["connect_timeout"].IsNull())
+
+	assert.Equal(t, types.ObjectNull(settingsType.AttrTypes), mergeSettings(types.ObjectNull(settingsType.AttrTypes), actual))
+}
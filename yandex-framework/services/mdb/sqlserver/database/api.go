@@ -0,0 +1,98 @@
+package database
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/retry"
+)
+
+func readDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, dbName string) *sqlserver.Database {
+	db, err := sdk.MDB().SQLServer().Database().Get(ctx, &sqlserver.GetDatabaseRequest{
+		ClusterId:    cid,
+		DatabaseName: dbName,
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Read resource",
+			"Error while requesting API to get SQL Server database:"+err.Error(),
+		)
+		return nil
+	}
+	return db
+}
+
+func createDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid, dbName string) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().SQLServer().Database().Create(ctx, &sqlserver.CreateDatabaseRequest{
+			ClusterId: cid,
+			DatabaseSpec: &sqlserver.DatabaseSpec{
+				Name: dbName,
+			},
+		})
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to create SQL Server database:"+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to create SQL Server database:"+err.Error(),
+		)
+	}
+}
+
+func restoreDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, request *sqlserver.RestoreDatabaseRequest) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().SQLServer().Database().Restore(ctx, request)
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to restore SQL Server database:"+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to restore SQL Server database:"+err.Error(),
+		)
+	}
+}
+
+func deleteDatabase(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, dbName string) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().SQLServer().Database().Delete(ctx, &sqlserver.DeleteDatabaseRequest{
+			ClusterId:    cid,
+			DatabaseName: dbName,
+		})
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Delete resource",
+			"Error while requesting API to delete SQL Server database: "+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Delete resource",
+			"Error while waiting for operation to delete SQL Server database: "+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,76 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+)
+
+type bindingDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewDataSource() datasource.DataSource {
+	return &bindingDataSource{}
+}
+
+func (d *bindingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_sqlserver_database"
+}
+
+func (d *bindingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func (d *bindingDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *bindingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DataSourceDatabase
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	dbName := state.Name.ValueString()
+	db := readDatabase(ctx, d.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ClusterID = types.StringValue(db.ClusterId)
+	state.Name = types.StringValue(db.Name)
+	state.Id = types.StringValue(resourceid.Construct(cid, dbName))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
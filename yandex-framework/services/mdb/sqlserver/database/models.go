@@ -0,0 +1,46 @@
+package database
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type Database struct {
+	Id        types.String `tfsdk:"id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+	Restore   types.Object `tfsdk:"restore"`
+}
+
+type DataSourceDatabase struct {
+	Id        types.String `tfsdk:"id"`
+	ClusterID types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
+type Restore struct {
+	BackupID     types.String `tfsdk:"backup_id"`
+	FromDatabase types.String `tfsdk:"from_database"`
+	Time         types.String `tfsdk:"time"`
+}
+
+var restoreType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"backup_id":     types.StringType,
+		"from_database": types.StringType,
+		"time":          types.StringType,
+	},
+}
+
+// restoreTimeFormat is the format of the point-in-time recovery timestamp, the same as in the cluster restore blocks.
+const restoreTimeFormat = "2006-01-02T15:04:05"
+
+// parseRestoreTime parses the point-in-time recovery timestamp, the current time is used if it is not set.
+func parseRestoreTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Now(), nil
+	}
+	return time.Parse(restoreTimeFormat, s)
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreRequestFromPlan(t *testing.T) {
+	plan := &Database{
+		ClusterID: types.StringValue("cid"),
+		Name:      types.StringValue("restored"),
+		Restore: types.ObjectValueMust(restoreType.AttrTypes, map[string]attr.Value{
+			"backup_id":     types.StringValue("backup"),
+			"from_database": types.StringNull(),
+			"time":          types.StringValue("2024-06-01T10:20:30"),
+		}),
+	}
+
+	request, diags := restoreRequestFromPlan(context.Background(), plan)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "cid", request.ClusterId)
+	assert.Equal(t, "restored", request.DatabaseName)
+	assert.Equal(t, "restored", request.FromDatabase)
+	assert.Equal(t, "backup", request.BackupId)
+	assert.Equal(t, time.Date(2024, 6, 1, 10, 20, 30, 0, time.UTC), request.Time.AsTime())
+}
+
+func TestParseRestoreTime(t *testing.T) {
+	now := time.Now()
+	parsed, err := parseRestoreTime("")
+	require.NoError(t, err)
+	assert.False(t, parsed.Before(now))
+
+	_, err = parseRestoreTime("2024-06-01 10:20:30")
+	assert.Error(t, err)
+}
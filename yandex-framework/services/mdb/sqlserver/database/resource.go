@@ -0,0 +1,259 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type bindingResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &bindingResource{}
+}
+
+func (r *bindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_sqlserver_database"
+}
+
+func (r *bindingResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *bindingResource) Schema(_ context.Context,
+	_ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"restore": schema.SingleNestedBlock{
+				Description: "Restore the database from a backup of the cluster instead of creating an empty one.",
+				Attributes: map[string]schema.Attribute{
+					"backup_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"from_database": schema.StringAttribute{
+						Optional: true,
+					},
+					"time": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplaceIf(
+						restoreChanged,
+						"Changing restore of a restored database recreates it.",
+						"Changing restore of a restored database recreates it.",
+					),
+				},
+			},
+		},
+	}
+}
+
+// restoreChanged requires the replacement only for the databases which were restored by the provider,
+// so the restore block can be added to the imported ones.
+func restoreChanged(_ context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func (r *bindingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config Database
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Restore.IsNull() || config.Restore.IsUnknown() {
+		return
+	}
+
+	var restore Restore
+	resp.Diagnostics.Append(config.Restore.As(ctx, &restore, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if restore.BackupID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("restore").AtName("backup_id"),
+			"Missing Attribute",
+			"backup_id is required to restore the database",
+		)
+	}
+	if restore.Time.IsUnknown() {
+		return
+	}
+	if _, err := parseRestoreTime(restore.Time.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("restore").AtName("time"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("time should be in the %q format: %s", restoreTimeFormat, err),
+		)
+	}
+}
+
+func (r *bindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Database
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	dbName := state.Name.ValueString()
+	db := readDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ClusterID = types.StringValue(db.ClusterId)
+	state.Name = types.StringValue(db.Name)
+
+	state.Id = types.StringValue(resourceid.Construct(cid, dbName))
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *bindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Database
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := plan.ClusterID.ValueString()
+	dbName := plan.Name.ValueString()
+	if plan.Restore.IsNull() {
+		createDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
+	} else {
+		request, diags := restoreRequestFromPlan(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		restoreDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, request)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(resourceid.Construct(cid, dbName))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func restoreRequestFromPlan(ctx context.Context, plan *Database) (*sqlserver.RestoreDatabaseRequest, diag.Diagnostics) {
+	var restore Restore
+	diags := plan.Restore.As(ctx, &restore, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	restoreTime, err := parseRestoreTime(restore.Time.ValueString())
+	if err != nil {
+		diags.AddError("Failed to Create resource", "Error while parsing restore time: "+err.Error())
+		return nil, diags
+	}
+
+	fromDatabase := restore.FromDatabase.ValueString()
+	if fromDatabase == "" {
+		fromDatabase = plan.Name.ValueString()
+	}
+	return &sqlserver.RestoreDatabaseRequest{
+		ClusterId:    plan.ClusterID.ValueString(),
+		DatabaseName: plan.Name.ValueString(),
+		FromDatabase: fromDatabase,
+		BackupId:     restore.BackupID.ValueString(),
+		Time:         timestamppb.New(restoreTime),
+	}, diags
+}
+
+// Update when the restore block is added to an existing database, it is only used on creation
+func (r *bindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Database
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *bindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Database
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterID.ValueString()
+	dbName := state.Name.ValueString()
+	deleteDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, dbName)
+}
+
+func (r *bindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterId, dbName, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+	db := readDatabase(ctx, r.providerConfig.SDK, &resp.Diagnostics, clusterId, dbName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := Database{
+		Restore: types.ObjectNull(restoreType.AttrTypes),
+	}
+	state.ClusterID = types.StringValue(db.ClusterId)
+	state.Name = types.StringValue(db.Name)
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,167 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+)
+
+type User struct {
+	Id         types.String `tfsdk:"id"`
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	Name       types.String `tfsdk:"name"`
+	Password   types.String `tfsdk:"password"`
+	Permission types.Set    `tfsdk:"permission"`
+}
+
+type Permission struct {
+	DatabaseName types.String `tfsdk:"database_name"`
+	Roles        types.Set    `tfsdk:"roles"`
+}
+
+var permissionType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"database_name": types.StringType,
+		"roles":         types.SetType{ElemType: types.StringType},
+	},
+}
+
+// roleNames are the database roles without the DB_ prefix, the same as in the cluster user block.
+var roleNames = func() []string {
+	var names []string
+	for i := 1; i < len(sqlserver.Permission_Role_name); i++ {
+		names = append(names, roleToState(sqlserver.Permission_Role(i)))
+	}
+	return names
+}()
+
+func roleToState(role sqlserver.Permission_Role) string {
+	return strings.TrimPrefix(role.String(), "DB_")
+}
+
+func roleFromState(role string) sqlserver.Permission_Role {
+	return sqlserver.Permission_Role(sqlserver.Permission_Role_value["DB_"+role])
+}
+
+func userToState(user *sqlserver.User, state *User) diag.Diagnostics {
+	state.Name = types.StringValue(user.Name)
+	state.ClusterID = types.StringValue(user.ClusterId)
+
+	return permissionsToState(user.Permissions, state)
+}
+
+func permissionsToState(permissions []*sqlserver.Permission, state *User) diag.Diagnostics {
+	var permissionValues []attr.Value
+
+	var diags diag.Diagnostics
+	for _, permission := range permissions {
+		roles := types.SetNull(types.StringType)
+		if len(permission.Roles) > 0 {
+			var stateRoles []attr.Value
+			for _, role := range permission.Roles {
+				stateRoles = append(stateRoles, types.StringValue(roleToState(role)))
+			}
+
+			value, diagnostics := types.SetValue(types.StringType, stateRoles)
+			diags.Append(diagnostics...)
+			roles = value
+		}
+		permissionValue, diagnostics := types.ObjectValue(permissionType.AttrTypes, map[string]attr.Value{
+			"database_name": types.StringValue(permission.DatabaseName),
+			"roles":         roles,
+		})
+
+		permissionValues = append(permissionValues, permissionValue)
+		diags.Append(diagnostics...)
+	}
+
+	value, diagnostics := types.SetValue(permissionType, permissionValues)
+	diags.Append(diagnostics...)
+
+	state.Permission = value
+	return diags
+}
+
+func userFromState(ctx context.Context, state *User) (*sqlserver.UserSpec, diag.Diagnostics) {
+	permissions, diags := permissionsFromState(ctx, state)
+	return &sqlserver.UserSpec{
+		Name:        state.Name.ValueString(),
+		Password:    state.Password.ValueString(),
+		Permissions: permissions,
+	}, diags
+}
+
+func permissionsFromState(ctx context.Context, state *User) ([]*sqlserver.Permission, diag.Diagnostics) {
+	permissions := make([]*sqlserver.Permission, 0, len(state.Permission.Elements()))
+	permissionsType := make([]Permission, 0, len(state.Permission.Elements()))
+	diags := state.Permission.ElementsAs(ctx, &permissionsType, false)
+
+	for _, permission := range permissionsType {
+		roles := make([]string, 0, len(permission.Roles.Elements()))
+		diags.Append(permission.Roles.ElementsAs(ctx, &roles, false)...)
+
+		permissionSpec := &sqlserver.Permission{
+			DatabaseName: permission.DatabaseName.ValueString(),
+		}
+		for _, role := range roles {
+			permissionSpec.Roles = append(permissionSpec.Roles, roleFromState(role))
+		}
+		permissions = append(permissions, permissionSpec)
+	}
+	return permissions, diags
+}
+
+// permissionChanges returns the roles to grant and to revoke per database to get from the state
+// permissions to the planned ones.
+func permissionChanges(state, plan []*sqlserver.Permission) (grants, revokes []*sqlserver.Permission) {
+	stateRoles := permissionRoles(state)
+	planRoles := permissionRoles(plan)
+
+	for _, permission := range plan {
+		roles, ok := stateRoles[permission.DatabaseName]
+		if !ok {
+			grants = append(grants, permission)
+			continue
+		}
+		if added := rolesDifference(permission.Roles, roles); len(added) > 0 {
+			grants = append(grants, &sqlserver.Permission{DatabaseName: permission.DatabaseName, Roles: added})
+		}
+	}
+	for _, permission := range state {
+		roles, ok := planRoles[permission.DatabaseName]
+		if !ok {
+			revokes = append(revokes, permission)
+			continue
+		}
+		if removed := rolesDifference(permission.Roles, roles); len(removed) > 0 {
+			revokes = append(revokes, &sqlserver.Permission{DatabaseName: permission.DatabaseName, Roles: removed})
+		}
+	}
+	return grants, revokes
+}
+
+func permissionRoles(permissions []*sqlserver.Permission) map[string]map[sqlserver.Permission_Role]struct{} {
+	result := make(map[string]map[sqlserver.Permission_Role]struct{}, len(permissions))
+	for _, permission := range permissions {
+		roles := make(map[sqlserver.Permission_Role]struct{}, len(permission.Roles))
+		for _, role := range permission.Roles {
+			roles[role] = struct{}{}
+		}
+		result[permission.DatabaseName] = roles
+	}
+	return result
+}
+
+func rolesDifference(roles []sqlserver.Permission_Role, exclude map[sqlserver.Permission_Role]struct{}) []sqlserver.Permission_Role {
+	var result []sqlserver.Permission_Role
+	for _, role := range roles {
+		if _, ok := exclude[role]; !ok {
+			result = append(result, role)
+		}
+	}
+	return result
+}
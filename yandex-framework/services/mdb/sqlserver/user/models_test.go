@@ -0,0 +1,45 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+)
+
+func TestRoleNames(t *testing.T) {
+	assert.Contains(t, roleNames, "OWNER")
+	assert.Contains(t, roleNames, "DENYDATAREADER")
+	assert.NotContains(t, roleNames, "ROLE_UNSPECIFIED")
+
+	for _, name := range roleNames {
+		assert.Equal(t, name, roleToState(roleFromState(name)))
+	}
+}
+
+func TestPermissionChanges(t *testing.T) {
+	state := []*sqlserver.Permission{
+		{DatabaseName: "kept", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAREADER}},
+		{DatabaseName: "changed", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAREADER, sqlserver.Permission_DB_DATAWRITER}},
+		{DatabaseName: "removed", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_OWNER}},
+	}
+	plan := []*sqlserver.Permission{
+		{DatabaseName: "kept", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAREADER}},
+		{DatabaseName: "changed", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAREADER, sqlserver.Permission_DB_DDLADMIN}},
+		{DatabaseName: "added", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_OWNER}},
+	}
+
+	grants, revokes := permissionChanges(state, plan)
+	assert.Equal(t, []*sqlserver.Permission{
+		{DatabaseName: "changed", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_DDLADMIN}},
+		{DatabaseName: "added", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_OWNER}},
+	}, grants)
+	assert.Equal(t, []*sqlserver.Permission{
+		{DatabaseName: "changed", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAWRITER}},
+		{DatabaseName: "removed", Roles: []sqlserver.Permission_Role{sqlserver.Permission_DB_OWNER}},
+	}, revokes)
+
+	grants, revokes = permissionChanges(plan, plan)
+	assert.Empty(t, grants)
+	assert.Empty(t, revokes)
+}
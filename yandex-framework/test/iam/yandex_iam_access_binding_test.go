@@ -0,0 +1,168 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/accessbinding"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+)
+
+const (
+	serviceAccountResourceType = "iam.serviceAccount"
+	serviceAccountResourceName = "yandex_iam_service_account.test-sa"
+)
+
+func TestAccIamAccessBinding_basic(t *testing.T) {
+	var (
+		saName = test.ResourceName(63)
+		role   = "iam.serviceAccounts.user"
+		member = "userAccount:" + test.GetExampleUserID1()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamAccessBindingConfig(saName, role, member),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIamAccessBindingMembers(serviceAccountResourceName, role, []string{member}),
+					resource.TestCheckResourceAttr("yandex_iam_access_binding.test-binding", "resource_type", serviceAccountResourceType),
+					resource.TestCheckResourceAttrPair("yandex_iam_access_binding.test-binding", "resource_id", serviceAccountResourceName, "id"),
+				),
+			},
+			{
+				ResourceName: "yandex_iam_access_binding.test-binding",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := test.ImportIamBindingIdFunc(serviceAccountResourceName, role)(s)
+					return serviceAccountResourceType + "," + id, err
+				},
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_id",
+			},
+			{
+				Config: testAccIamAccessBindingConfig(saName, role, ""),
+				Check:  testAccCheckIamAccessBindingMembers(serviceAccountResourceName, role, nil),
+			},
+		},
+	})
+}
+
+func TestAccIamAccessMember_basic(t *testing.T) {
+	var (
+		saName = test.ResourceName(63)
+		role   = "iam.serviceAccounts.user"
+		member = "userAccount:" + test.GetExampleUserID1()
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamAccessMemberConfig(saName, role, member),
+				Check:  testAccCheckIamAccessBindingMembers(serviceAccountResourceName, role, []string{member}),
+			},
+			{
+				ResourceName: "yandex_iam_access_member.test-member",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id, err := test.ImportIamBindingIdFunc(serviceAccountResourceName, role)(s)
+					return serviceAccountResourceType + "," + id + "," + member, err
+				},
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "resource_id",
+			},
+			{
+				Config: testAccIamAccessMemberConfig(saName, role, ""),
+				Check:  testAccCheckIamAccessBindingMembers(serviceAccountResourceName, role, nil),
+			},
+		},
+	})
+}
+
+func testAccCheckIamAccessBindingMembers(resourceName, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		client, err := accessbinding.GetAccessBindingsClient(config.SDK, serviceAccountResourceType)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.ListAccessBindings(context.Background(), &access.ListAccessBindingsRequest{
+			ResourceId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		var roleMembers []string
+		for _, b := range resp.AccessBindings {
+			if b.RoleId == role {
+				roleMembers = append(roleMembers, b.Subject.Type+":"+b.Subject.Id)
+			}
+		}
+		sort.Strings(members)
+		sort.Strings(roleMembers)
+
+		if !reflect.DeepEqual(members, roleMembers) {
+			return fmt.Errorf("expected members of role %s: %v, got %v", role, members, roleMembers)
+		}
+		return nil
+	}
+}
+
+func testAccIamAccessBindingConfig(saName, role, member string) string {
+	config := fmt.Sprintf(`
+resource "yandex_iam_service_account" "test-sa" {
+  name = "%s"
+}
+`, saName)
+
+	if member != "" {
+		config += fmt.Sprintf(`
+resource "yandex_iam_access_binding" "test-binding" {
+  resource_type = "%s"
+  resource_id   = yandex_iam_service_account.test-sa.id
+  role          = "%s"
+  members       = ["%s"]
+}
+`, serviceAccountResourceType, role, member)
+	}
+	return config
+}
+
+func testAccIamAccessMemberConfig(saName, role, member string) string {
+	config := fmt.Sprintf(`
+resource "yandex_iam_service_account" "test-sa" {
+  name = "%s"
+}
+`, saName)
+
+	if member != "" {
+		config += fmt.Sprintf(`
+resource "yandex_iam_access_member" "test-member" {
+  resource_type = "%s"
+  resource_id   = yandex_iam_service_account.test-sa.id
+  role          = "%s"
+  member        = "%s"
+}
+`, serviceAccountResourceType, role, member)
+	}
+	return config
+}
@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	chtpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/clickhouse"
+)
+
+const (
+	chDatabaseResourceName       = "yandex_mdb_clickhouse_database.testdb"
+	chDatabaseResourceName1      = "yandex_mdb_clickhouse_database.testdb1"
+	testClickHouseDatabasePrefix = "tf-clickhouse-database"
+	chClusterResourceName        = "yandex_mdb_clickhouse_cluster.foo"
+)
+
+// Test that a ClickHouse Database can be created, replaced and destroyed
+func TestAccMDBClickHouseDatabase_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix(testClickHouseDatabasePrefix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBClickHouseDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseDatabaseConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chDatabaseResourceName, "name", "testdb"),
+					testAccCheckMDBClickHouseClusterHasDatabase(t, "testdb"),
+				),
+			},
+			mdbClickHouseDatabaseImportStep(chDatabaseResourceName),
+			{
+				Config: testAccMDBClickHouseDatabaseConfigStep2(clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMDBClickHouseClusterHasDatabase(t, "renamed_testdb"),
+				),
+			},
+			mdbClickHouseDatabaseImportStep(chDatabaseResourceName),
+			{
+				Config: testAccMDBClickHouseDatabaseConfigStep3(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chDatabaseResourceName1, "name", "testdb1"),
+					resource.TestCheckResourceAttr(chDatabaseResourceName, "name", "testdb"),
+					// the cluster does not take over the databases
+					resource.TestCheckResourceAttr(chClusterResourceName, "database.#", "0"),
+				),
+			},
+			mdbClickHouseDatabaseImportStep(chDatabaseResourceName1),
+		},
+	})
+}
+
+func mdbClickHouseDatabaseImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
+
+func testAccLoadClickHouseDatabase(s *terraform.State, dbname string) (*clickhouse.Database, error) {
+	rs, ok := s.RootModule().Resources[chClusterResourceName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource %q not found", chClusterResourceName)
+	}
+	if rs.Primary.ID == "" {
+		return nil, fmt.Errorf("no ID is set")
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	return config.SDK.MDB().Clickhouse().Database().Get(context.Background(), &clickhouse.GetDatabaseRequest{
+		ClusterId:    rs.Primary.ID,
+		DatabaseName: dbname,
+	})
+}
+
+func testAccCheckMDBClickHouseClusterHasDatabase(t *testing.T, dbname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db, err := testAccLoadClickHouseDatabase(s, dbname)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, db.Name, dbname)
+		return nil
+	}
+}
+
+// Create database
+func testAccMDBClickHouseDatabaseConfigStep1(name string) string {
+	return chtpl.ClusterConfig(name, "ClickHouse Database Terraform Test") + `
+resource "yandex_mdb_clickhouse_database" "testdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
+
+// Database rename recreates it
+func testAccMDBClickHouseDatabaseConfigStep2(name string) string {
+	return chtpl.ClusterConfig(name, "ClickHouse Database Terraform Test") + `
+resource "yandex_mdb_clickhouse_database" "testdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "renamed_testdb"
+}
+`
+}
+
+// Create another database
+func testAccMDBClickHouseDatabaseConfigStep3(name string) string {
+	return chtpl.ClusterConfig(name, "ClickHouse Database Terraform Test") + `
+resource "yandex_mdb_clickhouse_database" "testdb1" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb1"
+}
+
+resource "yandex_mdb_clickhouse_database" "testdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
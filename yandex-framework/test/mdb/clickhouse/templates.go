@@ -0,0 +1,43 @@
+package clickhouse
+
+import "fmt"
+
+const VPCDependencies = `
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+`
+
+// ClusterConfig is a ClickHouse cluster leaving its databases and users to the standalone resources.
+func ClusterConfig(name, description string) string {
+	return fmt.Sprintf(VPCDependencies+`
+resource "yandex_mdb_clickhouse_cluster" "foo" {
+	name        = "%s"
+	description = "%s"
+	environment = "PRESTABLE"
+	version     = "23.8"
+	network_id  = yandex_vpc_network.foo.id
+
+	manage_databases = false
+	manage_users     = false
+
+	clickhouse {
+		resources {
+			resource_preset_id = "s2.micro"
+			disk_type_id       = "network-ssd"
+			disk_size          = 16
+		}
+	}
+
+	host {
+		type      = "CLICKHOUSE"
+		zone      = "ru-central1-a"
+		subnet_id = yandex_vpc_subnet.foo.id
+	}
+}
+`, name, description)
+}
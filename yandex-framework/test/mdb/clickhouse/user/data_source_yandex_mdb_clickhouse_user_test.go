@@ -0,0 +1,62 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	chtpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/clickhouse"
+)
+
+func TestAccDataSourceMDBClickHouseUser_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-clickhouse-user")
+	description := "ClickHouse User Terraform Datasource Test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBClickHouseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBClickHouseUserConfig(clusterName, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_clickhouse_user.bar", "id", "yandex_mdb_clickhouse_user.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "name", "foo"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "permission.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "permission.0.database_name", "testdb"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "settings.max_threads", "2"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_clickhouse_user.bar", "generate_password", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBClickHouseUserConfig(name string, description string) string {
+	return chtpl.ClusterConfig(name, description) + `
+resource "yandex_mdb_clickhouse_database" "testdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb"
+}
+
+resource "yandex_mdb_clickhouse_user" "foo" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "foo"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_clickhouse_database.testdb.name
+	}
+	settings {
+		max_threads = 2
+	}
+}
+
+data "yandex_mdb_clickhouse_user" "bar" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = yandex_mdb_clickhouse_user.foo.name
+}
+`
+}
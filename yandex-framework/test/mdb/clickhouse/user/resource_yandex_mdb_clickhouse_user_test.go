@@ -0,0 +1,227 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	chtpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/clickhouse"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	chClusterResourceName     = "yandex_mdb_clickhouse_cluster.foo"
+	chUserResourceNameAlice   = "yandex_mdb_clickhouse_user.alice"
+	chUserResourceNameBob     = "yandex_mdb_clickhouse_user.bob"
+	chUserResourceNameCharlie = "yandex_mdb_clickhouse_user.charlie"
+)
+
+// Test that a ClickHouse User can be created, updated and destroyed
+func TestAccMDBClickHouseUser_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-clickhouse-user")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBClickHouseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBClickHouseUserConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chUserResourceNameAlice, "name", "alice"),
+					testAccCheckMDBClickHouseUserHasPermission(t, "alice", []string{"testdb"}),
+				),
+			},
+			mdbClickHouseUserImportStep(chUserResourceNameAlice),
+			{
+				Config: testAccMDBClickHouseUserConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chUserResourceNameAlice, "settings.max_threads", "4"),
+					resource.TestCheckResourceAttr(chUserResourceNameAlice, "settings.read_overflow_mode", "break"),
+					resource.TestCheckResourceAttr(chUserResourceNameAlice, "quota.#", "1"),
+					resource.TestCheckResourceAttr(chUserResourceNameBob, "name", "bob"),
+					testAccCheckMDBClickHouseUserHasPermission(t, "bob", []string{"testdb", "otherdb"}),
+					testAccCheckMDBClickHouseUserSettings(t, "alice", func(t *testing.T, settings *clickhouse.UserSettings) {
+						assert.Equal(t, int64(4), settings.GetMaxThreads().GetValue())
+						assert.Equal(t, clickhouse.UserSettings_OVERFLOW_MODE_BREAK, settings.GetReadOverflowMode())
+					}),
+					// the cluster does not take over the users
+					resource.TestCheckResourceAttr(chClusterResourceName, "user.#", "0"),
+				),
+			},
+			mdbClickHouseUserImportStep(chUserResourceNameBob),
+			{
+				Config: testAccMDBClickHouseUserConfigStep3(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(chUserResourceNameCharlie, "generate_password", "true"),
+					resource.TestCheckResourceAttrSet(chUserResourceNameCharlie, "connection_manager.connection_id"),
+				),
+			},
+		},
+	})
+}
+
+func mdbClickHouseUserImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"password", // password is not returned
+			"settings", // settings are read once they are configured
+		},
+	}
+}
+
+func testAccLoadClickHouseUser(s *terraform.State, username string) (*clickhouse.User, error) {
+	rs, ok := s.RootModule().Resources[chClusterResourceName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource %q not found", chClusterResourceName)
+	}
+	if rs.Primary.ID == "" {
+		return nil, fmt.Errorf("no ID is set")
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	return config.SDK.MDB().Clickhouse().User().Get(context.Background(), &clickhouse.GetUserRequest{
+		ClusterId: rs.Primary.ID,
+		UserName:  username,
+	})
+}
+
+func testAccCheckMDBClickHouseUserHasPermission(t *testing.T, username string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := testAccLoadClickHouseUser(s, username)
+		if err != nil {
+			return err
+		}
+		var actual []string
+		for _, permission := range user.Permissions {
+			actual = append(actual, permission.DatabaseName)
+		}
+		slices.Sort(actual)
+		slices.Sort(expected)
+		assert.Equal(t, expected, actual)
+		return nil
+	}
+}
+
+func testAccCheckMDBClickHouseUserSettings(t *testing.T, username string, check func(*testing.T, *clickhouse.UserSettings)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := testAccLoadClickHouseUser(s, username)
+		if err != nil {
+			return err
+		}
+		check(t, user.Settings)
+		return nil
+	}
+}
+
+func testAccCheckMDBClickHouseUserDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_clickhouse_user" {
+			continue
+		}
+
+		clusterId, userName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.SDK.MDB().Clickhouse().User().Get(context.Background(), &clickhouse.GetUserRequest{
+			ClusterId: clusterId,
+			UserName:  userName,
+		})
+
+		if err == nil {
+			return fmt.Errorf("ClickHouse User still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBClickHouseUserConfigStep0(name string) string {
+	return chtpl.ClusterConfig(name, "ClickHouse User Terraform Test") + `
+resource "yandex_mdb_clickhouse_database" "testdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "testdb"
+}
+
+resource "yandex_mdb_clickhouse_database" "otherdb" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "otherdb"
+}
+`
+}
+
+// Create cluster, databases and user
+func testAccMDBClickHouseUserConfigStep1(name string) string {
+	return testAccMDBClickHouseUserConfigStep0(name) + `
+resource "yandex_mdb_clickhouse_user" "alice" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "alice"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_clickhouse_database.testdb.name
+	}
+}`
+}
+
+// Change Alice's settings and quotas, create another user
+func testAccMDBClickHouseUserConfigStep2(name string) string {
+	return testAccMDBClickHouseUserConfigStep0(name) + `
+resource "yandex_mdb_clickhouse_user" "alice" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "alice"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_clickhouse_database.testdb.name
+	}
+	settings {
+		max_threads        = 4
+		read_overflow_mode = "break"
+	}
+	quota {
+		interval_duration = 3600000
+		queries           = 1000
+		errors            = 100
+	}
+}
+
+resource "yandex_mdb_clickhouse_user" "bob" {
+	cluster_id = yandex_mdb_clickhouse_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_clickhouse_database.testdb.name
+	}
+	permission {
+		database_name = yandex_mdb_clickhouse_database.otherdb.name
+	}
+}`
+}
+
+// Create a user with a generated password
+func testAccMDBClickHouseUserConfigStep3(name string) string {
+	return testAccMDBClickHouseUserConfigStep0(name) + `
+resource "yandex_mdb_clickhouse_user" "charlie" {
+	cluster_id        = yandex_mdb_clickhouse_cluster.foo.id
+	name              = "charlie"
+	generate_password = true
+	permission {
+		database_name = yandex_mdb_clickhouse_database.testdb.name
+	}
+}`
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	sqlservertpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/sqlserver"
+)
+
+const (
+	sqlserverDatabaseResourceName  = "yandex_mdb_sqlserver_database.testdb"
+	sqlserverDatabaseResourceName1 = "yandex_mdb_sqlserver_database.testdb1"
+	testSQLServerDatabasePrefix    = "tf-sqlserver-database"
+	sqlserverClusterResourceName   = "yandex_mdb_sqlserver_cluster.foo"
+)
+
+// Test that a SQLServer Database can be created, replaced and destroyed
+func TestAccMDBSQLServerDatabase_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix(testSQLServerDatabasePrefix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBSQLServerDatabaseConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlserverDatabaseResourceName, "name", "testdb"),
+					testAccCheckMDBSQLServerClusterHasDatabase(t, "testdb"),
+				),
+			},
+			mdbSQLServerDatabaseImportStep(sqlserverDatabaseResourceName),
+			{
+				Config: testAccMDBSQLServerDatabaseConfigStep2(clusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMDBSQLServerClusterHasDatabase(t, "renamed_testdb"),
+				),
+			},
+			mdbSQLServerDatabaseImportStep(sqlserverDatabaseResourceName),
+			{
+				Config: testAccMDBSQLServerDatabaseConfigStep3(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlserverDatabaseResourceName1, "name", "testdb1"),
+					resource.TestCheckResourceAttr(sqlserverDatabaseResourceName, "name", "testdb"),
+					// the cluster does not take over the databases
+					resource.TestCheckResourceAttr(sqlserverClusterResourceName, "database.#", "0"),
+				),
+			},
+			mdbSQLServerDatabaseImportStep(sqlserverDatabaseResourceName1),
+			{
+				Config:      testAccMDBSQLServerDatabaseConfigInvalidRestore(clusterName),
+				ExpectError: regexp.MustCompile("time should be in the \"2006-01-02T15:04:05\" format"),
+			},
+		},
+	})
+}
+
+func mdbSQLServerDatabaseImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
+
+func testAccLoadSQLServerDatabase(s *terraform.State, dbname string) (*sqlserver.Database, error) {
+	rs, ok := s.RootModule().Resources[sqlserverClusterResourceName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource %q not found", sqlserverClusterResourceName)
+	}
+	if rs.Primary.ID == "" {
+		return nil, fmt.Errorf("no ID is set")
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	return config.SDK.MDB().SQLServer().Database().Get(context.Background(), &sqlserver.GetDatabaseRequest{
+		ClusterId:    rs.Primary.ID,
+		DatabaseName: dbname,
+	})
+}
+
+func testAccCheckMDBSQLServerClusterHasDatabase(t *testing.T, dbname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db, err := testAccLoadSQLServerDatabase(s, dbname)
+		if err != nil {
+			return err
+		}
+		assert.Equal(t, db.Name, dbname)
+		return nil
+	}
+}
+
+// Create database
+func testAccMDBSQLServerDatabaseConfigStep1(name string) string {
+	return sqlservertpl.ClusterConfig(name, "SQLServer Database Terraform Test") + `
+resource "yandex_mdb_sqlserver_database" "testdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
+
+// Database rename recreates it
+func testAccMDBSQLServerDatabaseConfigStep2(name string) string {
+	return sqlservertpl.ClusterConfig(name, "SQLServer Database Terraform Test") + `
+resource "yandex_mdb_sqlserver_database" "testdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "renamed_testdb"
+}
+`
+}
+
+// Create another database
+func testAccMDBSQLServerDatabaseConfigStep3(name string) string {
+	return sqlservertpl.ClusterConfig(name, "SQLServer Database Terraform Test") + `
+resource "yandex_mdb_sqlserver_database" "testdb1" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb1"
+}
+
+resource "yandex_mdb_sqlserver_database" "testdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb"
+}
+`
+}
+
+// Restore time is validated before the database is restored
+func testAccMDBSQLServerDatabaseConfigInvalidRestore(name string) string {
+	return sqlservertpl.ClusterConfig(name, "SQLServer Database Terraform Test") + `
+resource "yandex_mdb_sqlserver_database" "restored" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "restored"
+	restore {
+		backup_id = "backup_id"
+		time      = "2024-06-01 10:20:30"
+	}
+}
+`
+}
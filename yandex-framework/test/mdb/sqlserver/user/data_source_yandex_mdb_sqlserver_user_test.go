@@ -0,0 +1,59 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	sqlservertpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/sqlserver"
+)
+
+func TestAccDataSourceMDBSQLServerUser_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-sqlserver-user")
+	description := "SQLServer User Terraform Datasource Test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBSQLServerUserConfig(clusterName, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.yandex_mdb_sqlserver_user.bar", "id", "yandex_mdb_sqlserver_user.foo", "id"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_sqlserver_user.bar", "name", "foo"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_sqlserver_user.bar", "permission.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_sqlserver_user.bar", "permission.0.database_name", "testdb"),
+					resource.TestCheckTypeSetElemAttr("data.yandex_mdb_sqlserver_user.bar", "permission.0.roles.*", "DATAREADER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBSQLServerUserConfig(name string, description string) string {
+	return sqlservertpl.ClusterConfig(name, description) + `
+resource "yandex_mdb_sqlserver_database" "testdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb"
+}
+
+resource "yandex_mdb_sqlserver_user" "foo" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "foo"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_sqlserver_database.testdb.name
+		roles         = ["DATAREADER"]
+	}
+}
+
+data "yandex_mdb_sqlserver_user" "bar" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = yandex_mdb_sqlserver_user.foo.name
+}
+`
+}
@@ -0,0 +1,237 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test"
+	sqlservertpl "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/test/mdb/sqlserver"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	sqlserverClusterResourceName   = "yandex_mdb_sqlserver_cluster.foo"
+	sqlserverUserResourceNameAlice = "yandex_mdb_sqlserver_user.alice"
+	sqlserverUserResourceNameBob   = "yandex_mdb_sqlserver_user.bob"
+)
+
+type Permission struct {
+	DatabaseName string
+	Roles        []sqlserver.Permission_Role
+}
+
+// Test that a SQLServer User can be created, updated and destroyed
+func TestAccMDBSQLServerUser_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-sqlserver-user")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBSQLServerUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBSQLServerUserConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlserverUserResourceNameAlice, "name", "alice"),
+					testAccCheckMDBSQLServerUserHasPermission(t, "alice", nil),
+				),
+			},
+			mdbSQLServerUserImportStep(sqlserverUserResourceNameAlice),
+			{
+				Config: testAccMDBSQLServerUserConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(sqlserverUserResourceNameBob, "name", "bob"),
+					testAccCheckMDBSQLServerUserHasPermission(t, "bob", []Permission{{
+						DatabaseName: "testdb",
+						Roles:        []sqlserver.Permission_Role{sqlserver.Permission_DB_OWNER, sqlserver.Permission_DB_DDLADMIN},
+					}}),
+					// the cluster does not take over the users
+					resource.TestCheckResourceAttr(sqlserverClusterResourceName, "user.#", "0"),
+				),
+			},
+			mdbSQLServerUserImportStep(sqlserverUserResourceNameAlice),
+			mdbSQLServerUserImportStep(sqlserverUserResourceNameBob),
+			{
+				Config: testAccMDBSQLServerUserConfigStep3(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBSQLServerUserHasPermission(t, "alice", []Permission{{
+						DatabaseName: "testdb",
+						Roles:        []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAREADER},
+					}}),
+					testAccCheckMDBSQLServerUserHasPermission(t, "bob", []Permission{
+						{
+							DatabaseName: "testdb",
+							Roles:        []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAWRITER, sqlserver.Permission_DB_DDLADMIN},
+						},
+						{
+							DatabaseName: "otherdb",
+							Roles:        []sqlserver.Permission_Role{sqlserver.Permission_DB_DATAREADER},
+						},
+					}),
+				),
+			},
+			mdbSQLServerUserImportStep(sqlserverUserResourceNameAlice),
+			mdbSQLServerUserImportStep(sqlserverUserResourceNameBob),
+		},
+	})
+}
+
+func mdbSQLServerUserImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"password", // password is not returned
+		},
+	}
+}
+
+func testAccLoadSQLServerUser(s *terraform.State, username string) (*sqlserver.User, error) {
+	rs, ok := s.RootModule().Resources[sqlserverClusterResourceName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource %q not found", sqlserverClusterResourceName)
+	}
+	if rs.Primary.ID == "" {
+		return nil, fmt.Errorf("no ID is set")
+	}
+
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+	return config.SDK.MDB().SQLServer().User().Get(context.Background(), &sqlserver.GetUserRequest{
+		ClusterId: rs.Primary.ID,
+		UserName:  username,
+	})
+}
+
+func testAccCheckMDBSQLServerUserHasPermission(t *testing.T, username string, expected []Permission) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := testAccLoadSQLServerUser(s, username)
+		if err != nil {
+			return err
+		}
+		var actual []Permission
+		for _, permission := range user.Permissions {
+			slices.Sort(permission.Roles)
+			actual = append(actual, Permission{DatabaseName: permission.DatabaseName, Roles: permission.Roles})
+		}
+		for _, permission := range expected {
+			slices.Sort(permission.Roles)
+		}
+
+		cmp := func(a, b Permission) int {
+			if a.DatabaseName > b.DatabaseName {
+				return 1
+			} else if a.DatabaseName < b.DatabaseName {
+				return -1
+			} else {
+				return 0
+			}
+		}
+		slices.SortFunc(expected, cmp)
+		slices.SortFunc(actual, cmp)
+		assert.Equal(t, expected, actual)
+
+		return nil
+	}
+}
+
+func testAccCheckMDBSQLServerUserDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_sqlserver_user" {
+			continue
+		}
+
+		clusterId, userName, err := resourceid.Deconstruct(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = config.SDK.MDB().SQLServer().User().Get(context.Background(), &sqlserver.GetUserRequest{
+			ClusterId: clusterId,
+			UserName:  userName,
+		})
+
+		if err == nil {
+			return fmt.Errorf("SQLServer User still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBSQLServerUserConfigStep0(name string) string {
+	return sqlservertpl.ClusterConfig(name, "SQLServer User Terraform Test") + `
+resource "yandex_mdb_sqlserver_database" "testdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "testdb"
+}
+
+resource "yandex_mdb_sqlserver_database" "otherdb" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "otherdb"
+}
+`
+}
+
+// Create cluster, databases and user
+func testAccMDBSQLServerUserConfigStep1(name string) string {
+	return testAccMDBSQLServerUserConfigStep0(name) + `
+resource "yandex_mdb_sqlserver_user" "alice" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "alice"
+	password   = "mysecureP@ssw0rd"
+}`
+}
+
+// Create another user with roles in a database
+func testAccMDBSQLServerUserConfigStep2(name string) string {
+	return testAccMDBSQLServerUserConfigStep1(name) + `
+resource "yandex_mdb_sqlserver_user" "bob" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_sqlserver_database.testdb.name
+		roles         = ["OWNER", "DDLADMIN"]
+	}
+}`
+}
+
+// Grant Alice a role, change Bob's roles and grant him another database
+func testAccMDBSQLServerUserConfigStep3(name string) string {
+	return testAccMDBSQLServerUserConfigStep0(name) + `
+resource "yandex_mdb_sqlserver_user" "alice" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "alice"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_sqlserver_database.testdb.name
+		roles         = ["DATAREADER"]
+	}
+}
+
+resource "yandex_mdb_sqlserver_user" "bob" {
+	cluster_id = yandex_mdb_sqlserver_cluster.foo.id
+	name       = "bob"
+	password   = "mysecureP@ssw0rd"
+	permission {
+		database_name = yandex_mdb_sqlserver_database.testdb.name
+		roles         = ["DATAWRITER", "DDLADMIN"]
+	}
+	permission {
+		database_name = yandex_mdb_sqlserver_database.otherdb.name
+		roles         = ["DATAREADER"]
+	}
+}`
+}
@@ -0,0 +1,215 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/certificatemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+const yandexCMCertificateDnsChallengeTTL = 60
+
+// syncCMCertificateDnsChallenges creates the records for the pending DNS challenges of the certificate in
+// managed.dns_zone_id, and removes the records which are not needed anymore, e.g. once the certificate is issued.
+// The records are kept in dns_challenge_records, so that they are removed from the zone they were created in.
+func syncCMCertificateDnsChallenges(ctx context.Context, config *Config, d *schema.ResourceData, cert *certificatemanager.Certificate) error {
+	zoneID := d.Get("managed.0.dns_zone_id").(string)
+	created := d.Get("dns_challenge_records").([]interface{})
+	if zoneID == "" && len(created) == 0 {
+		return nil
+	}
+
+	var wanted []interface{}
+	if zoneID != "" && (cert.GetStatus() == certificatemanager.Certificate_VALIDATING || cert.GetStatus() == certificatemanager.Certificate_RENEWING) {
+		recordType, err := cmCertificateDnsChallengeRecordType(d.Get("managed.0.challenge_type").(string))
+		if err != nil {
+			return err
+		}
+
+		zone, err := getSDK(config).DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+			DnsZoneId: zoneID,
+		})
+		if err != nil {
+			return fmt.Errorf("error while reading DNS zone %q: %w", zoneID, err)
+		}
+
+		records, err := cmCertificateDnsChallengeRecords(cert.GetChallenges(), recordType, zone.GetZone())
+		if err != nil {
+			return err
+		}
+		wanted = flattenCMCertificateDnsChallengeRecords(zoneID, records)
+	}
+
+	for zoneID, rs := range expandCMCertificateDnsChallengeRecords(cmCertificateDnsChallengeRecordsDifference(created, wanted)) {
+		for _, r := range rs {
+			log.Printf("[INFO] removing %s record %q of certificate %q challenge from DNS zone %q", r.Type, r.Name, d.Id(), zoneID)
+			if err := removeDnsRecordSetData(ctx, config, zoneID, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	for zoneID, rs := range expandCMCertificateDnsChallengeRecords(wanted) {
+		for _, r := range rs {
+			log.Printf("[INFO] adding %s record %q of certificate %q challenge to DNS zone %q", r.Type, r.Name, d.Id(), zoneID)
+			if err := addDnsRecordSetData(ctx, config, zoneID, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	return d.Set("dns_challenge_records", wanted)
+}
+
+// waitCMCertificateValidation waits for the certificate to be issued after its challenges are passed.
+func waitCMCertificateValidation(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	return resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		cert, err := config.sdk.Certificates().Certificate().Get(ctx, &certificatemanager.GetCertificateRequest{
+			CertificateId: d.Id(),
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		switch cert.GetStatus() {
+		case certificatemanager.Certificate_ISSUED:
+			return nil
+		case certificatemanager.Certificate_VALIDATING, certificatemanager.Certificate_RENEWING:
+			return resource.RetryableError(fmt.Errorf("certificate %q is still %s", d.Id(), cert.GetStatus()))
+		default:
+			return resource.NonRetryableError(fmt.Errorf("certificate %q validation failed, it is %s", d.Id(), cert.GetStatus()))
+		}
+	})
+}
+
+func cmCertificateDnsChallengeRecordType(challengeTypeStr string) (string, error) {
+	challengeType, err := parseChallengeType(challengeTypeStr)
+	if err != nil {
+		return "", err
+	}
+	switch challengeType {
+	case CHALLENGE_TYPE_DNS_CNAME:
+		return "CNAME", nil
+	case CHALLENGE_TYPE_DNS_TXT:
+		return "TXT", nil
+	}
+	return "", fmt.Errorf("managed.dns_zone_id requires a DNS challenge type, got %s", challengeTypeStr)
+}
+
+// cmCertificateDnsChallengeRecords returns the records for the challenges of the type which are not passed yet,
+// grouped into record sets. All the records should belong to the zone.
+func cmCertificateDnsChallengeRecords(challenges []*certificatemanager.Challenge, recordType, zone string) ([]*dns.RecordSet, error) {
+	byName := map[string]*dns.RecordSet{}
+	var names []string
+	for _, challenge := range challenges {
+		if challenge.GetStatus() == certificatemanager.Challenge_VALID {
+			continue
+		}
+		dnsChallenge := challenge.GetDnsChallenge()
+		if dnsChallenge == nil || !strings.EqualFold(dnsChallenge.GetType(), recordType) {
+			continue
+		}
+
+		name := dnsChallenge.GetName()
+		if !isDnsNameInZone(name, zone) {
+			return nil, fmt.Errorf("challenge record %q for domain %q is not in DNS zone %q", name, challenge.GetDomain(), zone)
+		}
+
+		rs, ok := byName[name]
+		if !ok {
+			rs = &dns.RecordSet{
+				Name: name,
+				Type: recordType,
+				Ttl:  yandexCMCertificateDnsChallengeTTL,
+			}
+			byName[name] = rs
+			names = append(names, name)
+		}
+		if !slices.Contains(rs.Data, dnsChallenge.GetValue()) {
+			rs.Data = append(rs.Data, dnsChallenge.GetValue())
+		}
+	}
+
+	sort.Strings(names)
+	records := make([]*dns.RecordSet, 0, len(names))
+	for _, name := range names {
+		records = append(records, byName[name])
+	}
+	return records, nil
+}
+
+func isDnsNameInZone(name, zone string) bool {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	return name == zone || strings.HasSuffix(name, "."+zone)
+}
+
+func flattenCMCertificateDnsChallengeRecords(zoneID string, records []*dns.RecordSet) []interface{} {
+	var result []interface{}
+	for _, rs := range records {
+		for _, value := range rs.Data {
+			result = append(result, map[string]interface{}{
+				"dns_zone_id": zoneID,
+				"dns_name":    rs.Name,
+				"dns_type":    rs.Type,
+				"dns_value":   value,
+			})
+		}
+	}
+	return result
+}
+
+// expandCMCertificateDnsChallengeRecords groups the records by the DNS zone and the record set.
+func expandCMCertificateDnsChallengeRecords(records []interface{}) map[string][]*dns.RecordSet {
+	result := map[string][]*dns.RecordSet{}
+	for _, raw := range records {
+		r := raw.(map[string]interface{})
+		zoneID, name, recordType := r["dns_zone_id"].(string), r["dns_name"].(string), r["dns_type"].(string)
+
+		var rs *dns.RecordSet
+		for _, existing := range result[zoneID] {
+			if existing.Name == name && existing.Type == recordType {
+				rs = existing
+				break
+			}
+		}
+		if rs == nil {
+			rs = &dns.RecordSet{
+				Name: name,
+				Type: recordType,
+				Ttl:  yandexCMCertificateDnsChallengeTTL,
+			}
+			result[zoneID] = append(result[zoneID], rs)
+		}
+		rs.Data = append(rs.Data, r["dns_value"].(string))
+	}
+	return result
+}
+
+// cmCertificateDnsChallengeRecordsDifference returns the created records which are not wanted anymore.
+func cmCertificateDnsChallengeRecordsDifference(created, wanted []interface{}) []interface{} {
+	var result []interface{}
+	for _, raw := range created {
+		c := raw.(map[string]interface{})
+		found := false
+		for _, rawWanted := range wanted {
+			w := rawWanted.(map[string]interface{})
+			if c["dns_zone_id"] == w["dns_zone_id"] && c["dns_name"] == w["dns_name"] &&
+				c["dns_type"] == w["dns_type"] && c["dns_value"] == w["dns_value"] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/certificatemanager/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func testCMCertificateDnsChallenge(domain, recordType, name, value string, status certificatemanager.Challenge_Status) *certificatemanager.Challenge {
+	return &certificatemanager.Challenge{
+		Domain: domain,
+		Type:   certificatemanager.ChallengeType_DNS,
+		Status: status,
+		Challenge: &certificatemanager.Challenge_DnsChallenge{
+			DnsChallenge: &certificatemanager.Challenge_DnsRecord{
+				Name:  name,
+				Type:  recordType,
+				Value: value,
+			},
+		},
+	}
+}
+
+func TestCMCertificateDnsChallengeRecords(t *testing.T) {
+	challenges := []*certificatemanager.Challenge{
+		testCMCertificateDnsChallenge("example.com", "TXT", "_acme-challenge.example.com.", "token1", certificatemanager.Challenge_PENDING),
+		testCMCertificateDnsChallenge("*.example.com", "TXT", "_acme-challenge.example.com.", "token2", certificatemanager.Challenge_PENDING),
+		testCMCertificateDnsChallenge("www.example.com", "TXT", "_acme-challenge.www.example.com.", "token3", certificatemanager.Challenge_VALID),
+		testCMCertificateDnsChallenge("api.example.com", "CNAME", "_acme-challenge.api.example.com.", "fpq0000.cm.yandexcloud.net.", certificatemanager.Challenge_PENDING),
+		testCMCertificateDnsChallenge("api.example.com", "TXT", "_acme-challenge.api.example.com.", "token4", certificatemanager.Challenge_PROCESSING),
+	}
+
+	records, err := cmCertificateDnsChallengeRecords(challenges, "TXT", "example.com.")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "_acme-challenge.api.example.com.", records[0].Name)
+	assert.Equal(t, []string{"token4"}, records[0].Data)
+	assert.Equal(t, "_acme-challenge.example.com.", records[1].Name)
+	assert.Equal(t, "TXT", records[1].Type)
+	assert.Equal(t, []string{"token1", "token2"}, records[1].Data)
+
+	records, err = cmCertificateDnsChallengeRecords(challenges, "CNAME", "api.example.com.")
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, []string{"fpq0000.cm.yandexcloud.net."}, records[0].Data)
+
+	_, err = cmCertificateDnsChallengeRecords(challenges, "TXT", "example.org.")
+	assert.Error(t, err)
+}
+
+func TestIsDnsNameInZone(t *testing.T) {
+	assert.True(t, isDnsNameInZone("_acme-challenge.example.com.", "example.com."))
+	assert.True(t, isDnsNameInZone("example.com", "Example.com."))
+	assert.False(t, isDnsNameInZone("_acme-challenge.notexample.com.", "example.com."))
+	assert.False(t, isDnsNameInZone("example.org.", "example.com."))
+}
+
+func TestCMCertificateDnsChallengeRecordsRoundTrip(t *testing.T) {
+	records := []*dns.RecordSet{
+		{Name: "_acme-challenge.example.com.", Type: "TXT", Ttl: yandexCMCertificateDnsChallengeTTL, Data: []string{"token1", "token2"}},
+		{Name: "_acme-challenge.www.example.com.", Type: "TXT", Ttl: yandexCMCertificateDnsChallengeTTL, Data: []string{"token3"}},
+	}
+
+	flat := flattenCMCertificateDnsChallengeRecords("dns0000zone", records)
+	require.Len(t, flat, 3)
+	assert.Equal(t, map[string][]*dns.RecordSet{"dns0000zone": records}, expandCMCertificateDnsChallengeRecords(flat))
+
+	// the record for token2 is not wanted anymore, the other record set moves to another zone
+	wanted := flattenCMCertificateDnsChallengeRecords("dns0000zone", []*dns.RecordSet{
+		{Name: "_acme-challenge.example.com.", Type: "TXT", Data: []string{"token1"}},
+	})
+	wanted = append(wanted, flattenCMCertificateDnsChallengeRecords("dns1111zone", records[1:])...)
+	assert.Equal(t, map[string][]*dns.RecordSet{
+		"dns0000zone": {
+			{Name: "_acme-challenge.example.com.", Type: "TXT", Ttl: yandexCMCertificateDnsChallengeTTL, Data: []string{"token2"}},
+			{Name: "_acme-challenge.www.example.com.", Type: "TXT", Ttl: yandexCMCertificateDnsChallengeTTL, Data: []string{"token3"}},
+		},
+	}, expandCMCertificateDnsChallengeRecords(cmCertificateDnsChallengeRecordsDifference(flat, wanted)))
+}
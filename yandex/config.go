@@ -27,6 +27,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/restapi"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/logging"
 )
 
@@ -63,12 +65,18 @@ type Config struct {
 	SharedCredentialsFile string
 	Profile               string
 
+	// SecurityGroupRulesCheck is one of "off", "warn" or "error" and controls
+	// how problems found in security group rules are reported.
+	SecurityGroupRulesCheck string
+
 	// contextWithClientTraceID is a context that has client-trace-id in its metadata
 	// It is initialized from stopContext at the same time as ycsdk.SDK
 	contextWithClientTraceID context.Context
 
 	userAgent         string
 	sdk               *ycsdk.SDK
+	principals        *principal.Resolver
+	restClient        *restapi.Client
 	sharedCredentials *SharedCredentials
 	defaultS3Session  *session.Session
 }
@@ -142,6 +150,8 @@ func (c *Config) initAndValidate(stopContext context.Context, terraformVersion s
 	if err != nil {
 		return err
 	}
+	c.principals = principal.NewResolver(c.sdk, c.OrganizationID)
+	c.restClient = restapi.NewClient(c.sdk, c.userAgent, c.Plaintext, c.Insecure)
 
 	err = c.initSharedCredentials()
 	if err != nil {
@@ -0,0 +1,61 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexDnsZoneRecordsRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Set:      dnsZoneRecordHash,
+				Elem:     dnsZoneRecordSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+	zoneID := d.Get("zone_id").(string)
+
+	origin, err := dnsZoneRecordsOrigin(ctx, zoneID, "", config)
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := listDnsZoneRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to list record sets of DnsZone %q: %s", zoneID, err)
+	}
+	sortDnsRecordSets(recordSets)
+
+	d.SetId(zoneID)
+	d.Set("origin", origin)
+	d.Set("zone_file", formatDnsZoneFile(recordSets, origin))
+	return d.Set("record", flattenDnsZoneRecords(recordSets))
+}
@@ -0,0 +1,48 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1/asymmetricencryption"
+)
+
+func dataSourceYandexKMSAsymmetricEncryptionPublicKey() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the public key of a Yandex KMS asymmetric encryption key.",
+
+		ReadContext: dataSourceYandexKMSAsymmetricEncryptionPublicKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"asymmetric_encryption_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the asymmetric encryption key.",
+				Required:    true,
+			},
+
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "PEM-encoded public key in the X.509 SubjectPublicKeyInfo format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexKMSAsymmetricEncryptionPublicKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	keyID := d.Get("asymmetric_encryption_key_id").(string)
+
+	resp, err := config.sdk.KMSAsymmetricEncryptionCrypto().AsymmetricEncryptionCrypto().GetPublicKey(ctx, &kms.AsymmetricGetPublicKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return diag.Errorf("error getting public key of KMS asymmetric encryption key %q: %s", keyID, err)
+	}
+
+	d.SetId(resp.GetKeyId())
+	d.Set("public_key", resp.GetPublicKey())
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceKMSAsymmetricEncryptionPublicKey_basic(t *testing.T) {
+	keyName := "a" + acctest.RandString(10)
+	publicKeyData := "data.yandex_kms_asymmetric_encryption_public_key.basic_key"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexKmsAsymmetricEncryptionKeyAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMSAsymmetricEncryptionKeyResourceAndPublicKey(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(publicKeyData, "id", "yandex_kms_asymmetric_encryption_key.basic_key", "id"),
+					testAccCheckKMSPublicKeyPEM(publicKeyData, "public_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMSAsymmetricEncryptionKeyResourceAndPublicKey(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_kms_asymmetric_encryption_key" "basic_key" {
+  name = "%v"
+}
+
+data "yandex_kms_asymmetric_encryption_public_key" "basic_key" {
+  asymmetric_encryption_key_id = yandex_kms_asymmetric_encryption_key.basic_key.id
+}
+`, name)
+}
@@ -0,0 +1,62 @@
+package yandex
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDataSourceKMSAsymmetricSignaturePublicKey_basic(t *testing.T) {
+	keyName := "a" + acctest.RandString(10)
+	publicKeyData := "data.yandex_kms_asymmetric_signature_public_key.basic_key"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexKmsAsymmetricSignatureKeyAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMSAsymmetricSignatureKeyResourceAndPublicKey(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(publicKeyData, "id", "yandex_kms_asymmetric_signature_key.basic_key", "id"),
+					testAccCheckKMSPublicKeyPEM(publicKeyData, "public_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMSAsymmetricSignatureKeyResourceAndPublicKey(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_kms_asymmetric_signature_key" "basic_key" {
+  name = "%v"
+}
+
+data "yandex_kms_asymmetric_signature_public_key" "basic_key" {
+  asymmetric_signature_key_id = yandex_kms_asymmetric_signature_key.basic_key.id
+}
+`, name)
+}
+
+// testAccCheckKMSPublicKeyPEM checks that the attribute holds a PEM-encoded X.509 public key.
+func testAccCheckKMSPublicKeyPEM(name, attr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		block, _ := pem.Decode([]byte(rs.Primary.Attributes[attr]))
+		if block == nil || block.Type != "PUBLIC KEY" {
+			return fmt.Errorf("%s.%s is not a PEM-encoded public key: %q", name, attr, rs.Primary.Attributes[attr])
+		}
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return fmt.Errorf("error parsing %s.%s: %s", name, attr, err)
+		}
+		return nil
+	}
+}
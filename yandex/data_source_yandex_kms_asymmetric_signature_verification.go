@@ -0,0 +1,169 @@
+package yandex
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	kmsasymmetricsignature "github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1/asymmetricsignature"
+)
+
+func dataSourceYandexKMSAsymmetricSignatureVerification() *schema.Resource {
+	return &schema.Resource{
+		Description: "Verifies a signature made with a Yandex KMS asymmetric signature key against its public key. " +
+			"The verification is done by the provider and does not call the API.",
+
+		ReadContext: dataSourceYandexKMSAsymmetricSignatureVerificationRead,
+
+		Schema: map[string]*schema.Schema{
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "PEM-encoded public key of the signature key.",
+				Required:    true,
+			},
+
+			"signature_algorithm": {
+				Type:         schema.TypeString,
+				Description:  "Signature algorithm of the key.",
+				Required:     true,
+				ValidateFunc: validateParsableValue(parseKmsAsymmetricSignatureAlgorithm),
+			},
+
+			"message": {
+				Type:         schema.TypeString,
+				Description:  "Signed message.",
+				Optional:     true,
+				ExactlyOneOf: []string{"message", "digest"},
+			},
+
+			"digest": {
+				Type:         schema.TypeString,
+				Description:  "Base64-encoded digest of the signed message.",
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+
+			"signature": {
+				Type:         schema.TypeString,
+				Description:  "Base64-encoded signature to verify.",
+				Required:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Whether the signature is valid.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexKMSAsymmetricSignatureVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	algorithm, err := parseKmsAsymmetricSignatureAlgorithm(d.Get("signature_algorithm").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(d.Get("signature").(string))
+	if err != nil {
+		return diag.Errorf("error decoding signature: %s", err)
+	}
+
+	var digest []byte
+	if v, ok := d.GetOk("digest"); ok {
+		if digest, err = base64.StdEncoding.DecodeString(v.(string)); err != nil {
+			return diag.Errorf("error decoding digest: %s", err)
+		}
+	}
+
+	valid, err := verifyKMSAsymmetricSignature(d.Get("public_key").(string), algorithm, []byte(d.Get("message").(string)), digest, signature)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256(signature)))
+	d.Set("valid", valid)
+
+	return nil
+}
+
+// verifyKMSAsymmetricSignature checks the signature of the message, or of the digest if it is given.
+// An error is returned if the signature can not be checked at all, e.g. the key does not match the algorithm.
+func verifyKMSAsymmetricSignature(publicKeyPEM string, algorithm kmsasymmetricsignature.AsymmetricSignatureAlgorithm, message, digest, signature []byte) (bool, error) {
+	hash, curve, err := kmsAsymmetricSignatureParameters(algorithm)
+	if err != nil {
+		return false, err
+	}
+
+	if digest == nil {
+		h := hash.New()
+		h.Write(message)
+		digest = h.Sum(nil)
+	}
+	if len(digest) != hash.Size() {
+		return false, fmt.Errorf("digest should be %d bytes long for %s, got %d", hash.Size(), algorithm, len(digest))
+	}
+
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return false, fmt.Errorf("public key is not PEM-encoded")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return false, fmt.Errorf("error parsing public key: %s", err)
+	}
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if curve != nil {
+			return false, fmt.Errorf("public key is an RSA key, but the algorithm is %s", algorithm)
+		}
+		err := rsa.VerifyPSS(key, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthAuto})
+		return err == nil, nil
+	case *ecdsa.PublicKey:
+		if curve == nil || key.Curve != curve {
+			return false, fmt.Errorf("public key is an ECDSA %s key, but the algorithm is %s", key.Curve.Params().Name, algorithm)
+		}
+		return ecdsa.VerifyASN1(key, digest, signature), nil
+	default:
+		return false, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+}
+
+// kmsAsymmetricSignatureParameters returns the hash function of the algorithm, and the curve for ECDSA algorithms.
+func kmsAsymmetricSignatureParameters(algorithm kmsasymmetricsignature.AsymmetricSignatureAlgorithm) (crypto.Hash, elliptic.Curve, error) {
+	switch algorithm {
+	case kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_2048_SIGN_PSS_SHA_256,
+		kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_3072_SIGN_PSS_SHA_256,
+		kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_4096_SIGN_PSS_SHA_256:
+		return crypto.SHA256, nil, nil
+	case kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_2048_SIGN_PSS_SHA_384,
+		kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_3072_SIGN_PSS_SHA_384,
+		kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_4096_SIGN_PSS_SHA_384:
+		return crypto.SHA384, nil, nil
+	case kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_2048_SIGN_PSS_SHA_512,
+		kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_3072_SIGN_PSS_SHA_512,
+		kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_4096_SIGN_PSS_SHA_512:
+		return crypto.SHA512, nil, nil
+	case kmsasymmetricsignature.AsymmetricSignatureAlgorithm_ECDSA_NIST_P256_SHA_256:
+		return crypto.SHA256, elliptic.P256(), nil
+	case kmsasymmetricsignature.AsymmetricSignatureAlgorithm_ECDSA_NIST_P384_SHA_384:
+		return crypto.SHA384, elliptic.P384(), nil
+	case kmsasymmetricsignature.AsymmetricSignatureAlgorithm_ECDSA_NIST_P521_SHA_512:
+		return crypto.SHA512, elliptic.P521(), nil
+	default:
+		return 0, nil, fmt.Errorf("verification of %s signatures is not supported", algorithm)
+	}
+}
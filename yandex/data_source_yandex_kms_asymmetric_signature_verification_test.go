@@ -0,0 +1,145 @@
+package yandex
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	kmsasymmetricsignature "github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1/asymmetricsignature"
+)
+
+func testKMSPublicKeyPEM(t *testing.T, key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatalf("error marshalling public key: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestVerifyKMSAsymmetricSignature(t *testing.T) {
+	message := []byte("release-1.0.0.tar.gz")
+	digest := sha256.Sum256(message)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("error generating RSA key: %s", err)
+	}
+	rsaSignature, err := rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	if err != nil {
+		t.Fatalf("error signing with RSA key: %s", err)
+	}
+
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating ECDSA key: %s", err)
+	}
+	ecdsaSignature, err := ecdsa.SignASN1(rand.Reader, ecdsaKey, digest[:])
+	if err != nil {
+		t.Fatalf("error signing with ECDSA key: %s", err)
+	}
+
+	rsaPEM := testKMSPublicKeyPEM(t, &rsaKey.PublicKey)
+	ecdsaPEM := testKMSPublicKeyPEM(t, &ecdsaKey.PublicKey)
+	tampered := append([]byte{}, ecdsaSignature...)
+	tampered[len(tampered)-1] ^= 0xff
+
+	cases := []struct {
+		name      string
+		publicKey string
+		algorithm kmsasymmetricsignature.AsymmetricSignatureAlgorithm
+		message   []byte
+		digest    []byte
+		signature []byte
+		valid     bool
+		fails     bool
+	}{
+		{
+			name:      "rsa message",
+			publicKey: rsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_2048_SIGN_PSS_SHA_256,
+			message:   message,
+			signature: rsaSignature,
+			valid:     true,
+		},
+		{
+			name:      "rsa digest",
+			publicKey: rsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_2048_SIGN_PSS_SHA_256,
+			digest:    digest[:],
+			signature: rsaSignature,
+			valid:     true,
+		},
+		{
+			name:      "rsa other message",
+			publicKey: rsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_2048_SIGN_PSS_SHA_256,
+			message:   []byte("release-1.0.1.tar.gz"),
+			signature: rsaSignature,
+			valid:     false,
+		},
+		{
+			name:      "ecdsa message",
+			publicKey: ecdsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_ECDSA_NIST_P256_SHA_256,
+			message:   message,
+			signature: ecdsaSignature,
+			valid:     true,
+		},
+		{
+			name:      "ecdsa tampered signature",
+			publicKey: ecdsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_ECDSA_NIST_P256_SHA_256,
+			message:   message,
+			signature: tampered,
+			valid:     false,
+		},
+		{
+			name:      "key does not match algorithm",
+			publicKey: ecdsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_ECDSA_NIST_P384_SHA_384,
+			message:   message,
+			signature: ecdsaSignature,
+			fails:     true,
+		},
+		{
+			name:      "digest of wrong size",
+			publicKey: rsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_RSA_2048_SIGN_PSS_SHA_512,
+			digest:    digest[:],
+			signature: rsaSignature,
+			fails:     true,
+		},
+		{
+			name:      "unsupported algorithm",
+			publicKey: ecdsaPEM,
+			algorithm: kmsasymmetricsignature.AsymmetricSignatureAlgorithm_ECDSA_SECP256_K1_SHA_256,
+			message:   message,
+			signature: ecdsaSignature,
+			fails:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			valid, err := verifyKMSAsymmetricSignature(tc.publicKey, tc.algorithm, tc.message, tc.digest, tc.signature)
+			if tc.fails {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if valid != tc.valid {
+				t.Errorf("verifyKMSAsymmetricSignature() = %v, expected %v", valid, tc.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+)
+
+func dataSourceYandexKMSSymmetricKeyVersions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information about versions of a Yandex KMS symmetric key.",
+
+		ReadContext: dataSourceYandexKMSSymmetricKeyVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"symmetric_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the symmetric key.",
+				Required:    true,
+			},
+
+			"primary_version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the primary version used by default for cryptographic operations.",
+				Computed:    true,
+			},
+
+			"rotation_period": {
+				Type:        schema.TypeString,
+				Description: "Time period between automatic key rotations.",
+				Computed:    true,
+			},
+
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Description: "Time of the last key rotation.",
+				Computed:    true,
+			},
+
+			"next_rotation_at": {
+				Type:        schema.TypeString,
+				Description: "Time of the next automatic key rotation. Empty if automatic rotation is disabled.",
+				Computed:    true,
+			},
+
+			"versions": {
+				Type:        schema.TypeList,
+				Description: "Versions of the key, including the destroyed ones.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destroy_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_by_hsm": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexKMSSymmetricKeyVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	keyID := d.Get("symmetric_key_id").(string)
+
+	key, err := config.sdk.KMS().SymmetricKey().Get(ctx, &kms.GetSymmetricKeyRequest{
+		KeyId: keyID,
+	})
+	if err != nil {
+		return diag.Errorf("error getting KMS symmetric key %q: %s", keyID, err)
+	}
+
+	versions, err := listKMSSymmetricKeyVersions(ctx, config, keyID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(keyID)
+	d.Set("primary_version_id", key.GetPrimaryVersion().GetId())
+	d.Set("rotation_period", formatDuration(key.GetRotationPeriod()))
+	d.Set("rotated_at", getTimestamp(key.GetRotatedAt()))
+	d.Set("next_rotation_at", kmsSymmetricKeyNextRotationAt(key))
+
+	if err := d.Set("versions", flattenKMSSymmetricKeyVersions(versions)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func listKMSSymmetricKeyVersions(ctx context.Context, config *Config, keyID string) ([]*kms.SymmetricKeyVersion, error) {
+	var versions []*kms.SymmetricKeyVersion
+	pageToken := ""
+	for {
+		resp, err := config.sdk.KMS().SymmetricKey().ListVersions(ctx, &kms.ListSymmetricKeyVersionsRequest{
+			KeyId:     keyID,
+			PageSize:  defaultListSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing versions of KMS symmetric key %q: %w", keyID, err)
+		}
+
+		versions = append(versions, resp.GetKeyVersions()...)
+
+		if resp.GetNextPageToken() == "" {
+			return versions, nil
+		}
+		pageToken = resp.GetNextPageToken()
+	}
+}
+
+func flattenKMSSymmetricKeyVersions(versions []*kms.SymmetricKeyVersion) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, map[string]interface{}{
+			"id":            v.GetId(),
+			"status":        strings.ToLower(v.GetStatus().String()),
+			"algorithm":     v.GetAlgorithm().String(),
+			"primary":       v.GetPrimary(),
+			"created_at":    getTimestamp(v.GetCreatedAt()),
+			"destroy_at":    getTimestamp(v.GetDestroyAt()),
+			"hosted_by_hsm": v.GetHostedByHsm(),
+		})
+	}
+	return result
+}
+
+// kmsSymmetricKeyNextRotationAt returns the time of the next automatic rotation, which happens
+// one rotation period after the last rotation, or after the key creation if it was never rotated.
+func kmsSymmetricKeyNextRotationAt(key *kms.SymmetricKey) string {
+	if key.GetRotationPeriod() == nil {
+		return ""
+	}
+
+	last := key.GetRotatedAt()
+	if last == nil {
+		last = key.GetCreatedAt()
+	}
+	if last == nil {
+		return ""
+	}
+
+	return last.AsTime().Add(key.GetRotationPeriod().AsDuration()).Format(defaultTimeFormat)
+}
@@ -0,0 +1,138 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAccDataSourceKMSSymmetricKeyVersions_basic(t *testing.T) {
+	keyName := "a" + acctest.RandString(10)
+	versionsData := "data.yandex_kms_symmetric_key_versions.basic_key"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexKmsSymmetricKeyAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMSSymmetricKeyResourceAndVersions(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(versionsData, "id", "yandex_kms_symmetric_key.basic_key", "id"),
+					resource.TestCheckResourceAttrSet(versionsData, "primary_version_id"),
+					resource.TestCheckResourceAttrSet(versionsData, "next_rotation_at"),
+					resource.TestCheckResourceAttr(versionsData, "versions.#", "1"),
+					resource.TestCheckResourceAttr(versionsData, "versions.0.status", "active"),
+					resource.TestCheckResourceAttr(versionsData, "versions.0.primary", "true"),
+					resource.TestCheckResourceAttr(versionsData, "versions.0.algorithm", "AES_128"),
+					resource.TestCheckResourceAttrPair(versionsData, "versions.0.id", versionsData, "primary_version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMSSymmetricKeyResourceAndVersions(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_kms_symmetric_key" "basic_key" {
+  name            = "%v"
+  rotation_period = "8760h"
+}
+
+data "yandex_kms_symmetric_key_versions" "basic_key" {
+  symmetric_key_id = yandex_kms_symmetric_key.basic_key.id
+}
+`, name)
+}
+
+func TestKMSSymmetricKeyNextRotationAt(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rotatedAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		key      *kms.SymmetricKey
+		expected string
+	}{
+		{
+			name:     "rotation disabled",
+			key:      &kms.SymmetricKey{CreatedAt: timestamppb.New(createdAt)},
+			expected: "",
+		},
+		{
+			name: "never rotated",
+			key: &kms.SymmetricKey{
+				CreatedAt:      timestamppb.New(createdAt),
+				RotationPeriod: durationpb.New(24 * time.Hour),
+			},
+			expected: createdAt.Add(24 * time.Hour).Format(defaultTimeFormat),
+		},
+		{
+			name: "rotated",
+			key: &kms.SymmetricKey{
+				CreatedAt:      timestamppb.New(createdAt),
+				RotatedAt:      timestamppb.New(rotatedAt),
+				RotationPeriod: durationpb.New(24 * time.Hour),
+			},
+			expected: rotatedAt.Add(24 * time.Hour).Format(defaultTimeFormat),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := kmsSymmetricKeyNextRotationAt(tc.key); actual != tc.expected {
+				t.Errorf("kmsSymmetricKeyNextRotationAt() = %q, expected %q", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenKMSSymmetricKeyVersions(t *testing.T) {
+	destroyAt := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+	versions := []*kms.SymmetricKeyVersion{
+		{
+			Id:        "abj00000000000000v1",
+			Status:    kms.SymmetricKeyVersion_SCHEDULED_FOR_DESTRUCTION,
+			Algorithm: kms.SymmetricAlgorithm_AES_256,
+			DestroyAt: timestamppb.New(destroyAt),
+		},
+		{
+			Id:          "abj00000000000000v2",
+			Status:      kms.SymmetricKeyVersion_ACTIVE,
+			Algorithm:   kms.SymmetricAlgorithm_AES_256_HSM,
+			Primary:     true,
+			HostedByHsm: true,
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"id":            "abj00000000000000v1",
+			"status":        "scheduled_for_destruction",
+			"algorithm":     "AES_256",
+			"primary":       false,
+			"created_at":    "",
+			"destroy_at":    destroyAt.Format(defaultTimeFormat),
+			"hosted_by_hsm": false,
+		},
+		{
+			"id":            "abj00000000000000v2",
+			"status":        "active",
+			"algorithm":     "AES_256_HSM",
+			"primary":       true,
+			"created_at":    "",
+			"destroy_at":    "",
+			"hosted_by_hsm": true,
+		},
+	}
+
+	if actual := flattenKMSSymmetricKeyVersions(versions); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("flattenKMSSymmetricKeyVersions() = %v, expected %v", actual, expected)
+	}
+}
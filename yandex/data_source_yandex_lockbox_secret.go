@@ -108,6 +108,8 @@ func dataSourceYandexLockboxSecret() *schema.Resource {
 				Computed: true,
 			},
 
+			"password_payload_specification": dataSourceLockboxPasswordPayloadSpecificationSchema(),
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
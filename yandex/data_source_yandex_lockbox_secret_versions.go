@@ -0,0 +1,108 @@
+package yandex
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func dataSourceYandexLockboxSecretVersions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information about versions of a Yandex Cloud Lockbox secret. The payload of the versions is not exposed.",
+
+		ReadContext: dataSourceYandexLockboxSecretVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the secret.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(0, 50),
+			},
+
+			"current_version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the current version of the secret.",
+				Computed:    true,
+			},
+
+			"versions": {
+				Type:        schema.TypeList,
+				Description: "Versions of the secret, including the destroyed ones.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destroy_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"payload_entry_keys": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"current": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexLockboxSecretVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	req := &lockbox.GetSecretRequest{
+		SecretId: d.Get("secret_id").(string),
+	}
+
+	log.Printf("[INFO] reading Lockbox secret: %s", protojson.Format(req))
+
+	secret, err := config.sdk.LockboxSecret().Secret().Get(ctx, req)
+	if err != nil {
+		return diag.Errorf("error while reading secret %q: %s", req.SecretId, err)
+	}
+
+	versions, err := listLockboxSecretVersions(ctx, config, req.SecretId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(req.SecretId)
+	if err := d.Set("current_version_id", secret.GetCurrentVersion().GetId()); err != nil {
+		log.Printf("[ERROR] failed set field current_version_id: %s", err)
+		return diag.FromErr(err)
+	}
+	if err := d.Set("versions", flattenLockboxVersions(versions, secret.GetCurrentVersion().GetId())); err != nil {
+		log.Printf("[ERROR] failed set field versions: %s", err)
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] read %d versions of Lockbox secret with ID: %s", len(versions), d.Id())
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+)
+
+func TestAccDataSourceLockboxSecretVersions_basic(t *testing.T) {
+	secretName := "a" + acctest.RandString(10)
+	dataSource := "data.yandex_lockbox_secret_versions.test"
+	versionResource := "yandex_lockbox_secret_version.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceLockboxSecretVersionsConfig(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource, "secret_id", "yandex_lockbox_secret.test", "id"),
+					resource.TestCheckResourceAttrPair(dataSource, "current_version_id", versionResource, "id"),
+					resource.TestCheckResourceAttr(dataSource, "versions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSource, "versions.0.id", versionResource, "id"),
+					resource.TestCheckResourceAttr(dataSource, "versions.0.description", "test version"),
+					resource.TestCheckResourceAttr(dataSource, "versions.0.status", lockbox.Version_ACTIVE.String()),
+					resource.TestCheckResourceAttr(dataSource, "versions.0.current", "true"),
+					resource.TestCheckResourceAttr(dataSource, "versions.0.destroy_at", ""),
+					resource.TestCheckResourceAttr(dataSource, "versions.0.payload_entry_keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSource, "versions.0.payload_entry_keys.0", "key1"),
+					resource.TestCheckResourceAttr(dataSource, "versions.0.payload_entry_keys.1", "key2"),
+					resource.TestCheckResourceAttrSet(dataSource, "versions.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceLockboxSecretVersionsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "test" {
+  name = "%v"
+}
+
+resource "yandex_lockbox_secret_version" "test" {
+  secret_id   = yandex_lockbox_secret.test.id
+  description = "test version"
+
+  entries {
+    key        = "key1"
+    text_value = "val1"
+  }
+  entries {
+    key        = "key2"
+    text_value = "val2"
+  }
+}
+
+data "yandex_lockbox_secret_versions" "test" {
+  secret_id  = yandex_lockbox_secret.test.id
+  depends_on = [yandex_lockbox_secret_version.test]
+}
+`, name)
+}
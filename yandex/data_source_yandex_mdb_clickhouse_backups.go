@@ -0,0 +1,67 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+)
+
+func dataSourceYandexMDBClickHouseBackups() *schema.Resource {
+	return dataSourceYandexMDBBackups("ClickHouse", listClickHouseBackups)
+}
+
+func listClickHouseBackups(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error) {
+	var backups []*mdbBackup
+	pageToken := ""
+	for {
+		var (
+			page          []*clickhouse.Backup
+			nextPageToken string
+		)
+		if clusterID != "" {
+			resp, err := config.sdk.MDB().Clickhouse().Cluster().ListBackups(ctx, &clickhouse.ListClusterBackupsRequest{
+				ClusterId: clusterID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing backups of ClickHouse cluster %q: %w", clusterID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		} else {
+			resp, err := config.sdk.MDB().Clickhouse().Backup().List(ctx, &clickhouse.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing ClickHouse backups in folder %q: %w", folderID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		}
+
+		for _, b := range page {
+			backups = append(backups, flattenClickHouseBackup(b))
+		}
+
+		if nextPageToken == "" {
+			return backups, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func flattenClickHouseBackup(b *clickhouse.Backup) *mdbBackup {
+	return &mdbBackup{
+		ID:               b.Id,
+		FolderID:         b.FolderId,
+		SourceClusterID:  b.SourceClusterId,
+		StartedAt:        b.StartedAt,
+		CreatedAt:        b.CreatedAt,
+		SourceShardNames: b.SourceShardNames,
+		Size:             b.Size,
+		Type:             b.Type.String(),
+	}
+}
@@ -38,5 +38,5 @@ func dataSourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta inter
 	}
 
 	d.SetId(clusterID)
-	return resourceYandexMDBClickHouseClusterRead(d, meta)
+	return yandexMDBClickHouseClusterRead(d, meta, true)
 }
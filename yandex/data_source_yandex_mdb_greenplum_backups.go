@@ -0,0 +1,66 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+)
+
+func dataSourceYandexMDBGreenplumBackups() *schema.Resource {
+	return dataSourceYandexMDBBackups("Greenplum", listGreenplumBackups)
+}
+
+func listGreenplumBackups(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error) {
+	var backups []*mdbBackup
+	pageToken := ""
+	for {
+		var (
+			page          []*greenplum.Backup
+			nextPageToken string
+		)
+		if clusterID != "" {
+			resp, err := config.sdk.MDB().Greenplum().Cluster().ListBackups(ctx, &greenplum.ListClusterBackupsRequest{
+				ClusterId: clusterID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing backups of Greenplum cluster %q: %w", clusterID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		} else {
+			resp, err := config.sdk.MDB().Greenplum().Backup().List(ctx, &greenplum.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing Greenplum backups in folder %q: %w", folderID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		}
+
+		for _, b := range page {
+			backups = append(backups, flattenGreenplumBackup(b))
+		}
+
+		if nextPageToken == "" {
+			return backups, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func flattenGreenplumBackup(b *greenplum.Backup) *mdbBackup {
+	return &mdbBackup{
+		ID:              b.Id,
+		FolderID:        b.FolderId,
+		SourceClusterID: b.SourceClusterId,
+		StartedAt:       b.StartedAt,
+		CreatedAt:       b.CreatedAt,
+		Size:            b.Size,
+		Type:            b.Type.String(),
+	}
+}
@@ -269,13 +269,12 @@ func dataSourceYandexMDBGreenplumCluster() *schema.Resource {
 				},
 			},
 			"greenplum_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbGreenplumSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbGreenplumSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbGreenplumSettingsFieldsInfo),
 				},
 			},
 			"cloud_storage": {
@@ -0,0 +1,67 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+)
+
+func dataSourceYandexMDBMongodbBackups() *schema.Resource {
+	return dataSourceYandexMDBBackups("MongoDB", listMongodbBackups)
+}
+
+func listMongodbBackups(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error) {
+	var backups []*mdbBackup
+	pageToken := ""
+	for {
+		var (
+			page          []*mongodb.Backup
+			nextPageToken string
+		)
+		if clusterID != "" {
+			resp, err := config.sdk.MDB().MongoDB().Cluster().ListBackups(ctx, &mongodb.ListClusterBackupsRequest{
+				ClusterId: clusterID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing backups of MongoDB cluster %q: %w", clusterID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		} else {
+			resp, err := config.sdk.MDB().MongoDB().Backup().List(ctx, &mongodb.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing MongoDB backups in folder %q: %w", folderID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		}
+
+		for _, b := range page {
+			backups = append(backups, flattenMongodbBackup(b))
+		}
+
+		if nextPageToken == "" {
+			return backups, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func flattenMongodbBackup(b *mongodb.Backup) *mdbBackup {
+	return &mdbBackup{
+		ID:               b.Id,
+		FolderID:         b.FolderId,
+		SourceClusterID:  b.SourceClusterId,
+		StartedAt:        b.StartedAt,
+		CreatedAt:        b.CreatedAt,
+		SourceShardNames: b.SourceShardNames,
+		Size:             b.Size,
+		Type:             b.Type.String(),
+	}
+}
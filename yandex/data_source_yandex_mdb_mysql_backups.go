@@ -0,0 +1,66 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+)
+
+func dataSourceYandexMDBMySQLBackups() *schema.Resource {
+	return dataSourceYandexMDBBackups("MySQL", listMySQLBackups)
+}
+
+func listMySQLBackups(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error) {
+	var backups []*mdbBackup
+	pageToken := ""
+	for {
+		var (
+			page          []*mysql.Backup
+			nextPageToken string
+		)
+		if clusterID != "" {
+			resp, err := config.sdk.MDB().MySQL().Cluster().ListBackups(ctx, &mysql.ListClusterBackupsRequest{
+				ClusterId: clusterID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing backups of MySQL cluster %q: %w", clusterID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		} else {
+			resp, err := config.sdk.MDB().MySQL().Backup().List(ctx, &mysql.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing MySQL backups in folder %q: %w", folderID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		}
+
+		for _, b := range page {
+			backups = append(backups, flattenMySQLBackup(b))
+		}
+
+		if nextPageToken == "" {
+			return backups, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func flattenMySQLBackup(b *mysql.Backup) *mdbBackup {
+	return &mdbBackup{
+		ID:              b.Id,
+		FolderID:        b.FolderId,
+		SourceClusterID: b.SourceClusterId,
+		StartedAt:       b.StartedAt,
+		CreatedAt:       b.CreatedAt,
+		Size:            b.Size,
+		Type:            b.Type.String(),
+	}
+}
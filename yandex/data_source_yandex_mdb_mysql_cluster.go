@@ -233,13 +233,12 @@ func dataSourceYandexMDBMySQLCluster() *schema.Resource {
 				Computed: true,
 			},
 			"mysql_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbMySQLSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbMySQLSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbMySQLSettingsFieldsInfo),
 				},
 			},
 			"access": {
@@ -0,0 +1,66 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
+
+func dataSourceYandexMDBPostgreSQLBackups() *schema.Resource {
+	return dataSourceYandexMDBBackups("PostgreSQL", listPostgreSQLBackups)
+}
+
+func listPostgreSQLBackups(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error) {
+	var backups []*mdbBackup
+	pageToken := ""
+	for {
+		var (
+			page          []*postgresql.Backup
+			nextPageToken string
+		)
+		if clusterID != "" {
+			resp, err := config.sdk.MDB().PostgreSQL().Cluster().ListBackups(ctx, &postgresql.ListClusterBackupsRequest{
+				ClusterId: clusterID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing backups of PostgreSQL cluster %q: %w", clusterID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		} else {
+			resp, err := config.sdk.MDB().PostgreSQL().Backup().List(ctx, &postgresql.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing PostgreSQL backups in folder %q: %w", folderID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		}
+
+		for _, b := range page {
+			backups = append(backups, flattenPostgreSQLBackup(b))
+		}
+
+		if nextPageToken == "" {
+			return backups, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func flattenPostgreSQLBackup(b *postgresql.Backup) *mdbBackup {
+	return &mdbBackup{
+		ID:              b.Id,
+		FolderID:        b.FolderId,
+		SourceClusterID: b.SourceClusterId,
+		StartedAt:       b.StartedAt,
+		CreatedAt:       b.CreatedAt,
+		Size:            b.Size,
+		Type:            b.Type.String(),
+	}
+}
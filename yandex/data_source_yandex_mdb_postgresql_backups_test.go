@@ -0,0 +1,68 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceMDBPostgreSQLBackups_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-postgresql-backups")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBPostgreSQLBackupsConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.yandex_mdb_postgresql_backups.cluster", "id",
+						"yandex_mdb_postgresql_cluster.foo", "id",
+					),
+					resource.TestCheckResourceAttrSet("data.yandex_mdb_postgresql_backups.cluster", "backups.#"),
+					resource.TestCheckResourceAttr("data.yandex_mdb_postgresql_backups.folder", "folder_id", getExampleFolderID()),
+					resource.TestCheckResourceAttrSet("data.yandex_mdb_postgresql_backups.folder", "backups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBPostgreSQLBackupsConfig(name string) string {
+	return fmt.Sprintf(pgVPCDependencies+`
+resource "yandex_mdb_postgresql_cluster" "foo" {
+	name        = "%s"
+	environment = "PRODUCTION"
+	network_id  = "${yandex_vpc_network.mdb-pg-test-net.id}"
+
+	config {
+		version = 16
+		resources {
+			resource_preset_id = "s2.micro"
+			disk_size          = 10
+			disk_type_id       = "network-ssd"
+		}
+	}
+
+	host {
+		zone      = "ru-central1-a"
+		subnet_id = yandex_vpc_subnet.mdb-pg-test-subnet-a.id
+	}
+}
+
+data "yandex_mdb_postgresql_backups" "cluster" {
+	cluster_id = yandex_mdb_postgresql_cluster.foo.id
+}
+
+data "yandex_mdb_postgresql_backups" "folder" {
+	folder_id  = "%s"
+	depends_on = [yandex_mdb_postgresql_cluster.foo]
+}
+`, name, getExampleFolderID())
+}
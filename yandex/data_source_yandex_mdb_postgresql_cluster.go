@@ -187,13 +187,12 @@ func dataSourceYandexMDBPostgreSQLCluster() *schema.Resource {
 							Computed: true,
 						},
 						"settings": {
-							Type:             schema.TypeMap,
-							Optional:         true,
-							Computed:         true,
-							DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbPGUserSettingsFieldsInfo),
-							ValidateFunc:     generateMapSchemaValidateFunc(mdbPGUserSettingsFieldsInfo),
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: generateBlockSchema(mdbPGUserSettingsFieldsInfo),
 							},
 						},
 					},
@@ -335,13 +334,12 @@ func dataSourceYandexMDBPostgreSQLClusterConfigBlock() *schema.Resource {
 				Computed: true,
 			},
 			"postgresql_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbPGSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbPGSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbPGSettingsFieldsInfo),
 				},
 			},
 		},
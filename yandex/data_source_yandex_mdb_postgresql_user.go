@@ -50,13 +50,12 @@ func dataSourceYandexMDBPostgreSQLUser() *schema.Resource {
 				Optional: true,
 			},
 			"settings": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbPGUserSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbPGUserSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbPGUserSettingsFieldsInfo),
 				},
 			},
 			"deletion_protection": {
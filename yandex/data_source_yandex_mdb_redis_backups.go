@@ -0,0 +1,65 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+)
+
+func dataSourceYandexMDBRedisBackups() *schema.Resource {
+	return dataSourceYandexMDBBackups("Redis", listRedisBackups)
+}
+
+func listRedisBackups(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error) {
+	var backups []*mdbBackup
+	pageToken := ""
+	for {
+		var (
+			page          []*redis.Backup
+			nextPageToken string
+		)
+		if clusterID != "" {
+			resp, err := config.sdk.MDB().Redis().Cluster().ListBackups(ctx, &redis.ListClusterBackupsRequest{
+				ClusterId: clusterID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing backups of Redis cluster %q: %w", clusterID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		} else {
+			resp, err := config.sdk.MDB().Redis().Backup().List(ctx, &redis.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing Redis backups in folder %q: %w", folderID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		}
+
+		for _, b := range page {
+			backups = append(backups, flattenRedisBackup(b))
+		}
+
+		if nextPageToken == "" {
+			return backups, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func flattenRedisBackup(b *redis.Backup) *mdbBackup {
+	return &mdbBackup{
+		ID:               b.Id,
+		FolderID:         b.FolderId,
+		SourceClusterID:  b.SourceClusterId,
+		StartedAt:        b.StartedAt,
+		CreatedAt:        b.CreatedAt,
+		SourceShardNames: b.SourceShardNames,
+	}
+}
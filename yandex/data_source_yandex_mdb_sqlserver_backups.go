@@ -0,0 +1,64 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+)
+
+func dataSourceYandexMDBSQLServerBackups() *schema.Resource {
+	return dataSourceYandexMDBBackups("SQL Server", listSQLServerBackups)
+}
+
+func listSQLServerBackups(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error) {
+	var backups []*mdbBackup
+	pageToken := ""
+	for {
+		var (
+			page          []*sqlserver.Backup
+			nextPageToken string
+		)
+		if clusterID != "" {
+			resp, err := config.sdk.MDB().SQLServer().Cluster().ListBackups(ctx, &sqlserver.ListClusterBackupsRequest{
+				ClusterId: clusterID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing backups of SQL Server cluster %q: %w", clusterID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		} else {
+			resp, err := config.sdk.MDB().SQLServer().Backup().List(ctx, &sqlserver.ListBackupsRequest{
+				FolderId:  folderID,
+				PageSize:  defaultMDBPageSize,
+				PageToken: pageToken,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error listing SQL Server backups in folder %q: %w", folderID, err)
+			}
+			page, nextPageToken = resp.Backups, resp.NextPageToken
+		}
+
+		for _, b := range page {
+			backups = append(backups, flattenSQLServerBackup(b))
+		}
+
+		if nextPageToken == "" {
+			return backups, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func flattenSQLServerBackup(b *sqlserver.Backup) *mdbBackup {
+	return &mdbBackup{
+		ID:              b.Id,
+		FolderID:        b.FolderId,
+		SourceClusterID: b.SourceClusterId,
+		StartedAt:       b.StartedAt,
+		CreatedAt:       b.CreatedAt,
+	}
+}
@@ -186,13 +186,12 @@ func dataSourceYandexMDBSQLServerCluster() *schema.Resource {
 				Computed: true,
 			},
 			"sqlserver_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbSQLServerSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbSQLServerSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbSQLServerSettingsFieldsInfo),
 				},
 			},
 			"deletion_protection": {
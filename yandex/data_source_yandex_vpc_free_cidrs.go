@@ -0,0 +1,319 @@
+package yandex
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+// Reservations made by yandex_vpc_cidr_reservation are stored as network labels
+// `reserved-cidr.<address>/<prefix length>` = `<reservation key>`.
+const vpcFreeCidrsReservationLabelPrefix = "reserved-cidr."
+
+func dataSourceYandexVPCFreeCidrs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceYandexVPCFreeCidrsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(yandexVPCNetworkDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"supernet": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsCIDRNetwork(0, 32),
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"block_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"used_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceYandexVPCFreeCidrsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	networkID := d.Get("network_id").(string)
+	supernet, err := netip.ParsePrefix(d.Get("supernet").(string))
+	if err != nil {
+		return fmt.Errorf("invalid supernet: %s", err)
+	}
+	prefixLength := d.Get("prefix_length").(int)
+	count := d.Get("block_count").(int)
+
+	if err := validateVPCFreeCidrsSupernet(supernet, prefixLength); err != nil {
+		return err
+	}
+
+	network, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
+		NetworkId: networkID,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Network %q: %s", networkID, err)
+	}
+
+	used, err := listVPCNetworkV4CidrBlocks(ctx, config, networkID)
+	if err != nil {
+		return err
+	}
+
+	// blocks reserved by yandex_vpc_cidr_reservation are taken as used
+	reserved, _ := vpcFreeCidrsReservations(network.Labels, "")
+
+	blocks, err := allocateVPCFreeCidrs(supernet, prefixLength, count, append(used, reserved...))
+	if err != nil {
+		return fmt.Errorf("error allocating CIDR blocks in Network %q: %s", networkID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", networkID, supernet, prefixLength))
+
+	if err := d.Set("cidr_blocks", prefixesToStrings(blocks)); err != nil {
+		return err
+	}
+	return d.Set("used_cidr_blocks", prefixesToStrings(used))
+}
+
+func validateVPCFreeCidrsSupernet(supernet netip.Prefix, prefixLength int) error {
+	if !supernet.Addr().Is4() {
+		return fmt.Errorf("supernet %q is not an IPv4 network", supernet)
+	}
+	if prefixLength < supernet.Bits() {
+		return fmt.Errorf("prefix_length %d is shorter than the supernet %q prefix length", prefixLength, supernet)
+	}
+	return nil
+}
+
+func listVPCNetworkV4CidrBlocks(ctx context.Context, config *Config, networkID string) ([]netip.Prefix, error) {
+	var blocks []netip.Prefix
+
+	req := &vpc.ListNetworkSubnetsRequest{
+		NetworkId: networkID,
+	}
+	for {
+		resp, err := config.sdk.VPC().Network().ListSubnets(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("error listing subnets of Network %q: %s", networkID, err)
+		}
+
+		for _, subnet := range resp.Subnets {
+			for _, cidr := range subnet.V4CidrBlocks {
+				prefix, err := netip.ParsePrefix(cidr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid CIDR block %q of subnet %q: %s", cidr, subnet.Id, err)
+				}
+				blocks = append(blocks, prefix.Masked())
+			}
+		}
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	sortPrefixes(blocks)
+	return blocks, nil
+}
+
+// vpcFreeCidrsReservations splits the reservations stored in the network labels into the ones held by
+// other reservation keys and the ones held by reservationKey.
+func vpcFreeCidrsReservations(labels map[string]string, reservationKey string) (reserved, own []netip.Prefix) {
+	for k, v := range labels {
+		if !strings.HasPrefix(k, vpcFreeCidrsReservationLabelPrefix) {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(strings.TrimPrefix(k, vpcFreeCidrsReservationLabelPrefix))
+		if err != nil {
+			log.Printf("[WARN] Ignoring malformed CIDR reservation label %q: %s", k, err)
+			continue
+		}
+
+		if reservationKey != "" && v == reservationKey {
+			own = append(own, prefix.Masked())
+		} else {
+			reserved = append(reserved, prefix.Masked())
+		}
+	}
+
+	sortPrefixes(reserved)
+	sortPrefixes(own)
+	return reserved, own
+}
+
+// allocateVPCFreeCidrs returns count blocks of prefixLength inside supernet that don't overlap with used.
+// Blocks are taken in the address order, so the result is stable as long as the used blocks don't change.
+func allocateVPCFreeCidrs(supernet netip.Prefix, prefixLength, count int, used []netip.Prefix) ([]netip.Prefix, error) {
+	supernet = supernet.Masked()
+
+	start := ipv4ToUint(supernet.Addr())
+	end := start + ipv4BlockSize(supernet.Bits()) - 1
+	size := ipv4BlockSize(prefixLength)
+
+	var blocks []netip.Prefix
+	for cur := start; len(blocks) < count; {
+		candidate := netip.PrefixFrom(uintToIPv4(uint32(cur)), prefixLength)
+
+		next := cur + size
+		if overlapping := overlappingPrefixes(candidate, used); len(overlapping) > 0 {
+			// skip past the overlapping blocks, aligned to the requested prefix length
+			for _, o := range overlapping {
+				if oEnd := ipv4ToUint(o.Addr()) + ipv4BlockSize(o.Bits()); oEnd > next {
+					next = (oEnd + size - 1) / size * size
+				}
+			}
+		} else {
+			blocks = append(blocks, candidate)
+		}
+
+		if next > end {
+			break
+		}
+		cur = next
+	}
+
+	if len(blocks) < count {
+		return nil, fmt.Errorf("only %d free /%d blocks are left in %s, requested %d", len(blocks), prefixLength, supernet, count)
+	}
+
+	return blocks, nil
+}
+
+// reserveVPCFreeCidrs replaces the reservations of reservationKey in the network labels with blocks.
+func reserveVPCFreeCidrs(ctx context.Context, config *Config, network *vpc.Network, reservationKey string, blocks []netip.Prefix) error {
+	labels := make(map[string]string, len(network.Labels)+len(blocks))
+	for k, v := range network.Labels {
+		// own reservations not returned anymore are released
+		if strings.HasPrefix(k, vpcFreeCidrsReservationLabelPrefix) && v == reservationKey {
+			continue
+		}
+		labels[k] = v
+	}
+	for _, b := range blocks {
+		labels[vpcFreeCidrsReservationLabelPrefix+b.String()] = reservationKey
+	}
+
+	if equalStringMaps(labels, network.Labels) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Reserving CIDR blocks %v in Network %q for %q", blocks, network.Id, reservationKey)
+
+	// Labels are replaced as a whole, the caller holds the lock of the network, but another
+	// Terraform run may update them at the same time.
+
+	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Update(ctx, &vpc.UpdateNetworkRequest{
+		NetworkId:  network.Id,
+		Labels:     labels,
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"labels"}},
+	}))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to reserve CIDR blocks in Network %q: %s", network.Id, err)
+	}
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error reserving CIDR blocks in Network %q: %s", network.Id, err)
+	}
+
+	// Make sure the reservations survived a concurrent update.
+	updated, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
+		NetworkId: network.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Network %q: %s", network.Id, err)
+	}
+	for _, b := range blocks {
+		if owner := updated.Labels[vpcFreeCidrsReservationLabelPrefix+b.String()]; owner != reservationKey {
+			return fmt.Errorf("CIDR block %s in Network %q was concurrently reserved by %q, please retry", b, network.Id, owner)
+		}
+	}
+
+	return nil
+}
+
+func overlappingPrefixes(p netip.Prefix, prefixes []netip.Prefix) []netip.Prefix {
+	var res []netip.Prefix
+	for _, o := range prefixes {
+		if o.Overlaps(p) {
+			res = append(res, o)
+		}
+	}
+	return res
+}
+
+func ipv4ToUint(addr netip.Addr) uint64 {
+	b := addr.As4()
+	return uint64(binary.BigEndian.Uint32(b[:]))
+}
+
+func uintToIPv4(v uint32) netip.Addr {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	return netip.AddrFrom4(b)
+}
+
+func ipv4BlockSize(bits int) uint64 {
+	return 1 << (32 - bits)
+}
+
+func sortPrefixes(prefixes []netip.Prefix) {
+	sort.Slice(prefixes, func(i, j int) bool {
+		if prefixes[i].Addr() != prefixes[j].Addr() {
+			return prefixes[i].Addr().Less(prefixes[j].Addr())
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+}
+
+func prefixesToStrings(prefixes []netip.Prefix) []string {
+	res := make([]string, len(prefixes))
+	for i, p := range prefixes {
+		res[i] = p.String()
+	}
+	return res
+}
+
+func equalStringMaps(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package yandex
+
+import (
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAllocateVPCFreeCidrs(t *testing.T) {
+	prefixes := func(cidrs ...string) []netip.Prefix {
+		res := make([]netip.Prefix, len(cidrs))
+		for i, c := range cidrs {
+			res[i] = netip.MustParsePrefix(c)
+		}
+		return res
+	}
+
+	cases := []struct {
+		name     string
+		supernet string
+		bits     int
+		count    int
+		used     []netip.Prefix
+		expected []netip.Prefix
+		err      bool
+	}{
+		{
+			name:     "empty network",
+			supernet: "10.0.0.0/16",
+			bits:     24,
+			count:    2,
+			expected: prefixes("10.0.0.0/24", "10.0.1.0/24"),
+		},
+		{
+			name:     "skip used blocks",
+			supernet: "10.0.0.0/16",
+			bits:     24,
+			count:    2,
+			used:     prefixes("10.0.0.0/24", "10.0.1.128/25", "10.0.4.0/22"),
+			expected: prefixes("10.0.2.0/24", "10.0.3.0/24"),
+		},
+		{
+			name:     "larger used block",
+			supernet: "10.0.0.0/16",
+			bits:     26,
+			count:    1,
+			used:     prefixes("10.0.0.0/20"),
+			expected: prefixes("10.0.16.0/26"),
+		},
+		{
+			name:     "used blocks outside of supernet",
+			supernet: "172.16.0.0/24",
+			bits:     28,
+			count:    1,
+			used:     prefixes("10.0.0.0/8", "172.16.0.0/28"),
+			expected: prefixes("172.16.0.16/28"),
+		},
+		{
+			name:     "not enough space",
+			supernet: "10.0.0.0/23",
+			bits:     24,
+			count:    2,
+			used:     prefixes("10.0.1.0/28"),
+			err:      true,
+		},
+		{
+			name:     "whole supernet",
+			supernet: "10.0.0.0/24",
+			bits:     24,
+			count:    1,
+			expected: prefixes("10.0.0.0/24"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocks, err := allocateVPCFreeCidrs(netip.MustParsePrefix(tc.supernet), tc.bits, tc.count, tc.used)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error, got %v", blocks)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(blocks, tc.expected) {
+				t.Errorf("allocateVPCFreeCidrs() = %v, want %v", blocks, tc.expected)
+			}
+		})
+	}
+}
+
+func TestVPCFreeCidrsReservations(t *testing.T) {
+	labels := map[string]string{
+		"env":                        "prod",
+		"reserved-cidr.10.0.1.0/24":  "stage",
+		"reserved-cidr.10.0.2.0/24":  "prod",
+		"reserved-cidr.10.0.3.0/24":  "stage",
+		"reserved-cidr.not-a-prefix": "stage",
+	}
+
+	reserved, own := vpcFreeCidrsReservations(labels, "stage")
+	if expected := []netip.Prefix{netip.MustParsePrefix("10.0.2.0/24")}; !reflect.DeepEqual(reserved, expected) {
+		t.Errorf("reserved = %v, want %v", reserved, expected)
+	}
+	if expected := []netip.Prefix{netip.MustParsePrefix("10.0.1.0/24"), netip.MustParsePrefix("10.0.3.0/24")}; !reflect.DeepEqual(own, expected) {
+		t.Errorf("own = %v, want %v", own, expected)
+	}
+
+	reserved, own = vpcFreeCidrsReservations(labels, "")
+	if len(reserved) != 3 || len(own) != 0 {
+		t.Errorf("without reservation key: reserved = %v, own = %v", reserved, own)
+	}
+}
+
+func TestAccDataSourceVPCFreeCidrs_basic(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.RandomWithPrefix("tf-network")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCFreeCidrsConfig(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_free_cidrs.free", "cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_free_cidrs.free", "cidr_blocks.0", "172.16.2.0/24"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_free_cidrs.free", "cidr_blocks.1", "172.16.3.0/24"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_free_cidrs.free", "used_cidr_blocks.0", "172.16.0.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPCFreeCidrsConfig(name string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_subnet" "bar1" {
+  network_id     = "${yandex_vpc_network.foo.id}"
+  v4_cidr_blocks = ["172.16.0.0/24"]
+}
+
+resource "yandex_vpc_subnet" "bar2" {
+  network_id     = "${yandex_vpc_network.foo.id}"
+  v4_cidr_blocks = ["172.16.1.0/24"]
+}
+
+data "yandex_vpc_free_cidrs" "free" {
+  network_id    = "${yandex_vpc_network.foo.id}"
+  supernet      = "172.16.0.0/16"
+  prefix_length = 24
+  block_count   = 2
+
+  depends_on = [yandex_vpc_subnet.bar1, yandex_vpc_subnet.bar2]
+}
+`, name)
+}
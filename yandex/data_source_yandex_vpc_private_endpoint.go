@@ -0,0 +1,70 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexVPCPrivateEndpoint() *schema.Resource {
+	dataSource := convertResourceToDataSource(resourceYandexVPCPrivateEndpoint())
+
+	dataSource.Schema["private_endpoint_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	dataSource.Schema["name"].Computed = true
+	dataSource.Schema["name"].Optional = true
+	dataSource.Schema["folder_id"].Computed = true
+	dataSource.Schema["folder_id"].Optional = true
+
+	dataSource.Read = dataSourceYandexVPCPrivateEndpointRead
+
+	return dataSource
+}
+
+func dataSourceYandexVPCPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	err := checkOneOf(d, "private_endpoint_id", "name")
+	if err != nil {
+		return err
+	}
+
+	id := d.Get("private_endpoint_id").(string)
+	if name, ok := d.GetOk("name"); ok {
+		folderID, err := getFolderID(d, config)
+		if err != nil {
+			return err
+		}
+
+		endpoints, err := listVPCPrivateEndpoints(ctx, config, folderID)
+		if err != nil {
+			return fmt.Errorf("failed to resolve data source private endpoint by name: %v", err)
+		}
+
+		for _, endpoint := range endpoints {
+			if endpoint.Name == name.(string) {
+				id = endpoint.ID
+				break
+			}
+		}
+		if id == "" {
+			return fmt.Errorf("failed to resolve data source private endpoint by name: private endpoint %q not found in folder %q", name, folderID)
+		}
+	}
+
+	endpoint, err := getVPCPrivateEndpoint(ctx, config, id)
+	if err != nil {
+		return fmt.Errorf("error reading private endpoint %q: %s", id, err)
+	}
+
+	d.SetId(endpoint.ID)
+	if err := d.Set("private_endpoint_id", endpoint.ID); err != nil {
+		return err
+	}
+
+	return flattenVPCPrivateEndpoint(d, endpoint)
+}
@@ -0,0 +1,234 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+//go:generate go run ./internal/mdbconfiggen -output dynamic_accessors_gen.go -blocks-output mdb_config_blocks_gen.go
+
+// getValue and the typed setters go through the accessors generated for every field of the
+// messages registered with objectFieldsInfo.addType.
+func (fieldInfo fieldReflectInfo) getValue(v interface{}) (interface{}, error) {
+	return fieldInfo.get(v)
+}
+
+func (fieldInfo fieldReflectInfo) setInt(v interface{}, iv *int) error {
+	return fieldInfo.set(v, iv)
+}
+
+func (fieldInfo fieldReflectInfo) setBool(v interface{}, bv *bool) error {
+	return fieldInfo.set(v, bv)
+}
+
+func (fieldInfo fieldReflectInfo) setFloat(v interface{}, fv *float64) error {
+	return fieldInfo.set(v, fv)
+}
+
+func (fieldInfo fieldReflectInfo) setString(v interface{}, sv *string) error {
+	return fieldInfo.set(v, sv)
+}
+
+// accessorArgs checks the arguments of a generated accessor: the message must be *M and the value *V.
+func accessorArgs[M any, V any](name string, v interface{}, value interface{}) (*M, *V, error) {
+	m, ok := v.(*M)
+	if !ok || m == nil {
+		return nil, nil, &typeMismatchError{text: fmt.Sprintf("accessor %s: expected %T, got %T", name, m, v)}
+	}
+	if value == nil {
+		return m, nil, nil
+	}
+	vv, ok := value.(*V)
+	if !ok {
+		return nil, nil, &typeMismatchError{text: fmt.Sprintf("accessor %s: expected %T value, got %T", name, vv, value)}
+	}
+	return m, vv, nil
+}
+
+func int64ValueAccessor[M any](name, description string, field func(*M) **wrapperspb.Int64Value) fieldReflectInfo {
+	return fieldReflectInfo{
+		name:        name,
+		valueType:   schema.TypeInt,
+		description: description,
+		get: func(v interface{}) (interface{}, error) {
+			m, _, err := accessorArgs[M, int](name, v, nil)
+			if err != nil || *field(m) == nil {
+				return nil, err
+			}
+			return int((*field(m)).GetValue()), nil
+		},
+		set: func(v interface{}, value interface{}) error {
+			m, iv, err := accessorArgs[M, int](name, v, value)
+			if err != nil {
+				return err
+			}
+			if iv == nil {
+				*field(m) = nil
+				return nil
+			}
+			*field(m) = wrapperspb.Int64(int64(*iv))
+			return nil
+		},
+	}
+}
+
+func boolValueAccessor[M any](name, description string, field func(*M) **wrapperspb.BoolValue) fieldReflectInfo {
+	return fieldReflectInfo{
+		name:        name,
+		valueType:   schema.TypeBool,
+		description: description,
+		get: func(v interface{}) (interface{}, error) {
+			m, _, err := accessorArgs[M, bool](name, v, nil)
+			if err != nil || *field(m) == nil {
+				return nil, err
+			}
+			return (*field(m)).GetValue(), nil
+		},
+		set: func(v interface{}, value interface{}) error {
+			m, bv, err := accessorArgs[M, bool](name, v, value)
+			if err != nil {
+				return err
+			}
+			if bv == nil {
+				*field(m) = nil
+				return nil
+			}
+			*field(m) = wrapperspb.Bool(*bv)
+			return nil
+		},
+	}
+}
+
+func doubleValueAccessor[M any](name, description string, field func(*M) **wrapperspb.DoubleValue) fieldReflectInfo {
+	return fieldReflectInfo{
+		name:        name,
+		valueType:   schema.TypeFloat,
+		description: description,
+		get: func(v interface{}) (interface{}, error) {
+			m, _, err := accessorArgs[M, float64](name, v, nil)
+			if err != nil || *field(m) == nil {
+				return nil, err
+			}
+			return (*field(m)).GetValue(), nil
+		},
+		set: func(v interface{}, value interface{}) error {
+			m, fv, err := accessorArgs[M, float64](name, v, value)
+			if err != nil {
+				return err
+			}
+			if fv == nil {
+				*field(m) = nil
+				return nil
+			}
+			*field(m) = wrapperspb.Double(*fv)
+			return nil
+		},
+	}
+}
+
+// intAccessor is the accessor of the integer and enum fields.
+func intAccessor[M any, I ~int32 | ~int64](name, description string, field func(*M) *I) fieldReflectInfo {
+	return fieldReflectInfo{
+		name:        name,
+		valueType:   schema.TypeInt,
+		description: description,
+		get: func(v interface{}) (interface{}, error) {
+			m, _, err := accessorArgs[M, int](name, v, nil)
+			if err != nil {
+				return nil, err
+			}
+			return int(*field(m)), nil
+		},
+		set: func(v interface{}, value interface{}) error {
+			m, iv, err := accessorArgs[M, int](name, v, value)
+			if err != nil {
+				return err
+			}
+			if iv == nil {
+				return &nilNotAllowedError{text: fmt.Sprintf("accessor %s: field is not nilable", name)}
+			}
+			*field(m) = I(*iv)
+			return nil
+		},
+	}
+}
+
+func floatAccessor[M any, F ~float32 | ~float64](name, description string, field func(*M) *F) fieldReflectInfo {
+	return fieldReflectInfo{
+		name:        name,
+		valueType:   schema.TypeFloat,
+		description: description,
+		get: func(v interface{}) (interface{}, error) {
+			m, _, err := accessorArgs[M, float64](name, v, nil)
+			if err != nil {
+				return nil, err
+			}
+			return float64(*field(m)), nil
+		},
+		set: func(v interface{}, value interface{}) error {
+			m, fv, err := accessorArgs[M, float64](name, v, value)
+			if err != nil {
+				return err
+			}
+			if fv == nil {
+				return &nilNotAllowedError{text: fmt.Sprintf("accessor %s: field is not nilable", name)}
+			}
+			*field(m) = F(*fv)
+			return nil
+		},
+	}
+}
+
+func boolAccessor[M any](name, description string, field func(*M) *bool) fieldReflectInfo {
+	return fieldReflectInfo{
+		name:        name,
+		valueType:   schema.TypeBool,
+		description: description,
+		get: func(v interface{}) (interface{}, error) {
+			m, _, err := accessorArgs[M, bool](name, v, nil)
+			if err != nil {
+				return nil, err
+			}
+			return *field(m), nil
+		},
+		set: func(v interface{}, value interface{}) error {
+			m, bv, err := accessorArgs[M, bool](name, v, value)
+			if err != nil {
+				return err
+			}
+			if bv == nil {
+				return &nilNotAllowedError{text: fmt.Sprintf("accessor %s: field is not nilable", name)}
+			}
+			*field(m) = *bv
+			return nil
+		},
+	}
+}
+
+func stringAccessor[M any](name, description string, field func(*M) *string) fieldReflectInfo {
+	return fieldReflectInfo{
+		name:        name,
+		valueType:   schema.TypeString,
+		description: description,
+		get: func(v interface{}) (interface{}, error) {
+			m, _, err := accessorArgs[M, string](name, v, nil)
+			if err != nil {
+				return nil, err
+			}
+			return *field(m), nil
+		},
+		set: func(v interface{}, value interface{}) error {
+			m, sv, err := accessorArgs[M, string](name, v, value)
+			if err != nil {
+				return err
+			}
+			if sv == nil {
+				return &nilNotAllowedError{text: fmt.Sprintf("accessor %s: field is not nilable", name)}
+			}
+			*field(m) = *sv
+			return nil
+		},
+	}
+}
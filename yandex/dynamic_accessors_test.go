@@ -0,0 +1,74 @@
+package yandex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1/config"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var pgConfig16Type = reflect.TypeOf((*config.PostgresqlConfig16)(nil)).Elem()
+
+func TestGeneratedFieldsInfo(t *testing.T) {
+	t.Parallel()
+
+	fields := mdbPGSettingsFieldsInfo.getFields(pgConfig16Type)
+
+	assert.Equal(t, schema.TypeInt, fields["max_connections"].valueType)
+	assert.Equal(t, schema.TypeInt, fields["wal_level"].valueType)
+	assert.Equal(t, schema.TypeFloat, fields["bgwriter_lru_multiplier"].valueType)
+	assert.Equal(t, schema.TypeBool, fields["enable_parallel_hash"].valueType)
+	assert.Equal(t, schema.TypeString, fields["search_path"].valueType)
+	assert.Equal(t, "in bytes.", fields["shared_buffers"].description)
+	assert.NotContains(t, fields, "shared_preload_libraries", "repeated fields are not supported by the settings maps")
+}
+
+func TestGeneratedAccessors(t *testing.T) {
+	t.Parallel()
+
+	fields := mdbPGSettingsFieldsInfo.getFields(pgConfig16Type)
+	cfg := &config.PostgresqlConfig16{}
+
+	i := 100
+	require.NoError(t, fields["max_connections"].setInt(cfg, &i))
+	assert.Equal(t, int64(100), cfg.MaxConnections.GetValue())
+	v, err := fields["max_connections"].getValue(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, 100, v)
+
+	require.NoError(t, fields["max_connections"].setInt(cfg, nil))
+	assert.Nil(t, cfg.MaxConnections)
+	v, err = fields["max_connections"].getValue(cfg)
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	level := int(config.PostgresqlConfig16_WAL_LEVEL_LOGICAL)
+	require.NoError(t, fields["wal_level"].setInt(cfg, &level))
+	assert.Equal(t, config.PostgresqlConfig16_WAL_LEVEL_LOGICAL, cfg.WalLevel)
+	assert.True(t, isNilNotAllowedError(fields["wal_level"].setInt(cfg, nil)))
+
+	f := 2.5
+	require.NoError(t, fields["bgwriter_lru_multiplier"].setFloat(cfg, &f))
+	assert.Equal(t, wrapperspb.Double(2.5).GetValue(), cfg.BgwriterLruMultiplier.GetValue())
+
+	s := "public"
+	require.NoError(t, fields["search_path"].setString(cfg, &s))
+	assert.Equal(t, "public", cfg.SearchPath)
+
+	assert.True(t, isTypeMismatchError(fields["max_connections"].setInt(&config.PostgresqlConfig15{}, &i)))
+	assert.True(t, isTypeMismatchError(fields["max_connections"].setBool(cfg, new(bool))))
+	_, err = fields["max_connections"].getValue(&config.PostgresqlConfig15{})
+	assert.True(t, isTypeMismatchError(err))
+}
+
+func TestFieldsInfoDescription(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "in bytes.", mdbPGSettingsFieldsInfo.description("shared_buffers"))
+	assert.Equal(t, "The maximum permitted number of simultaneous client connections.", mdbMySQLSettingsFieldsInfo.description("max_connections"))
+	assert.Equal(t, "", mdbPGSettingsFieldsInfo.description("no_such_setting"))
+}
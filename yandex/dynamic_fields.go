@@ -3,6 +3,7 @@ package yandex
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -22,6 +23,8 @@ type fieldManualInfo struct {
 	isStringable       bool
 
 	emptySliceValue string
+	// enumValues are the names of the enum values, reported when the value is not in the enum
+	enumValues []string
 
 	checkValueFunc   func(fieldsInfo *objectFieldsInfo, v interface{}) error
 	compareValueFunc func(fieldsInfo *objectFieldsInfo, old, new string) bool
@@ -30,6 +33,12 @@ type fieldManualInfo struct {
 type fieldReflectInfo struct {
 	name      string
 	valueType schema.ValueType
+	// description is the documentation of the proto field
+	description string
+
+	// get and set are the generated accessors of the field, see dynamic_accessors.go
+	get func(v interface{}) (interface{}, error)
+	set func(v interface{}, value interface{}) error
 }
 
 type objectFieldsInfo struct {
@@ -121,6 +130,36 @@ func (fieldsInfo *objectFieldsInfo) intCheckSetValue(field string, v *int) error
 
 }
 
+// enumValuesError lists the values of an enum field, it returns nil for the fields which are not enums.
+func (fieldsInfo *objectFieldsInfo) enumValuesError(field string) error {
+	if fieldsInfo == nil || fieldsInfo.fieldsManual == nil {
+		return nil
+	}
+
+	fieldInfo, ok := fieldsInfo.fieldsManual[field]
+	if !ok || len(fieldInfo.enumValues) == 0 {
+		return nil
+	}
+	return fmt.Errorf("should be one of %s", strings.Join(fieldInfo.enumValues, ", "))
+}
+
+// enumStringToInt converts the value of an enum field checking that it is one of the enum values.
+func (fieldsInfo *objectFieldsInfo) enumStringToInt(field string, v string) (*int, error) {
+	i, err := fieldsInfo.stringToInt(field, v)
+	if err != nil {
+		if enumErr := fieldsInfo.enumValuesError(field); enumErr != nil {
+			return nil, enumErr
+		}
+		return nil, err
+	}
+	if enumErr := fieldsInfo.enumValuesError(field); enumErr != nil && i != nil {
+		if _, err := fieldsInfo.intToString(field, i); err != nil {
+			return nil, enumErr
+		}
+	}
+	return i, nil
+}
+
 func (fieldsInfo *objectFieldsInfo) floatCheckSetValue(field string, v *float64) error {
 	if v == nil && !fieldsInfo.backToNil(field) {
 		return &nilNotAllowedError{text: fmt.Sprintf("floatCheckSetValue: you can't set nil %s", field)}
@@ -313,13 +352,9 @@ func newObjectFieldsInfo() *objectFieldsInfo {
 }
 
 // addType panics on get type errors and any other errors
+// The fields of v are taken from generatedFieldsInfo, so the type must be listed in internal/mdbconfiggen
 func (fieldsInfo *objectFieldsInfo) addType(v interface{}) *objectFieldsInfo {
 
-	fis, err := getFieldsInfo(v, "protobuf", "name")
-	if err != nil {
-		panic(err.Error())
-	}
-
 	t, err := getStructType(v)
 	if err != nil {
 		panic(err.Error())
@@ -329,6 +364,11 @@ func (fieldsInfo *objectFieldsInfo) addType(v interface{}) *objectFieldsInfo {
 		panic("addType: type must be not nil")
 	}
 
+	fis, ok := generatedFieldsInfo[t]
+	if !ok {
+		panic(fmt.Sprintf("addType: no generated fields for %s, add it to internal/mdbconfiggen and run go generate", t))
+	}
+
 	fieldsInfo.fieldsReflect[t] = fis
 
 	for k := range fis {
@@ -351,6 +391,7 @@ func (fieldsInfo *objectFieldsInfo) addSkipEnumGeneratedNames(field string, valu
 		isDefaultSet:     true,
 		intToString:      makeIntToString(convIValuesToI32(values), def),
 		stringToInt:      makeStringToInt(convIValuesToI32(values), &def),
+		enumValues:       enumNames(convIValuesToI32(values)),
 		isStringable:     true,
 		isNotNullable:    true,
 		skip:             true,
@@ -377,6 +418,7 @@ func (fieldsInfo *objectFieldsInfo) addEnumGeneratedNames(field string, values m
 		isDefaultSet:    true,
 		intToString:     makeIntToString(convIValuesToI32(values), def),
 		stringToInt:     makeStringToInt(convIValuesToI32(values), &def),
+		enumValues:      enumNames(convIValuesToI32(values)),
 		isStringable:    true,
 		isNotNullable:   true,
 	}
@@ -393,6 +435,7 @@ func (fieldsInfo *objectFieldsInfo) addEnumHumanNames(field string, values map[i
 		isDefaultSet:    true,
 		intToString:     makeIntToString(values, def),
 		stringToInt:     makeStringToInt2(values, convIValuesToI32(values2), &def),
+		enumValues:      enumNames(values),
 		isStringable:    true,
 		isNotNullable:   true,
 	}
@@ -400,6 +443,15 @@ func (fieldsInfo *objectFieldsInfo) addEnumHumanNames(field string, values map[i
 	return fieldsInfo
 }
 
+func enumNames(values map[int]string) []string {
+	names := make([]string, 0, len(values))
+	for _, name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func convIValuesToI32(values map[int32]string) map[int]string {
 	valuesI := make(map[int]string)
 	for k, v := range values {
@@ -542,7 +594,7 @@ func defaultStringOfEnumsCheck(fieldname string) func(*objectFieldsInfo, interfa
 
 			for _, sv := range strings.Split(s, ",") {
 
-				i, err := fieldsInfo.stringToInt(fieldname, sv)
+				i, err := fieldsInfo.enumStringToInt(fieldname, sv)
 				if err != nil {
 					return err
 				}
@@ -560,3 +612,85 @@ func defaultStringOfEnumsCheck(fieldname string) func(*objectFieldsInfo, interfa
 func defaultStringCompare(fieldsInfo *objectFieldsInfo, old, new string) bool {
 	return old == new
 }
+
+// description returns the first sentence of the documentation of the field.
+func (fieldsInfo *objectFieldsInfo) description(field string) string {
+	d := fieldsInfo.documentation(field)
+	if i := strings.Index(d, ". "); i >= 0 {
+		d = d[:i+1]
+	}
+	return d
+}
+
+// documentation returns the documentation of the field, as found in the first type that has it.
+func (fieldsInfo *objectFieldsInfo) documentation(field string) string {
+	for _, t := range fieldsInfo.nameFieldsType[field] {
+		if d := fieldsInfo.getType(t, field).description; d != "" {
+			return d
+		}
+	}
+	return ""
+}
+
+// valueType returns the type of the field in the types that have it, the enums are of schema.TypeInt.
+func (fieldsInfo *objectFieldsInfo) valueType(field string) schema.ValueType {
+	for _, t := range fieldsInfo.nameFieldsType[field] {
+		return fieldsInfo.getType(t, field).valueType
+	}
+	return schema.TypeInvalid
+}
+
+// names returns the sorted names of the fields of the type, all the known fields when the type is nil.
+func (fieldsInfo *objectFieldsInfo) names(t reflect.Type) []string {
+	var names []string
+	if t != nil {
+		for name := range fieldsInfo.getFields(t) {
+			names = append(names, name)
+		}
+	} else {
+		for name := range fieldsInfo.nameFieldsType {
+			names = append(names, name)
+		}
+		for name, fieldInfo := range fieldsInfo.fieldsManual {
+			if _, ok := fieldsInfo.nameFieldsType[name]; !ok && fieldInfo.checkValueFunc != nil {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// closestFieldName returns the name closest to the misspelled one, or an empty string if none of the names is close enough.
+func closestFieldName(name string, names []string) string {
+	closest, closestDistance := "", len(name)/3
+	if closestDistance < 2 {
+		closestDistance = 2
+	}
+	for _, candidate := range names {
+		if distance := levenshteinDistance(name, candidate); distance <= closestDistance {
+			closest, closestDistance = candidate, distance-1
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(min(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
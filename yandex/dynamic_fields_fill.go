@@ -136,7 +136,7 @@ func generateMapSchemaValidateFunc(fieldsInfo *objectFieldsInfo) func(interface{
 
 			if !ok {
 				fields = append(fields, k)
-				errors = append(errors, fmt.Errorf("Unsupported key %s.%s", path, k))
+				errors = append(errors, fmt.Errorf("Unsupported key %s.%s%s", path, k, didYouMean(k, fieldsInfo.names(nil))))
 				continue
 			}
 
@@ -158,6 +158,8 @@ func generateMapSchemaValidateFunc(fieldsInfo *objectFieldsInfo) func(interface{
 				fields = append(fields, k)
 				if err == nil {
 					errors = append(errors, fmt.Errorf("Check Fail key %s.%s unsupperted type", path, k))
+				} else if d := fieldsInfo.description(k); d != "" {
+					errors = append(errors, fmt.Errorf("Check Fail key %s.%s value: %v err: %v (%s: %s)", path, k, v, err, k, d))
 				} else {
 					errors = append(errors, fmt.Errorf("Check Fail key %s.%s value: %v err: %v", path, k, v, err))
 				}
@@ -167,13 +169,89 @@ func generateMapSchemaValidateFunc(fieldsInfo *objectFieldsInfo) func(interface{
 		return fields, errors
 	}
 }
+
+// generateBlockSchema Generate the attributes of a settings block, one for every field of the types of fieldsInfo
+// and for every field filled manually. The enums and the fields filled manually are set by their string values.
+func generateBlockSchema(fieldsInfo *objectFieldsInfo) map[string]*schema.Schema {
+	attributes := make(map[string]*schema.Schema)
+
+	for _, field := range fieldsInfo.names(nil) {
+		attribute := &schema.Schema{
+			Type:        fieldsInfo.valueType(field),
+			Optional:    true,
+			Computed:    true,
+			Description: fieldsInfo.documentation(field),
+		}
+		if fieldsInfo.isStringable(field) || fieldsInfo.checkValueFunc(field) != nil {
+			attribute.Type = schema.TypeString
+			attribute.ValidateFunc = generateBlockSchemaValidateFunc(fieldsInfo, field)
+		}
+		attributes[field] = attribute
+	}
+
+	return attributes
+}
+
+// generateBlockSchemaValidateFunc Generate ValidateFunc of the string attribute of a settings block
+func generateBlockSchemaValidateFunc(fieldsInfo *objectFieldsInfo, field string) schema.SchemaValidateFunc {
+	return func(v interface{}, path string) ([]string, []error) {
+		value := v.(string)
+
+		cvf := fieldsInfo.checkValueFunc(field)
+		if cvf != nil {
+			if err := cvf(value); err != nil {
+				return nil, []error{fmt.Errorf("Check Fail key %s value: %v err: %v", path, value, err)}
+			}
+			return nil, nil
+		}
+
+		var err error
+		for _, t := range fieldsInfo.nameFieldsType[field] {
+			ok, errOut := checkValidate(fieldsInfo, field, value, t)
+			if ok {
+				return nil, nil
+			}
+			if errOut != nil {
+				err = errOut
+			}
+		}
+		if err == nil {
+			err = fmt.Errorf("unsupported type")
+		}
+		return nil, []error{fmt.Errorf("Check Fail key %s value: %v err: %v", path, value, err)}
+	}
+}
+
+// settingString returns the string form of the value of a setting.
+func settingString(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return fmt.Sprint(v)
+}
+
+// didYouMean suggests the closest valid name for the misspelled one, if any.
+func didYouMean(name string, names []string) string {
+	if closest := closestFieldName(name, names); closest != "" {
+		return fmt.Sprintf(", did you mean %q?", closest)
+	}
+	return ""
+}
+
 func checkValidate(fieldsInfo *objectFieldsInfo, field, value string, t reflect.Type) (bool, error) {
 	fi := fieldsInfo.getType(t, field)
 	if fi.valueType == schema.TypeInvalid {
 		return false, nil
 	}
 	if fi.valueType == schema.TypeInt {
-		i, err := fieldsInfo.stringToInt(field, value)
+		i, err := fieldsInfo.enumStringToInt(field, value)
 		if err != nil {
 			return false, err
 		}
@@ -237,7 +315,7 @@ func flattenResourceGenerate(fieldsInfo *objectFieldsInfo, v interface{}, includ
 
 	for field, fieldInfo := range fields {
 		if !fieldsInfo.skip(field) {
-			value, err := getValueFrom(v, fieldInfo.name)
+			value, err := fieldInfo.getValue(v)
 
 			if err != nil {
 				return nil, err
@@ -326,17 +404,8 @@ func flattenResourceGenerateMapS(v interface{}, includeNil bool,
 		out := make(map[string]string)
 
 		for k, v := range m {
-			if vI, ok := v.(int); ok {
-				out[k] = strconv.Itoa(vI)
-			}
-			if vF, ok := v.(float64); ok {
-				out[k] = strconv.FormatFloat(vF, 'f', -1, 64)
-			}
-			if vB, ok := v.(bool); ok {
-				out[k] = strconv.FormatBool(vB)
-			}
-			if vS, ok := v.(string); ok {
-				out[k] = vS
+			if v != nil {
+				out[k] = settingString(v)
 			}
 		}
 		return out, nil
@@ -344,6 +413,27 @@ func flattenResourceGenerateMapS(v interface{}, includeNil bool,
 	return nil, nil
 }
 
+// flattenResourceGenerateBlock flattens v to the value of a settings block, nil when v is.
+func flattenResourceGenerateBlock(v interface{}, fieldsInfo *objectFieldsInfo, knownDefault map[string]struct{}) ([]interface{}, error) {
+	m, err := flattenResourceGenerate(fieldsInfo, v, false, false, true, knownDefault)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	return []interface{}{m}, nil
+}
+
+// changedSettingsPaths returns the update mask paths of the changed attributes of the settings block at the key,
+// the block holds every setting so only the changed ones can be sent.
+func changedSettingsPaths(fieldsInfo *objectFieldsInfo, d interface{ HasChange(string) bool }, key, pathPrefix string) []string {
+	var paths []string
+	for _, field := range fieldsInfo.names(nil) {
+		if !fieldsInfo.skip(field) && d.HasChange(key+".0."+field) {
+			paths = append(paths, pathPrefix+field)
+		}
+	}
+	return paths
+}
+
 // expandResourceGenerate fill v from resource data
 // v must be ptr
 func expandResourceGenerateNonSkippedFields(fieldsInfo *objectFieldsInfo, d *schema.ResourceData, v interface{}, path string, skipNil bool) ([]string, error) {
@@ -428,7 +518,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setIntField(obj, fieldInfo.name, &intValue)
+			err = fieldInfo.setInt(obj, &intValue)
 			if err != nil {
 				return err
 			}
@@ -448,7 +538,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setIntField(obj, fieldInfo.name, intValue)
+			err = fieldInfo.setInt(obj, intValue)
 			if err != nil {
 				return err
 			}
@@ -459,7 +549,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setIntField(obj, fieldInfo.name, nil)
+			err = fieldInfo.setInt(obj, nil)
 			if err != nil {
 				return err
 			}
@@ -474,7 +564,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setBoolField(obj, fieldInfo.name, &boolValue)
+			err = fieldInfo.setBool(obj, &boolValue)
 			if err != nil {
 				return err
 			}
@@ -494,7 +584,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setBoolField(obj, fieldInfo.name, boolValue)
+			err = fieldInfo.setBool(obj, boolValue)
 			if err != nil {
 				return err
 			}
@@ -505,7 +595,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setBoolField(obj, fieldInfo.name, nil)
+			err = fieldInfo.setBool(obj, nil)
 			if err != nil {
 				return err
 			}
@@ -520,7 +610,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setFloatField(obj, fieldInfo.name, &floatValue)
+			err = fieldInfo.setFloat(obj, &floatValue)
 			if err != nil {
 				return err
 			}
@@ -540,7 +630,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setFloatField(obj, fieldInfo.name, floatValue)
+			err = fieldInfo.setFloat(obj, floatValue)
 			if err != nil {
 				return err
 			}
@@ -551,7 +641,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setFloatField(obj, fieldInfo.name, nil)
+			err = fieldInfo.setFloat(obj, nil)
 			if err != nil {
 				return err
 			}
@@ -571,7 +661,7 @@ func expandResourceGenerateOneField(fieldsInfo *objectFieldsInfo, fieldInfo fiel
 				return err
 			}
 
-			err = setStringField(obj, fieldInfo.name, &stringValue)
+			err = fieldInfo.setString(obj, &stringValue)
 			if err != nil {
 				return err
 			}
@@ -1,6 +1,7 @@
 package yandex
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -17,7 +18,7 @@ func TestExpandResourceGenerateOneFieldInt(t *testing.T) {
 		addEnumHumanNames("b_name", mdbPGUserSettingsTransactionIsolationName,
 			postgresql.UserSettings_TransactionIsolation_name)
 
-	fiB := fieldReflectInfo{name: "B", valueType: schema.TypeInt}
+	fiB := intAccessor("B", "", func(m *TestStruct) *int32 { return &m.B })
 
 	err := expandResourceGenerateOneField(fieldsInfo, fiB, "b_name", testStruct, 6, true, "some.path.for.error")
 	if err != nil {
@@ -76,7 +77,7 @@ func TestExpandResourceGenerateOneFieldWrappersInt64(t *testing.T) {
 
 	fieldsInfo := newObjectFieldsInfo()
 
-	fiD := fieldReflectInfo{name: "D", valueType: schema.TypeInt}
+	fiD := int64ValueAccessor("D", "", func(m *TestStruct) **wrappers.Int64Value { return &m.D })
 
 	err := expandResourceGenerateOneField(fieldsInfo, fiD, "d_name", testStruct, 7, true, "some.path.for.error")
 	if err != nil {
@@ -138,7 +139,7 @@ func TestExpandResourceGenerateOneFieldBool(t *testing.T) {
 
 	fieldsInfo := newObjectFieldsInfo()
 
-	fiE := fieldReflectInfo{name: "E", valueType: schema.TypeBool}
+	fiE := boolAccessor("E", "", func(m *TestStruct) *bool { return &m.E })
 
 	err := expandResourceGenerateOneField(fieldsInfo, fiE, "e_name", testStruct, true, true, "some.path.for.error")
 	if err != nil {
@@ -187,7 +188,7 @@ func TestExpandResourceGenerateOneFieldWrappersBool(t *testing.T) {
 
 	fieldsInfo := newObjectFieldsInfo()
 
-	fiF := fieldReflectInfo{name: "F", valueType: schema.TypeBool}
+	fiF := boolValueAccessor("F", "", func(m *TestStruct) **wrappers.BoolValue { return &m.F })
 
 	err := expandResourceGenerateOneField(fieldsInfo, fiF, "f_name", testStruct, true, true, "some.path.for.error")
 	if err != nil {
@@ -249,7 +250,7 @@ func TestExpandResourceGenerateOneFieldFloat(t *testing.T) {
 
 	fieldsInfo := newObjectFieldsInfo()
 
-	fiG := fieldReflectInfo{name: "G", valueType: schema.TypeFloat}
+	fiG := floatAccessor("G", "", func(m *TestStruct) *float64 { return &m.G })
 
 	err := expandResourceGenerateOneField(fieldsInfo, fiG, "g_name", testStruct, 7.6, true, "some.path.for.error")
 	if err != nil {
@@ -298,7 +299,7 @@ func TestExpandResourceGenerateOneFieldWrappersFloat(t *testing.T) {
 
 	fieldsInfo := newObjectFieldsInfo()
 
-	fiH := fieldReflectInfo{name: "H", valueType: schema.TypeFloat}
+	fiH := doubleValueAccessor("H", "", func(m *TestStruct) **wrappers.DoubleValue { return &m.H })
 
 	err := expandResourceGenerateOneField(fieldsInfo, fiH, "h_name", testStruct, 7.8, true, "some.path.for.error")
 	if err != nil {
@@ -360,7 +361,7 @@ func TestExpandResourceGenerateOneFieldString(t *testing.T) {
 
 	fieldsInfo := newObjectFieldsInfo()
 
-	fiI := fieldReflectInfo{name: "I", valueType: schema.TypeString}
+	fiI := stringAccessor("I", "", func(m *TestStruct) *string { return &m.I })
 
 	err := expandResourceGenerateOneField(fieldsInfo, fiI, "i_name", testStruct, "some text", true, "some.path.for.error")
 	if err != nil {
@@ -531,3 +532,110 @@ func TestFieldsDynamicGenerateMapSchemaDiffSuppressFuncEnum(t *testing.T) {
 		t.Errorf("generateMapSchemaDiffSuppressFunc: enum values should be equal when new value is empty")
 	}
 }
+
+func TestFieldsDynamicGenerateMapSchemaValidateFuncSuggestion(t *testing.T) {
+	t.Parallel()
+
+	validateFunc := generateMapSchemaValidateFunc(mdbPGSettingsFieldsInfo)
+
+	_, errors := validateFunc(map[string]interface{}{"shared_bufers": "1024"}, "postgresql_config")
+	if len(errors) != 1 {
+		t.Fatalf("generateMapSchemaValidateFunc: expected 1 error, got %v", errors)
+	}
+	if want := `Unsupported key postgresql_config.shared_bufers, did you mean "shared_buffers"?`; errors[0].Error() != want {
+		t.Errorf("generateMapSchemaValidateFunc: error is %q, want %q", errors[0], want)
+	}
+}
+
+func TestClosestFieldName(t *testing.T) {
+	t.Parallel()
+
+	names := []string{"max_connections", "max_prepared_transactions", "shared_buffers", "work_mem"}
+	cases := map[string]string{
+		"max_conections": "max_connections",
+		"shared_bufers":  "shared_buffers",
+		"work_mme":       "work_mem",
+		"foo":            "",
+	}
+	for name, want := range cases {
+		if got := closestFieldName(name, names); got != want {
+			t.Errorf("closestFieldName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestFieldsDynamicGenerateBlockSchema(t *testing.T) {
+	t.Parallel()
+
+	attributes := generateBlockSchema(mdbPGSettingsFieldsInfo)
+
+	if got := attributes["max_connections"].Type; got != schema.TypeInt {
+		t.Errorf("generateBlockSchema: max_connections is %v, want %v", got, schema.TypeInt)
+	}
+
+	isolation := attributes["default_transaction_isolation"]
+	if isolation.Type != schema.TypeString {
+		t.Fatalf("generateBlockSchema: the enum default_transaction_isolation is %v, want %v", isolation.Type, schema.TypeString)
+	}
+	if _, errors := isolation.ValidateFunc("TRANSACTION_ISOLATION_READ_UNCOMMITTED", "default_transaction_isolation"); len(errors) > 0 {
+		t.Errorf("generateBlockSchema: the name of the enum value is rejected: %v", errors)
+	}
+	if _, errors := isolation.ValidateFunc("TRANSACTION_ISOLATION_FOO", "default_transaction_isolation"); len(errors) == 0 {
+		t.Errorf("generateBlockSchema: the unknown enum value is accepted")
+	}
+
+	if _, errors := attributes["shared_preload_libraries"].ValidateFunc("SHARED_PRELOAD_LIBRARIES_FOO", "shared_preload_libraries"); len(errors) == 0 {
+		t.Errorf("generateBlockSchema: the unknown library is accepted")
+	}
+}
+
+func TestFieldsDynamicGenerateBlockSchemaTypes(t *testing.T) {
+	t.Parallel()
+
+	// an attribute has one type for all the versions, so the field must have the same type in all of them
+	for name, fieldsInfo := range map[string]*objectFieldsInfo{
+		"postgresql":      mdbPGSettingsFieldsInfo,
+		"postgresql user": mdbPGUserSettingsFieldsInfo,
+		"mysql":           mdbMySQLSettingsFieldsInfo,
+		"greenplum":       mdbGreenplumSettingsFieldsInfo,
+		"sqlserver":       mdbSQLServerSettingsFieldsInfo,
+	} {
+		for field, attribute := range generateBlockSchema(fieldsInfo) {
+			if attribute.Type == schema.TypeString && attribute.ValidateFunc != nil {
+				continue
+			}
+			for _, typ := range fieldsInfo.nameFieldsType[field] {
+				if got := fieldsInfo.getType(typ, field).valueType; got != attribute.Type {
+					t.Errorf("%s: %s is %v in %s, but its attribute is %v", name, field, got, typ.Name(), attribute.Type)
+				}
+			}
+		}
+	}
+}
+
+func TestChangedSettingsPaths(t *testing.T) {
+	t.Parallel()
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"settings": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: generateBlockSchema(mdbPGUserSettingsFieldsInfo),
+			},
+		},
+	}, map[string]interface{}{
+		"settings": []interface{}{
+			map[string]interface{}{
+				"log_min_duration_statement": 5000,
+			},
+		},
+	})
+
+	want := []string{"settings.log_min_duration_statement"}
+	if got := changedSettingsPaths(mdbPGUserSettingsFieldsInfo, d, "settings", "settings."); !reflect.DeepEqual(got, want) {
+		t.Errorf("changedSettingsPaths = %v, want %v", got, want)
+	}
+}
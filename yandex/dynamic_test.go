@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
@@ -16,100 +17,84 @@ func TestDynamicSetRead(t *testing.T) {
 
 	us.TempFileLimit = &wrappers.Int64Value{Value: 10}
 
-	rv := reflect.ValueOf(us)
-	rv = rv.Elem()
+	fields := generatedFieldsInfo[reflect.TypeOf(postgresql.UserSettings{})]
 
-	for i := 0; i < rv.NumField(); i++ {
-		f := rv.Type().Field(i)
-
-		tg, okTg := findTag(f, "protobuf", "name")
-
-		if okTg {
-			if tg == "default_transaction_isolation" {
-				v := 4
-				err := setIntFieldToReflect(rv, f.Name, &v)
-				if err != nil {
-					t.Error(err)
-				}
+	for tg, fi := range fields {
+		if tg == "default_transaction_isolation" {
+			v := 4
+			err := fi.setInt(us, &v)
+			if err != nil {
+				t.Error(err)
 			}
-			if tg == "lock_timeout" {
-				v := 7
-				err := setIntFieldToReflect(rv, f.Name, &v)
-				if err != nil {
-					t.Error(err)
-				}
+		}
+		if tg == "lock_timeout" {
+			v := 7
+			err := fi.setInt(us, &v)
+			if err != nil {
+				t.Error(err)
 			}
+		}
 
-			if tg == "temp_file_limit" {
-				err := setIntFieldToReflect(rv, f.Name, nil)
-				if err != nil {
-					t.Error(err)
-				}
+		if tg == "temp_file_limit" {
+			err := fi.setInt(us, nil)
+			if err != nil {
+				t.Error(err)
 			}
+		}
 
-			if tg == "log_statement" {
-				err := setIntFieldToReflect(rv, f.Name, nil)
-				if err == nil {
-					t.Error("setIntValueToReflect fail: Insert nil into not nil field")
-				}
+		if tg == "log_statement" {
+			err := fi.setInt(us, nil)
+			if err == nil {
+				t.Error("setInt fail: Insert nil into not nil field")
 			}
 		}
-
 	}
 
 	if us.LockTimeout == nil {
-		t.Error("setIntValueToReflect fail: not set value")
+		t.Error("setInt fail: not set value")
 	}
 
 	if us.LockTimeout.GetValue() != 7 {
-		t.Error("setIntValueToReflect fail: value set not correct in *wrappers.Int64Value")
+		t.Error("setInt fail: value set not correct in *wrappers.Int64Value")
 	}
 
 	if us.DefaultTransactionIsolation != 4 {
-		t.Error("setIntValueToReflect fail: not set value in int")
+		t.Error("setInt fail: not set value in int")
 	}
 
 	if us.TempFileLimit != nil {
-		t.Error("setIntValueToReflect fail: not set nil in *wrappers.Int64Value")
+		t.Error("setInt fail: not set nil in *wrappers.Int64Value")
 	}
 
-	for i := 0; i < rv.NumField(); i++ {
-		f := rv.Type().Field(i)
-
-		tg, okTg := findTag(f, "protobuf", "name")
-
-		if okTg {
-			if tg == "default_transaction_isolation" {
-				vl, err := getValueFromReflect(rv, f.Name)
-				if err != nil {
-					t.Error(err)
-				}
-				if vl.(int) != 4 {
-					t.Error("getValueFromReflect fail: read not correct value from int")
-				}
+	for tg, fi := range fields {
+		if tg == "default_transaction_isolation" {
+			vl, err := fi.getValue(us)
+			if err != nil {
+				t.Error(err)
 			}
-			if tg == "lock_timeout" {
-				vl, err := getValueFromReflect(rv, f.Name)
-				if err != nil {
-					t.Error(err)
-				}
-				if vl.(int) != 7 {
-					t.Error("getValueFromReflect fail: read not correct value from *wrappers.Int64Value")
-				}
+			if vl.(int) != 4 {
+				t.Error("getValue fail: read not correct value from int")
 			}
-
-			if tg == "temp_file_limit" {
-				vl, err := getValueFromReflect(rv, f.Name)
-				if err != nil {
-					t.Error(err)
-				}
-				if vl != nil {
-					t.Error("getValueFromReflect read not corect nil value from *wrappers.Int64Value")
-				}
+		}
+		if tg == "lock_timeout" {
+			vl, err := fi.getValue(us)
+			if err != nil {
+				t.Error(err)
+			}
+			if vl.(int) != 7 {
+				t.Error("getValue fail: read not correct value from *wrappers.Int64Value")
 			}
-
 		}
 
+		if tg == "temp_file_limit" {
+			vl, err := fi.getValue(us)
+			if err != nil {
+				t.Error(err)
+			}
+			if vl != nil {
+				t.Error("getValue read not corect nil value from *wrappers.Int64Value")
+			}
+		}
 	}
 
 }
@@ -130,71 +115,64 @@ type TestStruct struct {
 	I string `tag_test:"name=i_name"`
 }
 
-func TestDynamicFindTagGetCorrect(t *testing.T) {
-	t.Parallel()
-
-	testStruct := TestStruct{}
-
-	rv := reflect.ValueOf(testStruct)
-
-	fl, ok := rv.Type().FieldByName("B")
-
-	if !ok {
-		t.Error("Not found field B")
-	}
-
-	vl, ok := findTag(fl, "tag_test", "name")
-
-	if !ok {
-		t.Error("Tag \"tag_test\"-\"name\" not found")
-	}
+// testStructFields are the accessors of TestStruct, built the same way as the generated ones.
+var testStructFields = map[string]fieldReflectInfo{
+	"A": intAccessor("A", "", func(m *TestStruct) *int32 { return &m.A }),
+	"B": intAccessor("B", "", func(m *TestStruct) *int32 { return &m.B }),
+	"D": int64ValueAccessor("D", "", func(m *TestStruct) **wrappers.Int64Value { return &m.D }),
+	"E": boolAccessor("E", "", func(m *TestStruct) *bool { return &m.E }),
+	"F": boolValueAccessor("F", "", func(m *TestStruct) **wrappers.BoolValue { return &m.F }),
+	"G": floatAccessor("G", "", func(m *TestStruct) *float64 { return &m.G }),
+	"H": doubleValueAccessor("H", "", func(m *TestStruct) **wrappers.DoubleValue { return &m.H }),
+	"I": stringAccessor("I", "", func(m *TestStruct) *string { return &m.I }),
+}
 
-	if vl != "b_name" {
-		t.Errorf("Tag \"tag_test\"-\"name\" value shuld be \"b_name\", value %v is not correct", vl)
+// protobufName returns the name of the proto field from the protobuf tag of the struct field.
+func protobufName(f reflect.StructField) (string, bool) {
+	for _, tag := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(tag, "name="); ok {
+			return name, true
+		}
 	}
+	return "", false
 }
 
-func TestDynamicFindTagNotFound(t *testing.T) {
+func TestDynamicGeneratedFieldsGetCorrect(t *testing.T) {
 	t.Parallel()
 
-	testStruct := TestStruct{}
-
-	rv := reflect.ValueOf(testStruct)
-
-	fl, ok := rv.Type().FieldByName("A")
-
-	if !ok {
-		t.Error("Not found field A")
-	}
+	for tp, fields := range generatedFieldsInfo {
+		for key, fi := range fields {
+			fl, ok := tp.FieldByName(fi.name)
+			if !ok {
+				t.Errorf("Not found field %s of %s", fi.name, tp)
+				continue
+			}
 
-	_, ok = findTag(fl, "tag_test", "name")
+			vl, ok := protobufName(fl)
+			if !ok {
+				t.Errorf("Tag \"protobuf\"-\"name\" of %s.%s not found", tp, fi.name)
+			}
 
-	if ok {
-		t.Error("Tag \"tag_test\"-\"name\" should be not found")
+			if vl != key {
+				t.Errorf("Generated key of %s.%s shuld be %q, key %q is not correct", tp, fi.name, vl, key)
+			}
+		}
 	}
 }
 
-func TestDynamicFindTagGetFlag(t *testing.T) {
+func TestDynamicGeneratedFieldsNotFound(t *testing.T) {
 	t.Parallel()
 
-	testStruct := TestStruct{}
+	fields := generatedFieldsInfo[reflect.TypeOf(postgresql.UserSettings{})]
 
-	rv := reflect.ValueOf(testStruct)
-
-	fl, ok := rv.Type().FieldByName("C")
-
-	if !ok {
-		t.Error("Not found field C")
+	if _, ok := fields["no_such_setting"]; ok {
+		t.Error("Field \"no_such_setting\" should be not found")
 	}
 
-	vl, ok := findTag(fl, "json", "omitempty")
-
-	if !ok {
-		t.Error("Tag \"json\"-\"omitempty\" should be found")
-	}
-
-	if vl != "" {
-		t.Error("Tag value \"json\"-\"omitempty\" should be empty (\"\")")
+	for key, fi := range fields {
+		if fi.get == nil || fi.set == nil {
+			t.Errorf("Field %q should have generated accessors", key)
+		}
 	}
 }
 
@@ -204,26 +182,26 @@ func TestDynamicSetIntValue(t *testing.T) {
 	testStruct := &TestStruct{}
 	value := 6
 
-	err := setIntField(testStruct, "A", &value)
+	err := testStructFields["A"].setInt(testStruct, &value)
 
 	if err != nil {
-		t.Errorf("setIntField: set value to A (int) fail, set should return nil error when pass value 6, but error: %v", err)
+		t.Errorf("setInt: set value to A (int) fail, set should return nil error when pass value 6, but error: %v", err)
 	}
 
 	if testStruct.A != 6 {
-		t.Error("setIntField: set value to A (int) fail, value is not setted")
+		t.Error("setInt: set value to A (int) fail, value is not setted")
 	}
 
-	err = setIntField(testStruct, "A", nil)
+	err = testStructFields["A"].setInt(testStruct, nil)
 
 	if err == nil {
-		t.Error("setIntField: set value to A (int) fail, value is setted nil into int")
+		t.Error("setInt: set value to A (int) fail, value is setted nil into int")
 	}
 
-	err = setIntField(nil, "A", &value)
+	err = testStructFields["A"].setInt(nil, &value)
 
 	if err == nil {
-		t.Error("setIntField: set value to A (int) fail, value is setted into nil object")
+		t.Error("setInt: set value to A (int) fail, value is setted into nil object")
 	}
 }
 
@@ -233,27 +211,27 @@ func TestDynamicSetWrappersInt64(t *testing.T) {
 	testStruct := &TestStruct{}
 	value := 6
 
-	err := setIntField(testStruct, "D", &value)
+	err := testStructFields["D"].setInt(testStruct, &value)
 
 	if err != nil {
-		t.Errorf("setIntField: set value to D (*wrappers.Int64Value) fail, set should return nil error when pass value 6, but error: %v", err)
+		t.Errorf("setInt: set value to D (*wrappers.Int64Value) fail, set should return nil error when pass value 6, but error: %v", err)
 	}
 
 	if testStruct.D == nil {
-		t.Errorf("setIntField: set value to D (*wrappers.Int64Value) fail, value (6) is not setted")
+		t.Errorf("setInt: set value to D (*wrappers.Int64Value) fail, value (6) is not setted")
 	}
 	if testStruct.D.GetValue() != 6 {
-		t.Errorf("setIntField: set value to D (*wrappers.Int64Value) fail, value setted not correct should be 6 but setted: %v", testStruct.D.GetValue())
+		t.Errorf("setInt: set value to D (*wrappers.Int64Value) fail, value setted not correct should be 6 but setted: %v", testStruct.D.GetValue())
 	}
 
-	err = setIntField(testStruct, "D", nil)
+	err = testStructFields["D"].setInt(testStruct, nil)
 
 	if err != nil {
-		t.Errorf("setIntField: set value to D (*wrappers.Int64Value) fail, set should return nil error when pass value nil, but error: %v", err)
+		t.Errorf("setInt: set value to D (*wrappers.Int64Value) fail, set should return nil error when pass value nil, but error: %v", err)
 	}
 
 	if testStruct.D != nil {
-		t.Errorf("setIntField: set value to D (*wrappers.Int64Value) fail, value nil is not setted")
+		t.Errorf("setInt: set value to D (*wrappers.Int64Value) fail, value nil is not setted")
 	}
 
 }
@@ -264,27 +242,27 @@ func TestDynamicSetBoolValue(t *testing.T) {
 	testStruct := &TestStruct{}
 	value := true
 
-	err := setBoolField(testStruct, "E", &value)
+	err := testStructFields["E"].setBool(testStruct, &value)
 
 	if err != nil {
-		t.Errorf("setBoolField: set value to E (bool) fail, set should return nil error when pass value true, but error: %v", err)
+		t.Errorf("setBool: set value to E (bool) fail, set should return nil error when pass value true, but error: %v", err)
 		t.Error(err)
 	}
 
 	if !testStruct.E {
-		t.Error("setBoolField: set value to E (bool) fail, value is not setted")
+		t.Error("setBool: set value to E (bool) fail, value is not setted")
 	}
 
-	err = setBoolField(testStruct, "E", nil)
+	err = testStructFields["E"].setBool(testStruct, nil)
 
 	if err == nil {
-		t.Error("setBoolField: set value to E (bool) fail, value is setted nil into bool")
+		t.Error("setBool: set value to E (bool) fail, value is setted nil into bool")
 	}
 
-	err = setBoolField(nil, "E", &value)
+	err = testStructFields["E"].setBool(nil, &value)
 
 	if err == nil {
-		t.Error("setBoolField: set value to E (bool) fail, value is setted into nil object")
+		t.Error("setBool: set value to E (bool) fail, value is setted into nil object")
 	}
 }
 
@@ -294,27 +272,27 @@ func TestDynamicSetWrappersBool(t *testing.T) {
 	testStruct := &TestStruct{}
 	value := true
 
-	err := setBoolField(testStruct, "F", &value)
+	err := testStructFields["F"].setBool(testStruct, &value)
 
 	if err != nil {
-		t.Errorf("setIntField: set value to F (*wrappers.BoolValue) fail, set should return nil error when pass value true, but error: %v", err)
+		t.Errorf("setInt: set value to F (*wrappers.BoolValue) fail, set should return nil error when pass value true, but error: %v", err)
 	}
 
 	if testStruct.F == nil {
-		t.Errorf("setIntField: set value to F (*wrappers.BoolValue) fail, value (true) is not setted")
+		t.Errorf("setInt: set value to F (*wrappers.BoolValue) fail, value (true) is not setted")
 	}
 	if !testStruct.F.GetValue() {
-		t.Errorf("setIntField: set value to F (*wrappers.BoolValue) fail, value setted not correct should be true but setted: %v", testStruct.F.GetValue())
+		t.Errorf("setInt: set value to F (*wrappers.BoolValue) fail, value setted not correct should be true but setted: %v", testStruct.F.GetValue())
 	}
 
-	err = setBoolField(testStruct, "F", nil)
+	err = testStructFields["F"].setBool(testStruct, nil)
 
 	if err != nil {
-		t.Errorf("setIntField: set value to F (*wrappers.BoolValue) fail, set should return nil error when pass value nil, but error: %v", err)
+		t.Errorf("setInt: set value to F (*wrappers.BoolValue) fail, set should return nil error when pass value nil, but error: %v", err)
 	}
 
 	if testStruct.F != nil {
-		t.Errorf("setIntField: set value to F (*wrappers.BoolValue) fail, value nil is not setted")
+		t.Errorf("setInt: set value to F (*wrappers.BoolValue) fail, value nil is not setted")
 	}
 
 }
@@ -325,26 +303,26 @@ func TestDynamicSetFloatValue(t *testing.T) {
 	testStruct := &TestStruct{}
 	value := 7.6
 
-	err := setFloatField(testStruct, "G", &value)
+	err := testStructFields["G"].setFloat(testStruct, &value)
 
 	if err != nil {
-		t.Errorf("setFloatField: set value to G (float64) fail, set should return nil error when pass value 7.6, but error: %v", err)
+		t.Errorf("setFloat: set value to G (float64) fail, set should return nil error when pass value 7.6, but error: %v", err)
 	}
 
 	if testStruct.G != 7.6 {
-		t.Error("setFloatField: set value to G (float64) fail, value is not setted")
+		t.Error("setFloat: set value to G (float64) fail, value is not setted")
 	}
 
-	err = setFloatField(testStruct, "G", nil)
+	err = testStructFields["G"].setFloat(testStruct, nil)
 
 	if err == nil {
-		t.Error("setFloatField: set value to G (float64) fail, value is setted nil into float")
+		t.Error("setFloat: set value to G (float64) fail, value is setted nil into float")
 	}
 
-	err = setFloatField(nil, "G", &value)
+	err = testStructFields["G"].setFloat(nil, &value)
 
 	if err == nil {
-		t.Error("setFloatField: set value to G (float64) fail, value is setted into nil object")
+		t.Error("setFloat: set value to G (float64) fail, value is setted into nil object")
 	}
 }
 
@@ -354,27 +332,27 @@ func TestDynamicSetWrappersFloat(t *testing.T) {
 	testStruct := &TestStruct{}
 	value := 7.6
 
-	err := setFloatField(testStruct, "H", &value)
+	err := testStructFields["H"].setFloat(testStruct, &value)
 
 	if err != nil {
-		t.Errorf("setFloatField: set value to H (*wrappers.DoubleValue) fail, set should return nil error when pass value 7.6, but error: %v", err)
+		t.Errorf("setFloat: set value to H (*wrappers.DoubleValue) fail, set should return nil error when pass value 7.6, but error: %v", err)
 	}
 
 	if testStruct.H == nil {
-		t.Errorf("setFloatField: set value to H (*wrappers.DoubleValue) fail, value (7.6) is not setted")
+		t.Errorf("setFloat: set value to H (*wrappers.DoubleValue) fail, value (7.6) is not setted")
 	}
 	if testStruct.H.GetValue() != 7.6 {
-		t.Errorf("setFloatField: set value to H (*wrappers.DoubleValue) fail, value setted not correct should be 6 but setted: %v", testStruct.H.GetValue())
+		t.Errorf("setFloat: set value to H (*wrappers.DoubleValue) fail, value setted not correct should be 6 but setted: %v", testStruct.H.GetValue())
 	}
 
-	err = setFloatField(testStruct, "H", nil)
+	err = testStructFields["H"].setFloat(testStruct, nil)
 
 	if err != nil {
-		t.Errorf("setFloatField: set value to H (*wrappers.DoubleValue) fail, set should return nil error when pass value nil, but error: %v", err)
+		t.Errorf("setFloat: set value to H (*wrappers.DoubleValue) fail, set should return nil error when pass value nil, but error: %v", err)
 	}
 
 	if testStruct.H != nil {
-		t.Errorf("setFloatField: set value to H (*wrappers.DoubleValue) fail, value nil is not setted")
+		t.Errorf("setFloat: set value to H (*wrappers.DoubleValue) fail, value nil is not setted")
 	}
 
 }
@@ -385,27 +363,27 @@ func TestDynamicSetStringValue(t *testing.T) {
 	testStruct := &TestStruct{}
 	value := "some text"
 
-	err := setStringField(testStruct, "I", &value)
+	err := testStructFields["I"].setString(testStruct, &value)
 
 	if err != nil {
-		t.Errorf("setStringField: set value to I (string) fail, set should return nil error when pass value \"some text\", but error: %v", err)
+		t.Errorf("setString: set value to I (string) fail, set should return nil error when pass value \"some text\", but error: %v", err)
 		t.Error(err)
 	}
 
 	if testStruct.I != "some text" {
-		t.Error("setStringField: set value to I (string) fail, value is not setted")
+		t.Error("setString: set value to I (string) fail, value is not setted")
 	}
 
-	err = setStringField(testStruct, "I", nil)
+	err = testStructFields["I"].setString(testStruct, nil)
 
 	if err == nil {
-		t.Error("setStringField: set value to I (string) fail, value is setted nil into string")
+		t.Error("setString: set value to I (string) fail, value is setted nil into string")
 	}
 
-	err = setStringField(nil, "I", &value)
+	err = testStructFields["I"].setString(nil, &value)
 
 	if err == nil {
-		t.Error("setStringField: set value to I (string) fail, value is setted into nil object")
+		t.Error("setString: set value to I (string) fail, value is setted into nil object")
 	}
 }
 
@@ -416,7 +394,7 @@ func TestDynamicGetValueFromInt(t *testing.T) {
 		A: 6,
 	}
 
-	v, err := getValueFrom(testStruct, "A")
+	v, err := testStructFields["A"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -440,7 +418,7 @@ func TestDynamicGetValueFromWrappersInt64(t *testing.T) {
 
 	testStruct := TestStruct{}
 
-	v, err := getValueFrom(testStruct, "D")
+	v, err := testStructFields["D"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -450,7 +428,7 @@ func TestDynamicGetValueFromWrappersInt64(t *testing.T) {
 
 	testStruct.D = &wrappers.Int64Value{Value: 6}
 
-	v, err = getValueFrom(testStruct, "D")
+	v, err = testStructFields["D"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -476,7 +454,7 @@ func TestDynamicGetValueFromBool(t *testing.T) {
 		E: true,
 	}
 
-	v, err := getValueFrom(testStruct, "E")
+	v, err := testStructFields["E"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -500,7 +478,7 @@ func TestDynamicGetValueFromWrappersBool(t *testing.T) {
 
 	testStruct := TestStruct{}
 
-	v, err := getValueFrom(testStruct, "F")
+	v, err := testStructFields["F"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -510,7 +488,7 @@ func TestDynamicGetValueFromWrappersBool(t *testing.T) {
 
 	testStruct.F = &wrappers.BoolValue{Value: true}
 
-	v, err = getValueFrom(testStruct, "F")
+	v, err = testStructFields["F"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -537,7 +515,7 @@ func TestDynamicGetValueFromFloat(t *testing.T) {
 		G: 6.5,
 	}
 
-	v, err := getValueFrom(val, "G")
+	v, err := testStructFields["G"].getValue(&val)
 	if err != nil {
 		t.Error(err)
 	}
@@ -561,7 +539,7 @@ func TestDynamicGetValueFromWrappersFloat(t *testing.T) {
 
 	testStruct := TestStruct{}
 
-	v, err := getValueFrom(testStruct, "H")
+	v, err := testStructFields["H"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -571,7 +549,7 @@ func TestDynamicGetValueFromWrappersFloat(t *testing.T) {
 
 	testStruct.H = &wrappers.DoubleValue{Value: 6.5}
 
-	v, err = getValueFrom(testStruct, "H")
+	v, err = testStructFields["H"].getValue(&testStruct)
 	if err != nil {
 		t.Error(err)
 	}
@@ -597,7 +575,7 @@ func TestDynamicGetValueFromString(t *testing.T) {
 		I: "str",
 	}
 
-	v, err := getValueFrom(val, "I")
+	v, err := testStructFields["I"].getValue(&val)
 	if err != nil {
 		t.Error(err)
 	}
@@ -650,58 +628,61 @@ func TestDynamicGetStructValue(t *testing.T) {
 		I: "str",
 	}
 
-	sv, err := getStructValue(val)
-	if err != nil {
-		t.Error(err)
+	_, err := testStructFields["A"].getValue(val)
+	if !isTypeMismatchError(err) {
+		t.Errorf("Value get fail: accessors should only accept a pointer to the struct, error: %v", err)
 	}
-	svp, err := getStructValue(&val)
+	v, err := testStructFields["A"].getValue(&val)
 	if err != nil {
 		t.Error(err)
 	}
 
-	if sv.Type() != svp.Type() {
-		t.Errorf("Value type get fail types must be equal %v != %v", sv.Type(), svp.Type())
+	if v != 6 {
+		t.Errorf("Value get fail: value must be equal 6 != %v", v)
 	}
 
-	if svp.Type() != reflect.TypeOf(val) {
-		t.Errorf("Value type get fail types. Type must be not ptr. Must be equal %v != %v", sv.Type(), reflect.TypeOf(&val))
+	_, err = testStructFields["A"].getValue((*TestStruct)(nil))
+	if !isTypeMismatchError(err) {
+		t.Errorf("Value get fail: accessors should not accept nil, error: %v", err)
 	}
 }
 
 func TestDynamicGetFieldsInfo(t *testing.T) {
 	t.Parallel()
 
-	testStruct := TestStruct{}
-
-	m, err := getFieldsInfo(testStruct, "tag_test", "name")
-
-	if err != nil {
-		t.Error(err)
+	valueTypes := map[reflect.Type]schema.ValueType{
+		reflect.TypeOf(&wrappers.Int64Value{}):  schema.TypeInt,
+		reflect.TypeOf(&wrappers.BoolValue{}):   schema.TypeBool,
+		reflect.TypeOf(&wrappers.DoubleValue{}): schema.TypeFloat,
 	}
-
-	if len(m) != 7 {
-		t.Errorf("must be found 7 fields not %v: %v", len(m), m)
+	kindTypes := map[reflect.Kind]schema.ValueType{
+		reflect.Int32:   schema.TypeInt,
+		reflect.Int64:   schema.TypeInt,
+		reflect.Bool:    schema.TypeBool,
+		reflect.Float32: schema.TypeFloat,
+		reflect.Float64: schema.TypeFloat,
+		reflect.String:  schema.TypeString,
 	}
 
-	if m["b_name"].valueType != schema.TypeInt {
-		t.Errorf("field b_name must be int, not %v", m["b_name"].valueType)
-	}
-	if m["d_name"].valueType != schema.TypeInt {
-		t.Errorf("field d_name must be int, not %v", m["d_name"].valueType)
-	}
-	if m["e_name"].valueType != schema.TypeBool {
-		t.Errorf("field e_name must be bool, not %v", m["e_name"].valueType)
-	}
-	if m["f_name"].valueType != schema.TypeBool {
-		t.Errorf("field f_name must be bool, not %v", m["f_name"].valueType)
-	}
-	if m["g_name"].valueType != schema.TypeFloat {
-		t.Errorf("field g_name must be float, not %v", m["g_name"].valueType)
-	}
-	if m["h_name"].valueType != schema.TypeFloat {
-		t.Errorf("field h_name must be float, not %v", m["h_name"].valueType)
-	}
-	if m["i_name"].valueType != schema.TypeString {
-		t.Errorf("field i_name must be string, not %v", m["i_name"].valueType)
+	for tp, fields := range generatedFieldsInfo {
+		if len(fields) == 0 {
+			t.Errorf("must be found fields of %s", tp)
+		}
+
+		for key, fi := range fields {
+			fl, ok := tp.FieldByName(fi.name)
+			if !ok {
+				t.Errorf("Not found field %s of %s", fi.name, tp)
+				continue
+			}
+
+			expected, ok := valueTypes[fl.Type]
+			if !ok {
+				expected = kindTypes[fl.Type.Kind()]
+			}
+			if fi.valueType != expected {
+				t.Errorf("field %s of %s must be %v, not %v", key, tp, expected, fi.valueType)
+			}
+		}
 	}
 }
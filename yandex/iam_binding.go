@@ -12,6 +12,9 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"golang.org/x/exp/maps"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
 )
 
 var accessBindingSchema = map[string]*schema.Schema{
@@ -38,21 +41,24 @@ var accessBindingSchema = map[string]*schema.Schema{
 
 func resourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, opts ...SchemaOption) *schema.Resource {
 	r := &schema.Resource{
-		CreateContext: resourceAccessBindingCreate(newUpdaterFunc),
-		ReadContext:   resourceAccessBindingRead(newUpdaterFunc, false),
-		UpdateContext: resourceAccessBindingUpdate(newUpdaterFunc),
-		DeleteContext: resourceAccessBindingDelete(newUpdaterFunc),
 		Schema:        mergeSchemas(accessBindingSchema, parentSpecificSchema),
+		CustomizeDiff: resolveIamMembersDiff("members"),
 	}
 
 	for _, opt := range opts {
 		opt(r)
 	}
 
+	_, withModes := r.Schema["mode"]
+	r.CreateContext = resourceAccessBindingCreate(newUpdaterFunc, withModes)
+	r.ReadContext = resourceAccessBindingRead(newUpdaterFunc, false, withModes)
+	r.UpdateContext = resourceAccessBindingUpdate(newUpdaterFunc, withModes)
+	r.DeleteContext = resourceAccessBindingDelete(newUpdaterFunc, withModes)
+
 	return r
 }
 
-func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateContextFunc {
+func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc, withModes bool) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -60,7 +66,18 @@ func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			return diag.FromErr(err)
 		}
 
-		p := getResourceIamBindings(d)
+		if iamBindingMode(d, withModes) == iamBindingModeDetectOnly {
+			log.Printf("[DEBUG]: Access bindings of %s are only checked for drift, not changing them", updater.DescribeResource())
+			d.SetId(updater.GetResourceID() + "/" + d.Get("role").(string))
+			return resourceAccessBindingRead(newUpdaterFunc, true, withModes)(ctx, d, meta)
+		}
+
+		resolved, err := resolveIamBindingMembers(ctx, d, config, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		p := getResourceIamBindings(d, resolved)
+
 		err = iamPolicyReadModifySet(ctx, updater, func(ep *Policy) error {
 			// Creating a binding does not remove existing members if they are not in the provided members list.
 			// This prevents removing existing permission without the user's knowledge.
@@ -73,18 +90,17 @@ func resourceAccessBindingCreate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			return diag.FromErr(err)
 		}
 
-		role := p[0].RoleId
-		d.SetId(updater.GetResourceID() + "/" + role)
+		d.SetId(updater.GetResourceID() + "/" + d.Get("role").(string))
 
 		if v, ok := d.GetOk("sleep_after"); ok {
 			time.Sleep(time.Second * time.Duration(v.(int)))
 		}
 
-		return resourceAccessBindingRead(newUpdaterFunc, true)(ctx, d, meta)
+		return resourceAccessBindingRead(newUpdaterFunc, true, withModes)(ctx, d, meta)
 	}
 }
 
-func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check bool) schema.ReadContextFunc {
+func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check bool, withModes bool) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -93,7 +109,11 @@ func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check b
 		}
 
 		role := d.Get("role").(string)
-		eBindings := getResourceIamBindings(d)
+		resolved, err := resolveIamBindingMembers(ctx, d, config, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		eBindings := getResourceIamBindings(d, resolved)
 
 		p, err := updater.GetResourceIamPolicy(ctx)
 		if err != nil {
@@ -111,6 +131,10 @@ func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check b
 		}
 		log.Printf("[DEBUG]: Retrieved access bindings of %s: %+v", updater.DescribeResource(), p)
 
+		if mode := iamBindingMode(d, withModes); mode != "" {
+			return readAccessBindingWithMode(d, updater, p, mode, check, resolved)
+		}
+
 		var mBindings []*access.AccessBinding
 		for _, b := range p.Bindings {
 			if b.RoleId != role {
@@ -138,14 +162,14 @@ func resourceAccessBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, check b
 			}
 		}
 
-		if err := d.Set("members", roleToMembersList(role, mBindings)); err != nil {
+		if err := d.Set("members", principal.Restore(roleToMembersList(role, mBindings), resolved)); err != nil {
 			return diag.FromErr(err)
 		}
 		return nil
 	}
 }
 
-func resourceAccessBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateContextFunc {
+func resourceAccessBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc, withModes bool) schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -153,23 +177,44 @@ func resourceAccessBindingUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			return diag.FromErr(err)
 		}
 
-		bindings := getResourceIamBindings(d)
+		resolved, err := resolveIamBindingMembers(ctx, d, config, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		bindings := getResourceIamBindings(d, resolved)
 		role := d.Get("role").(string)
 
-		err = iamPolicyReadModifySet(ctx, updater, func(p *Policy) error {
-			p.Bindings = removeRoleFromBindings(role, p.Bindings)
-			p.Bindings = append(p.Bindings, bindings...)
-			return nil
-		})
+		switch iamBindingMode(d, withModes) {
+		case iamBindingModeDetectOnly:
+			log.Printf("[DEBUG]: Access bindings of %s are only checked for drift, not changing them", updater.DescribeResource())
+		case iamBindingModeAdditive:
+			oldMembers, newMembers := d.GetChange("members")
+			var removed map[string]string
+			removed, err = resolveIamMembers(ctx, config, convertStringSet(oldMembers.(*schema.Set).Difference(newMembers.(*schema.Set))), true)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = iamPolicyReadModifySet(ctx, updater, func(p *Policy) error {
+				p.Bindings = removeRoleMembersFromBindings(role, maps.Values(removed), p.Bindings)
+				p.Bindings = mergeBindings(append(p.Bindings, bindings...))
+				return nil
+			})
+		default:
+			err = iamPolicyReadModifySet(ctx, updater, func(p *Policy) error {
+				p.Bindings = removeRoleFromBindings(role, p.Bindings)
+				p.Bindings = append(p.Bindings, bindings...)
+				return nil
+			})
+		}
 		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		return resourceAccessBindingRead(newUpdaterFunc, true)(ctx, d, meta)
+		return resourceAccessBindingRead(newUpdaterFunc, true, withModes)(ctx, d, meta)
 	}
 }
 
-func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteContextFunc {
+func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc, withModes bool) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -177,14 +222,28 @@ func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			return diag.FromErr(err)
 		}
 
-		binding := getResourceIamBindings(d)
-		if len(binding) == 0 {
+		if d.Get("members").(*schema.Set).Len() == 0 {
 			log.Printf("[DEBUG]: Resource %s is missing or deleted, marking policy binding as deleted", updater.DescribeResource())
 			return nil
 		}
-		role := binding[0].RoleId
+		role := d.Get("role").(string)
+		mode := iamBindingMode(d, withModes)
+
+		if mode == iamBindingModeDetectOnly {
+			log.Printf("[DEBUG]: Access bindings of %s are only checked for drift, not changing them", updater.DescribeResource())
+			return nil
+		}
+
+		resolved, err := resolveIamBindingMembers(ctx, d, config, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		err = iamPolicyReadModifySet(ctx, updater, func(p *Policy) error {
+			if mode == iamBindingModeAdditive {
+				p.Bindings = removeRoleMembersFromBindings(role, maps.Values(resolved), p.Bindings)
+				return nil
+			}
 			p.Bindings = removeRoleFromBindings(role, p.Bindings)
 			return nil
 		})
@@ -198,7 +257,7 @@ func resourceAccessBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schem
 			return diag.FromErr(err)
 		}
 
-		return resourceAccessBindingRead(newUpdaterFunc, false)(ctx, d, meta)
+		return resourceAccessBindingRead(newUpdaterFunc, false, withModes)(ctx, d, meta)
 	}
 }
 
@@ -230,15 +289,21 @@ func iamBindingImport(resourceIDParser resourceIDParserFunc) schema.StateContext
 	}
 }
 
-// all bindings use same Role
-func getResourceIamBindings(d *schema.ResourceData) []*access.AccessBinding {
+// all bindings use same Role, members are given in the canonical form, members missing in resolved are skipped
+func getResourceIamBindings(d *schema.ResourceData, resolved map[string]string) []*access.AccessBinding {
 	members := d.Get("members").(*schema.Set)
 	role := d.Get("role").(string)
 
-	result := make([]*access.AccessBinding, members.Len())
+	result := make([]*access.AccessBinding, 0, members.Len())
 
-	for i, member := range convertStringSet(members) {
-		result[i] = roleMemberToAccessBinding(role, member)
+	for _, member := range convertStringSet(members) {
+		if canonical, ok := resolved[member]; ok {
+			result = append(result, roleMemberToAccessBinding(role, canonical))
+		}
 	}
 	return result
 }
+
+func resolveIamBindingMembers(ctx context.Context, d *schema.ResourceData, config *Config, ignoreNotFound bool) (map[string]string, error) {
+	return resolveIamMembers(ctx, config, convertStringSet(d.Get("members").(*schema.Set)), ignoreNotFound)
+}
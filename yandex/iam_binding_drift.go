@@ -0,0 +1,174 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
+)
+
+const (
+	// iamBindingModeAuthoritative makes the binding the only source of members of the role,
+	// members added outside Terraform show up in the plan and are removed.
+	iamBindingModeAuthoritative = "authoritative"
+	// iamBindingModeAdditive only manages configured members, other members of the role are kept intact.
+	iamBindingModeAdditive = "additive"
+	// iamBindingModeDetectOnly never changes access bindings, drift is only reported.
+	iamBindingModeDetectOnly = "detect-only"
+)
+
+// iamPrincipalTypes are human-readable names of access binding subject types.
+var iamPrincipalTypes = map[string]string{
+	"userAccount":    "user account",
+	"serviceAccount": "service account",
+	"group":          "group",
+	"federatedUser":  "federated user",
+	"system":         "system group",
+	"email":          "user with e-mail",
+}
+
+// WithIamBindingModes adds the `mode` attribute to an IAM binding resource, which enables
+// reporting of changes of the role members made outside Terraform.
+func WithIamBindingModes() SchemaOption {
+	return func(r *schema.Resource) {
+		r.Schema["mode"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      iamBindingModeAuthoritative,
+			ValidateFunc: validation.StringInSlice([]string{iamBindingModeAuthoritative, iamBindingModeAdditive, iamBindingModeDetectOnly}, false),
+			// bindings created before the attribute was added have no mode in state and are authoritative
+			DiffSuppressFunc: iamBindingModeDiffSuppress,
+		}
+	}
+}
+
+func iamBindingModeDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	if old == "" {
+		old = iamBindingModeAuthoritative
+	}
+	return old == new
+}
+
+// iamBindingMode returns the binding mode, or empty string for resources without modes.
+func iamBindingMode(d *schema.ResourceData, withModes bool) string {
+	if !withModes {
+		return ""
+	}
+	if mode := d.Get("mode").(string); mode != "" {
+		return mode
+	}
+	return iamBindingModeAuthoritative
+}
+
+// readAccessBindingWithMode refreshes members of the role according to the binding mode. The resolved map
+// holds canonical forms of the members, which are kept in state in the form given in the configuration.
+func readAccessBindingWithMode(d *schema.ResourceData, updater ResourceIamUpdater, p *Policy, mode string, check bool, resolved map[string]string) diag.Diagnostics {
+	role := d.Get("role").(string)
+	expected := d.Get("members").(*schema.Set)
+	actual := schema.NewSet(expected.F, convertStringArrToInterface(roleToMembersList(role, p.Bindings)))
+
+	if actual.Len() == 0 && mode != iamBindingModeDetectOnly {
+		if check {
+			return diag.FromErr(fmt.Errorf("Access bindings for role %q not found in access bindings of %s.", role, updater.DescribeResource()))
+		}
+		log.Printf("[DEBUG]: Access bindings for role %q not found in access bindings of %s, removing from state.", role, updater.DescribeResource())
+		d.SetId("")
+		return nil
+	}
+
+	expectedCanonical := schema.NewSet(expected.F, nil)
+	var present, removed []string
+	for _, member := range convertStringSet(expected) {
+		canonical, ok := resolved[member]
+		if ok {
+			expectedCanonical.Add(canonical)
+		}
+		if ok && actual.Contains(canonical) {
+			present = append(present, member)
+		} else {
+			removed = append(removed, member)
+		}
+	}
+
+	added := convertStringSet(actual.Difference(expectedCanonical))
+	diags := iamBindingDriftDiagnostics(updater.DescribeResource(), role, mode, added, removed)
+
+	if err := d.Set("mode", mode); err != nil {
+		return diag.FromErr(err)
+	}
+
+	switch mode {
+	case iamBindingModeAuthoritative:
+		if err := d.Set("members", principal.Restore(convertStringSet(actual), resolved)); err != nil {
+			return diag.FromErr(err)
+		}
+	case iamBindingModeAdditive:
+		if err := d.Set("members", present); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func iamBindingDriftDiagnostics(resource, role, mode string, added, removed []string) diag.Diagnostics {
+	if mode == iamBindingModeAdditive {
+		// members added outside Terraform are expected in additive mode
+		added = nil
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	var detail strings.Builder
+	if len(added) > 0 {
+		detail.WriteString("Added outside Terraform:\n")
+		detail.WriteString(describeIamPrincipals(added))
+	}
+	if len(removed) > 0 {
+		detail.WriteString("Removed outside Terraform:\n")
+		detail.WriteString(describeIamPrincipals(removed))
+	}
+
+	switch mode {
+	case iamBindingModeAuthoritative:
+		detail.WriteString("The changes will be reverted on apply.")
+	case iamBindingModeAdditive:
+		detail.WriteString("The removed members will be added back on apply.")
+	case iamBindingModeDetectOnly:
+		detail.WriteString("The changes are only reported, since mode is \"detect-only\".")
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Members of role %q of %s changed outside Terraform", role, resource),
+			Detail:   detail.String(),
+		},
+	}
+}
+
+// describeIamPrincipals lists members with their principal types, grouped by type.
+func describeIamPrincipals(members []string) string {
+	sorted := append([]string{}, members...)
+	sort.Strings(sorted)
+
+	var b strings.Builder
+	for _, member := range sorted {
+		principalType, id := member, ""
+		if chunks := strings.SplitN(member, ":", 2); len(chunks) == 2 {
+			principalType, id = chunks[0], chunks[1]
+		}
+		if name, ok := iamPrincipalTypes[principalType]; ok {
+			principalType = name
+		}
+		b.WriteString(fmt.Sprintf("  - %s %s\n", principalType, id))
+	}
+	return b.String()
+}
@@ -0,0 +1,200 @@
+package yandex
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+)
+
+type testIamUpdater struct {
+	policy *Policy
+}
+
+func (u *testIamUpdater) GetResourceIamPolicy(context.Context) (*Policy, error) {
+	return u.policy, nil
+}
+
+func (u *testIamUpdater) SetResourceIamPolicy(_ context.Context, policy *Policy) error {
+	u.policy = policy
+	return nil
+}
+
+func (u *testIamUpdater) UpdateResourceIamPolicy(context.Context, *PolicyDelta) error {
+	return nil
+}
+
+func (u *testIamUpdater) GetMutexKey() string      { return "iam-test" }
+func (u *testIamUpdater) GetResourceID() string    { return "folder1" }
+func (u *testIamUpdater) DescribeResource() string { return "folder \"folder1\"" }
+
+func TestReadAccessBindingWithMode(t *testing.T) {
+	policy := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("viewer", "serviceAccount:outside"),
+		roleMemberToAccessBinding("editor", "userAccount:b"),
+	}}
+
+	cases := []struct {
+		mode            string
+		expectedMembers []string
+		expectedDetail  []string
+	}{
+		{
+			mode:            iamBindingModeAuthoritative,
+			expectedMembers: []string{"serviceAccount:outside", "userAccount:a"},
+			expectedDetail:  []string{"Added outside Terraform", "service account outside", "Removed outside Terraform", "group removed"},
+		},
+		{
+			mode:            iamBindingModeAdditive,
+			expectedMembers: []string{"userAccount:a"},
+			expectedDetail:  []string{"Removed outside Terraform", "group removed"},
+		},
+		{
+			mode:            iamBindingModeDetectOnly,
+			expectedMembers: []string{"group:removed", "userAccount:a"},
+			expectedDetail:  []string{"Added outside Terraform", "Removed outside Terraform", "detect-only"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.mode, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceYandexResourceManagerFolderIAMBinding().Schema, map[string]interface{}{
+				"folder_id": "folder1",
+				"role":      "viewer",
+				"members":   []interface{}{"userAccount:a", "group:removed"},
+				"mode":      tc.mode,
+			})
+			d.SetId("folder1/viewer")
+
+			diags := readAccessBindingWithMode(d, &testIamUpdater{}, policy, tc.mode, false, testResolvedIamMembers("userAccount:a", "group:removed"))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if len(diags) != 1 || diags[0].Severity != diag.Warning {
+				t.Fatalf("expected a single warning, got %v", diags)
+			}
+			for _, s := range tc.expectedDetail {
+				if !strings.Contains(diags[0].Detail, s) {
+					t.Errorf("expected warning detail to contain %q, got %q", s, diags[0].Detail)
+				}
+			}
+			if tc.mode == iamBindingModeAdditive && strings.Contains(diags[0].Detail, "Added outside Terraform") {
+				t.Errorf("unexpected added members warning in additive mode: %q", diags[0].Detail)
+			}
+
+			members := convertStringSet(d.Get("members").(*schema.Set))
+			sort.Strings(members)
+			if !reflect.DeepEqual(members, tc.expectedMembers) {
+				t.Errorf("members = %v, want %v", members, tc.expectedMembers)
+			}
+		})
+	}
+}
+
+func TestReadAccessBindingWithModeNoDrift(t *testing.T) {
+	policy := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+	}}
+
+	d := schema.TestResourceDataRaw(t, resourceYandexResourceManagerFolderIAMBinding().Schema, map[string]interface{}{
+		"folder_id": "folder1",
+		"role":      "viewer",
+		"members":   []interface{}{"userAccount:a"},
+	})
+	d.SetId("folder1/viewer")
+
+	if diags := readAccessBindingWithMode(d, &testIamUpdater{}, policy, iamBindingModeAuthoritative, false, testResolvedIamMembers("userAccount:a")); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+	if d.Id() == "" {
+		t.Errorf("binding is unexpectedly removed from state")
+	}
+
+	d.Set("role", "editor")
+	if diags := readAccessBindingWithMode(d, &testIamUpdater{}, policy, iamBindingModeAuthoritative, false, testResolvedIamMembers("userAccount:a")); len(diags) != 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("binding without members is expected to be removed from state")
+	}
+}
+
+func TestReadAccessBindingWithModeResolvedMembers(t *testing.T) {
+	policy := &Policy{Bindings: []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("viewer", "serviceAccount:b"),
+	}}
+	resolved := map[string]string{
+		"email:alice@corp.example":  "userAccount:a",
+		"serviceAccount:folder1/sa": "serviceAccount:b",
+	}
+
+	for _, mode := range []string{iamBindingModeAuthoritative, iamBindingModeAdditive} {
+		t.Run(mode, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceYandexResourceManagerFolderIAMBinding().Schema, map[string]interface{}{
+				"folder_id": "folder1",
+				"role":      "viewer",
+				"members":   []interface{}{"email:alice@corp.example", "serviceAccount:folder1/sa"},
+				"mode":      mode,
+			})
+			d.SetId("folder1/viewer")
+
+			if diags := readAccessBindingWithMode(d, &testIamUpdater{}, policy, mode, false, resolved); len(diags) != 0 {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+
+			members := convertStringSet(d.Get("members").(*schema.Set))
+			sort.Strings(members)
+			expected := []string{"email:alice@corp.example", "serviceAccount:folder1/sa"}
+			if !reflect.DeepEqual(members, expected) {
+				t.Errorf("members = %v, want %v", members, expected)
+			}
+		})
+	}
+}
+
+func TestRemoveRoleMembersFromBindings(t *testing.T) {
+	bindings := []*access.AccessBinding{
+		roleMemberToAccessBinding("viewer", "userAccount:a"),
+		roleMemberToAccessBinding("viewer", "userAccount:b"),
+		roleMemberToAccessBinding("editor", "userAccount:a"),
+	}
+
+	result := removeRoleMembersFromBindings("viewer", []string{"userAccount:a"}, bindings)
+	if len(result) != 2 || canonicalMember(result[0]) != "userAccount:b" || result[1].RoleId != "editor" {
+		t.Errorf("unexpected bindings %v", result)
+	}
+}
+
+func testResolvedIamMembers(members ...string) map[string]string {
+	resolved := make(map[string]string, len(members))
+	for _, member := range members {
+		resolved[member] = member
+	}
+	return resolved
+}
+
+func TestIamBindingModeDiffSuppress(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{"", iamBindingModeAuthoritative, true},
+		{"", iamBindingModeAdditive, false},
+		{iamBindingModeAuthoritative, iamBindingModeAuthoritative, true},
+		{iamBindingModeAdditive, iamBindingModeAuthoritative, false},
+		{iamBindingModeAuthoritative, iamBindingModeDetectOnly, false},
+	}
+
+	for _, tc := range cases {
+		if got := iamBindingModeDiffSuppress("mode", tc.old, tc.new, nil); got != tc.suppress {
+			t.Errorf("iamBindingModeDiffSuppress(%q, %q) = %v, want %v", tc.old, tc.new, got, tc.suppress)
+		}
+	}
+}
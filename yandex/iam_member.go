@@ -13,6 +13,8 @@ import (
 	"google.golang.org/grpc/codes"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common/principal"
 )
 
 var IamMemberBaseSchema = map[string]*schema.Schema{
@@ -36,9 +38,8 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 }
 
 func validateIamMember(i interface{}, k string) (s []string, es []error) {
-	chunks := strings.SplitN(i.(string), ":", 2)
-	if len(chunks) == 1 || chunks[0] == "" || chunks[1] == "" {
-		es = append(es, fmt.Errorf("expect 'member' value should be in TYPE:ID format, got '%v'", i.(string)))
+	if err := principal.Validate(i.(string)); err != nil {
+		es = append(es, fmt.Errorf("expect 'member' value should be in TYPE:ID format, got '%v': %s", i.(string), err))
 	}
 	return
 }
@@ -85,6 +86,7 @@ func resourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdate
 		CreateContext: resourceIamMemberCreate(newUpdaterFunc),
 		ReadContext:   resourceIamMemberRead(newUpdaterFunc),
 		DeleteContext: resourceIamMemberDelete(newUpdaterFunc),
+		CustomizeDiff: resolveIamMembersDiff("member"),
 
 		Schema: mergeSchemas(IamMemberBaseSchema, parentSpecificSchema),
 	}
@@ -96,11 +98,21 @@ func resourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdate
 	return r
 }
 
-func getResourceIamMember(d *schema.ResourceData) *access.AccessBinding {
+// getResourceIamMember returns the access binding of the member in the canonical form,
+// or nil if ignoreNotFound is set and the principal does not exist.
+func getResourceIamMember(ctx context.Context, d *schema.ResourceData, config *Config, ignoreNotFound bool) (*access.AccessBinding, error) {
 	member := d.Get("member").(string)
 	role := d.Get("role").(string)
 
-	return roleMemberToAccessBinding(role, member)
+	resolved, err := resolveIamMembers(ctx, config, []string{member}, ignoreNotFound)
+	if err != nil {
+		return nil, err
+	}
+	canonical, ok := resolved[member]
+	if !ok {
+		return nil, nil
+	}
+	return roleMemberToAccessBinding(role, canonical), nil
 }
 
 func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateContextFunc {
@@ -111,7 +123,10 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 			return diag.FromErr(err)
 		}
 
-		member := getResourceIamMember(d)
+		member, err := getResourceIamMember(ctx, d, config, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 		err = iamPolicyReadModifyUpdate(ctx, updater, &PolicyDelta{
 			Deltas: []*access.AccessBindingDelta{
 				{
@@ -123,7 +138,7 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		d.SetId(updater.GetResourceID() + "/" + member.RoleId + "/" + canonicalMember(member))
+		d.SetId(updater.GetResourceID() + "/" + member.RoleId + "/" + d.Get("member").(string))
 
 		if v, ok := d.GetOk("sleep_after"); ok {
 			time.Sleep(time.Second * time.Duration(v.(int)))
@@ -141,7 +156,16 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 			return diag.FromErr(err)
 		}
 
-		eMember := getResourceIamMember(d)
+		eMember, err := getResourceIamMember(ctx, d, config, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if eMember == nil {
+			log.Printf("[DEBUG]: Member %q does not exist, removing from state.", d.Get("member").(string))
+			d.SetId("")
+			return nil
+		}
+
 		p, err := updater.GetResourceIamPolicy(ctx)
 		if err != nil {
 			if isStatusWithCode(err, codes.NotFound) {
@@ -169,10 +193,11 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 			return nil
 		}
 
+		// the member is kept in the form given in the configuration, which may differ from the canonical one
 		var member string
 		for _, b := range mBinding {
 			if canonicalMember(b) == canonicalMember(eMember) {
-				member = canonicalMember(b)
+				member = d.Get("member").(string)
 			}
 		}
 		if member == "" {
@@ -194,7 +219,14 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 			return diag.FromErr(err)
 		}
 
-		member := getResourceIamMember(d)
+		member, err := getResourceIamMember(ctx, d, config, true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if member == nil {
+			log.Printf("[DEBUG]: Member %q does not exist, nothing to remove.", d.Get("member").(string))
+			return nil
+		}
 
 		err = iamPolicyReadModifyUpdate(ctx, updater, &PolicyDelta{
 			Deltas: []*access.AccessBindingDelta{
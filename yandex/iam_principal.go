@@ -0,0 +1,34 @@
+package yandex
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resolveIamMembers maps members to their canonical TYPE:ID form, members given by e-mail, name
+// or federation are looked up. Members of principals which no longer exist are left out of the result
+// if ignoreNotFound is set, which is used on refresh so that a removed principal shows up in the plan.
+func resolveIamMembers(ctx context.Context, config *Config, members []string, ignoreNotFound bool) (map[string]string, error) {
+	return config.principals.ResolveAll(ctx, members, ignoreNotFound)
+}
+
+// resolveIamMembersDiff fails the plan early if members under the key can not be resolved.
+func resolveIamMembersDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+
+		var members []string
+		switch v := d.Get(key).(type) {
+		case string:
+			members = []string{v}
+		case *schema.Set:
+			members = convertStringSet(v)
+		}
+
+		_, err := resolveIamMembers(ctx, meta.(*Config), members, false)
+		return err
+	}
+}
@@ -0,0 +1,289 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"google.golang.org/grpc/codes"
+)
+
+const defaultServiceAccountKeyOverlapCount = 2
+
+// serviceAccountKeyKind creates and deletes service account keys of one type, so that keys of
+// all types are rotated and handed off to Lockbox in the same way.
+type serviceAccountKeyKind struct {
+	// description is a human-readable name of the key type, e.g. "Service Account Key".
+	description string
+	// secretEntries are attributes of the `output_to_lockbox` block with names of entries
+	// for the key material, along with their descriptions.
+	secretEntries map[string]string
+	// rotatedAttributes are computed attributes which change on each rotation.
+	rotatedAttributes []string
+
+	// create creates a key and returns its ID and the key material by attributes of `output_to_lockbox`.
+	create func(ctx context.Context, d *schema.ResourceData, config *Config) (string, map[string]string, error)
+	// exists checks whether the key with the ID still exists.
+	exists func(ctx context.Context, config *Config, id string) (bool, error)
+	delete func(ctx context.Context, config *Config, id string) error
+}
+
+// serviceAccountKeyRotationSchema returns attributes common to all rotatable service account keys.
+func serviceAccountKeyRotationSchema(kind *serviceAccountKeyKind) map[string]*schema.Schema {
+	lockboxSchema := map[string]*schema.Schema{
+		"secret_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the Lockbox secret to add versions with the key material to.",
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+	for attr, description := range kind.secretEntries {
+		lockboxSchema[attr] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: description,
+			Required:    true,
+			ForceNew:    true,
+		}
+	}
+
+	return map[string]*schema.Schema{
+		"output_to_lockbox": {
+			Type:          schema.TypeList,
+			Description:   "Writes the key material to a Lockbox secret version instead of the state.",
+			Optional:      true,
+			ForceNew:      true,
+			MaxItems:      1,
+			ConflictsWith: []string{"pgp_key"},
+			Elem:          &schema.Resource{Schema: lockboxSchema},
+		},
+
+		"rotation": {
+			Type:         schema.TypeList,
+			Description:  "Periodically creates a new key, keeping the given number of the newest keys active.",
+			Optional:     true,
+			MaxItems:     1,
+			RequiredWith: []string{"output_to_lockbox"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"period": {
+						Type:         schema.TypeString,
+						Description:  "Time between rotations, e.g. `720h`.",
+						Required:     true,
+						ValidateFunc: validateServiceAccountKeyRotationPeriod,
+					},
+					"overlap_count": {
+						Type:         schema.TypeInt,
+						Description:  "Number of keys kept active, including the newest one. The oldest key is deleted when exceeded.",
+						Optional:     true,
+						Default:      defaultServiceAccountKeyOverlapCount,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"output_to_lockbox_version_id": {
+			Type:        schema.TypeString,
+			Description: "ID of the Lockbox secret version with the material of the newest key.",
+			Computed:    true,
+		},
+
+		"active_key_ids": {
+			Type:        schema.TypeList,
+			Description: "IDs of the keys kept active, from the oldest to the newest one.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func validateServiceAccountKeyRotationPeriod(v interface{}, k string) (ws []string, es []error) {
+	period, err := time.ParseDuration(v.(string))
+	if err != nil {
+		es = append(es, fmt.Errorf("%q should be a duration, e.g. 720h: %s", k, err))
+		return
+	}
+	if period < time.Hour {
+		es = append(es, fmt.Errorf("%q should be at least 1h, got %s", k, period))
+	}
+	return
+}
+
+// serviceAccountKeyRotationDiff plans a rotation once the newest key is older than the rotation period.
+func serviceAccountKeyRotationDiff(kind *serviceAccountKeyKind) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+
+		period, ok := serviceAccountKeyRotationPeriod(d.Get("rotation").([]interface{}))
+		if !ok {
+			return nil
+		}
+
+		createdAt, err := time.Parse(defaultTimeFormat, d.Get("created_at").(string))
+		if err != nil {
+			return nil
+		}
+		if time.Since(createdAt) < period {
+			return nil
+		}
+
+		log.Printf("[DEBUG] %s %q is older than %s, planning rotation", kind.description, d.Id(), period)
+		for _, attr := range append([]string{"created_at", "output_to_lockbox_version_id", "active_key_ids"}, kind.rotatedAttributes...) {
+			if err := d.SetNewComputed(attr); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func serviceAccountKeyRotationPeriod(rotation []interface{}) (time.Duration, bool) {
+	if len(rotation) == 0 || rotation[0] == nil {
+		return 0, false
+	}
+	period, err := time.ParseDuration(rotation[0].(map[string]interface{})["period"].(string))
+	if err != nil {
+		return 0, false
+	}
+	return period, true
+}
+
+// createServiceAccountKey creates the first key. The key material is written to Lockbox if requested,
+// otherwise it is up to the caller to store it.
+func createServiceAccountKey(ctx context.Context, d *schema.ResourceData, config *Config, kind *serviceAccountKeyKind) (map[string]string, error) {
+	id, material, err := kind.create(ctx, d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	d.Set("active_key_ids", []string{id})
+
+	if _, ok := d.GetOk("output_to_lockbox"); !ok {
+		return material, nil
+	}
+	return nil, outputServiceAccountKeyToLockbox(ctx, d, config, kind, material)
+}
+
+// rotateServiceAccountKey creates a new key, hands it off to Lockbox and deletes the oldest keys
+// exceeding the overlap count.
+func rotateServiceAccountKey(ctx context.Context, d *schema.ResourceData, config *Config, kind *serviceAccountKeyKind) error {
+	id, material, err := kind.create(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	activeKeyIDs := expandStringSlice(d.Get("active_key_ids").([]interface{}))
+	if len(activeKeyIDs) == 0 {
+		activeKeyIDs = []string{d.Id()}
+	}
+	activeKeyIDs = append(activeKeyIDs, id)
+
+	log.Printf("[DEBUG] Rotated %s %q to %q", kind.description, d.Id(), id)
+	d.SetId(id)
+	d.Set("active_key_ids", activeKeyIDs)
+
+	if err := outputServiceAccountKeyToLockbox(ctx, d, config, kind, material); err != nil {
+		return err
+	}
+
+	overlapCount := d.Get("rotation.0.overlap_count").(int)
+	for len(activeKeyIDs) > overlapCount {
+		oldest := activeKeyIDs[0]
+		log.Printf("[DEBUG] Deleting %s %q, since only %d keys are kept active", kind.description, oldest, overlapCount)
+		if err := kind.delete(ctx, config, oldest); err != nil && !isStatusWithCode(err, codes.NotFound) {
+			return fmt.Errorf("error deleting %s %q: %s", kind.description, oldest, err)
+		}
+		activeKeyIDs = activeKeyIDs[1:]
+		d.Set("active_key_ids", activeKeyIDs)
+	}
+
+	return nil
+}
+
+func outputServiceAccountKeyToLockbox(ctx context.Context, d *schema.ResourceData, config *Config, kind *serviceAccountKeyKind, material map[string]string) error {
+	secretID := d.Get("output_to_lockbox.0.secret_id").(string)
+
+	req := &lockbox.AddVersionRequest{
+		SecretId:    secretID,
+		Description: fmt.Sprintf("%s %s", kind.description, d.Id()),
+	}
+	for attr := range kind.secretEntries {
+		req.PayloadEntries = append(req.PayloadEntries, &lockbox.PayloadEntryChange{
+			Key:   d.Get("output_to_lockbox.0." + attr).(string),
+			Value: &lockbox.PayloadEntryChange_TextValue{TextValue: material[attr]},
+		})
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().AddVersion(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error adding version with %s %q to Lockbox secret %q: %s", kind.description, d.Id(), secretID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while getting operation metadata of add secret version: %s", err)
+	}
+	md, ok := protoMetadata.(*lockbox.AddVersionMetadata)
+	if !ok {
+		return fmt.Errorf("could not get version ID from add secret version operation metadata")
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error adding version with %s %q to Lockbox secret %q: %s", kind.description, d.Id(), secretID, err)
+	}
+
+	d.Set("output_to_lockbox_version_id", md.VersionId)
+	return nil
+}
+
+// refreshServiceAccountActiveKeys removes keys deleted outside Terraform from the active ones.
+func refreshServiceAccountActiveKeys(ctx context.Context, d *schema.ResourceData, config *Config, kind *serviceAccountKeyKind) error {
+	activeKeyIDs := expandStringSlice(d.Get("active_key_ids").([]interface{}))
+	if len(activeKeyIDs) == 0 {
+		return nil
+	}
+
+	existing := make([]string, 0, len(activeKeyIDs))
+	for _, id := range activeKeyIDs {
+		if id == d.Id() {
+			existing = append(existing, id)
+			continue
+		}
+		ok, err := kind.exists(ctx, config, id)
+		if err != nil {
+			return fmt.Errorf("error reading %s %q: %s", kind.description, id, err)
+		}
+		if ok {
+			existing = append(existing, id)
+		}
+	}
+	return d.Set("active_key_ids", existing)
+}
+
+// deleteServiceAccountKeys deletes the newest key along with the other active ones.
+func deleteServiceAccountKeys(ctx context.Context, d *schema.ResourceData, config *Config, kind *serviceAccountKeyKind) error {
+	for _, id := range expandStringSlice(d.Get("active_key_ids").([]interface{})) {
+		if id == d.Id() {
+			continue
+		}
+		if err := kind.delete(ctx, config, id); err != nil && !isStatusWithCode(err, codes.NotFound) {
+			return fmt.Errorf("error deleting %s %q: %s", kind.description, id, err)
+		}
+	}
+
+	err := kind.delete(ctx, config, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("%s %q", kind.description, d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,87 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testServiceAccountKeyRotationState(createdAt time.Time) *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "aje00000000000000key",
+		Attributes: map[string]string{
+			"id":                            "aje00000000000000key",
+			"service_account_id":            "aje0000000000000000sa",
+			"created_at":                    createdAt.Format(defaultTimeFormat),
+			"output_to_lockbox.#":           "1",
+			"output_to_lockbox.0.secret_id": "e6q00000000000secret",
+			"output_to_lockbox.0.entry_for_secret_key": "api_key",
+			"output_to_lockbox_version_id":             "e6q0000000000version",
+			"rotation.#":                               "1",
+			"rotation.0.period":                        "24h",
+			"rotation.0.overlap_count":                 "2",
+			"active_key_ids.#":                         "1",
+			"active_key_ids.0":                         "aje00000000000000key",
+		},
+	}
+}
+
+func testServiceAccountKeyRotationConfig() *terraform.ResourceConfig {
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		"service_account_id": "aje0000000000000000sa",
+		"output_to_lockbox": []interface{}{
+			map[string]interface{}{
+				"secret_id":            "e6q00000000000secret",
+				"entry_for_secret_key": "api_key",
+			},
+		},
+		"rotation": []interface{}{
+			map[string]interface{}{
+				"period": "24h",
+			},
+		},
+	})
+}
+
+func TestServiceAccountKeyRotationDiff(t *testing.T) {
+	r := resourceYandexIAMServiceAccountAPIKey()
+
+	diff, err := r.Diff(context.Background(), testServiceAccountKeyRotationState(time.Now().Add(-2*time.Hour)), testServiceAccountKeyRotationConfig(), &Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected no rotation for a fresh key, got diff: %#v", diff.Attributes)
+	}
+
+	diff, err = r.Diff(context.Background(), testServiceAccountKeyRotationState(time.Now().Add(-25*time.Hour)), testServiceAccountKeyRotationConfig(), &Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff.RequiresNew() {
+		t.Errorf("expected rotation to update the resource in place")
+	}
+	for _, attr := range []string{"created_at", "output_to_lockbox_version_id", "active_key_ids.#"} {
+		if d, ok := diff.Attributes[attr]; !ok || !d.NewComputed {
+			t.Errorf("expected %q to be recomputed on rotation, got: %#v", attr, d)
+		}
+	}
+}
+
+func TestValidateServiceAccountKeyRotationPeriod(t *testing.T) {
+	cases := map[string]bool{
+		"720h": true,
+		"1h":   true,
+		"30m":  false,
+		"week": false,
+	}
+
+	for period, valid := range cases {
+		_, es := validateServiceAccountKeyRotationPeriod(period, "period")
+		if (len(es) == 0) != valid {
+			t.Errorf("validateServiceAccountKeyRotationPeriod(%q) returned %v, expected valid: %v", period, es, valid)
+		}
+	}
+}
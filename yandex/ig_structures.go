@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -1272,6 +1273,72 @@ func flattenInstanceGroupManagedInstances(instances []*instancegroup.ManagedInst
 	return res, nil
 }
 
+type instanceGroupRolloutPolicy struct {
+	waitForHealthy    bool
+	pauseOnFailure    bool
+	rollbackOnFailure bool
+}
+
+func expandInstanceGroupRolloutPolicy(d *schema.ResourceData) instanceGroupRolloutPolicy {
+	return instanceGroupRolloutPolicy{
+		waitForHealthy:    d.Get("rollout_policy.0.wait_for_healthy").(bool),
+		pauseOnFailure:    d.Get("rollout_policy.0.pause_on_failure").(bool),
+		rollbackOnFailure: d.Get("rollout_policy.0.rollback_on_failure").(bool),
+	}
+}
+
+// checkInstanceGroupRollout reports whether all instances of the group run the actual template
+// and passed health checks. An instance which has been waiting for health checks longer than
+// maxHealthWait is treated as a failed rollout.
+func checkInstanceGroupRollout(state *instancegroup.ManagedInstancesState, instances []*instancegroup.ManagedInstance,
+	maxHealthWait time.Duration, now time.Time) (bool, error) {
+	if maxHealthWait > 0 {
+		for _, instance := range instances {
+			switch instance.GetStatus() {
+			case instancegroup.ManagedInstance_AWAITING_STARTUP_DURATION, instancegroup.ManagedInstance_CHECKING_HEALTH:
+			default:
+				continue
+			}
+
+			if instance.GetStatusChangedAt() != nil && now.Sub(instance.GetStatusChangedAt().AsTime()) > maxHealthWait {
+				return false, fmt.Errorf("instance %q did not pass health checks in %s, instances status:\n%s",
+					instance.GetName(), maxHealthWait, describeInstanceGroupManagedInstances(instances))
+			}
+		}
+	}
+
+	if state == nil {
+		return false, nil
+	}
+	if state.GetRunningActualCount() != state.GetTargetSize() ||
+		state.GetRunningOutdatedCount() != 0 || state.GetProcessingCount() != 0 {
+		return false, nil
+	}
+
+	for _, instance := range instances {
+		if instance.GetStatus() != instancegroup.ManagedInstance_RUNNING_ACTUAL {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func describeInstanceGroupManagedInstances(instances []*instancegroup.ManagedInstance) string {
+	lines := make([]string, 0, len(instances))
+	for _, instance := range instances {
+		line := fmt.Sprintf("  - %s (%s, %s): %s", instance.GetName(), instance.GetInstanceId(),
+			instance.GetZoneId(), instance.GetStatus().String())
+		if instance.GetStatusMessage() != "" {
+			line += ": " + instance.GetStatusMessage()
+		}
+		lines = append(lines, line)
+	}
+	sort.Strings(lines)
+
+	return strings.Join(lines, "\n")
+}
+
 func hashInstanceGroupFilesystem(v interface{}) int {
 	var buf bytes.Buffer
 
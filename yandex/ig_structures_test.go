@@ -3,6 +3,7 @@ package yandex
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/timestamp"
@@ -1222,3 +1223,102 @@ func TestFlattenInstanceGroupPlacementPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckInstanceGroupRollout(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	changedAt := func(ago time.Duration) *timestamp.Timestamp {
+		return &timestamp.Timestamp{Seconds: now.Add(-ago).Unix()}
+	}
+
+	tests := []struct {
+		name          string
+		state         *instancegroup.ManagedInstancesState
+		instances     []*instancegroup.ManagedInstance
+		maxHealthWait time.Duration
+		done          bool
+		wantErr       bool
+	}{
+		{
+			name:  "all instances running actual",
+			state: &instancegroup.ManagedInstancesState{TargetSize: 2, RunningActualCount: 2},
+			instances: []*instancegroup.ManagedInstance{
+				{Name: "a", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+				{Name: "b", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+			},
+			maxHealthWait: time.Minute,
+			done:          true,
+		},
+		{
+			name:  "outdated instance left",
+			state: &instancegroup.ManagedInstancesState{TargetSize: 2, RunningActualCount: 1, RunningOutdatedCount: 1},
+			instances: []*instancegroup.ManagedInstance{
+				{Name: "a", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+				{Name: "b", Status: instancegroup.ManagedInstance_RUNNING_OUTDATED},
+			},
+			maxHealthWait: time.Minute,
+		},
+		{
+			name:  "instance is checking health in time",
+			state: &instancegroup.ManagedInstancesState{TargetSize: 2, RunningActualCount: 1, ProcessingCount: 1},
+			instances: []*instancegroup.ManagedInstance{
+				{Name: "a", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+				{Name: "b", Status: instancegroup.ManagedInstance_CHECKING_HEALTH, StatusChangedAt: changedAt(30 * time.Second)},
+			},
+			maxHealthWait: time.Minute,
+		},
+		{
+			name:  "instance is stuck in health checks",
+			state: &instancegroup.ManagedInstancesState{TargetSize: 2, RunningActualCount: 1, ProcessingCount: 1},
+			instances: []*instancegroup.ManagedInstance{
+				{Name: "a", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+				{Name: "b", Status: instancegroup.ManagedInstance_CHECKING_HEALTH, StatusChangedAt: changedAt(2 * time.Minute)},
+			},
+			maxHealthWait: time.Minute,
+			wantErr:       true,
+		},
+		{
+			name:  "no health wait limit",
+			state: &instancegroup.ManagedInstancesState{TargetSize: 1, ProcessingCount: 1},
+			instances: []*instancegroup.ManagedInstance{
+				{Name: "a", Status: instancegroup.ManagedInstance_CHECKING_HEALTH, StatusChangedAt: changedAt(time.Hour)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			done, err := checkInstanceGroupRollout(tt.state, tt.instances, tt.maxHealthWait, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("checkInstanceGroupRollout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if done != tt.done {
+				t.Errorf("checkInstanceGroupRollout() got = %v, want %v", done, tt.done)
+			}
+		})
+	}
+}
+
+func TestDescribeInstanceGroupManagedInstances(t *testing.T) {
+	instances := []*instancegroup.ManagedInstance{
+		{
+			Name:          "b",
+			InstanceId:    "id-b",
+			ZoneId:        "ru-central1-b",
+			Status:        instancegroup.ManagedInstance_CHECKING_HEALTH,
+			StatusMessage: "health check failed",
+		},
+		{
+			Name:       "a",
+			InstanceId: "id-a",
+			ZoneId:     "ru-central1-a",
+			Status:     instancegroup.ManagedInstance_RUNNING_ACTUAL,
+		},
+	}
+
+	expected := "  - a (id-a, ru-central1-a): RUNNING_ACTUAL\n" +
+		"  - b (id-b, ru-central1-b): CHECKING_HEALTH: health check failed"
+
+	if res := describeInstanceGroupManagedInstances(instances); res != expected {
+		t.Errorf("describeInstanceGroupManagedInstances() got = %q, want %q", res, expected)
+	}
+}
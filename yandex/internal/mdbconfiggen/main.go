@@ -0,0 +1,555 @@
+// Command mdbconfiggen generates Go code for the MDB config messages from their proto descriptors.
+//
+// For the messages of the settings blocks whose type depends on the version of the cluster
+// (postgresql_config, mysql_config, etc.) it emits, for every field, the Terraform key, value type,
+// Go field accessor and the field documentation taken from the generated Go sources. The attributes
+// of those blocks are built from them at run time, see generateBlockSchema.
+//
+// For the messages the provider exposes as blocks (clickhouse config merge_tree) it emits the
+// schema of the block, with the descriptions and the validation of the fields, its expand and
+// flatten functions and the list of its keys for the update masks.
+//
+// Run it through go generate from the yandex package directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud"
+	clickhouseconfig "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1/config"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	mysqlconfig "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1/config"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	postgresqlconfig "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1/config"
+	sqlserverconfig "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1/config"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// messages are the config messages registered with objectFieldsInfo.addType.
+var messages = []proto.Message{
+	&postgresql.UserSettings{},
+	&postgresqlconfig.PostgresqlConfig16{},
+	&postgresqlconfig.PostgresqlConfig15{},
+	&postgresqlconfig.PostgresqlConfig15_1C{},
+	&postgresqlconfig.PostgresqlConfig14{},
+	&postgresqlconfig.PostgresqlConfig14_1C{},
+	&postgresqlconfig.PostgresqlConfig13{},
+	&postgresqlconfig.PostgresqlConfig13_1C{},
+	&postgresqlconfig.PostgresqlConfig12{},
+	&postgresqlconfig.PostgresqlConfig12_1C{},
+	&postgresqlconfig.PostgresqlConfig11{},
+	&postgresqlconfig.PostgresqlConfig11_1C{},
+	&postgresqlconfig.PostgresqlConfig10{},
+	&postgresqlconfig.PostgresqlConfig10_1C{},
+	&mysqlconfig.MysqlConfig8_0{},
+	&mysqlconfig.MysqlConfig5_7{},
+	&greenplum.GreenplumConfig6_22{},
+	&greenplum.GreenplumConfig6{},
+	&sqlserverconfig.SQLServerConfig2016Sp2Std{},
+	&sqlserverconfig.SQLServerConfig2016Sp2Ent{},
+}
+
+// blocks are the config messages exposed as blocks, by the name of the generated functions.
+// The unvalidated keys are the attributes the block had before it was generated, they get no
+// validation so that the configurations accepted before are not rejected now.
+var blocks = []struct {
+	name        string
+	message     proto.Message
+	unvalidated []string
+}{
+	{"ClickhouseMergeTreeConfig", &clickhouseconfig.ClickhouseConfig_MergeTree{}, []string{
+		"replicated_deduplication_window",
+		"replicated_deduplication_window_seconds",
+		"parts_to_delay_insert",
+		"parts_to_throw_insert",
+		"max_replicated_merges_in_queue",
+		"number_of_free_entries_in_pool_to_lower_max_size_of_merge",
+		"max_bytes_to_merge_at_min_space_in_pool",
+		"min_bytes_for_wide_part",
+		"min_rows_for_wide_part",
+		"ttl_only_drop_parts",
+		"merge_with_ttl_timeout",
+		"merge_with_recompression_ttl_timeout",
+		"max_parts_in_total",
+		"max_number_of_merges_with_ttl_in_pool",
+		"cleanup_delay_period",
+		"max_avg_part_size_for_too_many_parts",
+		"min_age_to_force_merge_seconds",
+		"min_age_to_force_merge_on_partition_only",
+		"merge_selecting_sleep_ms",
+	}},
+}
+
+// wrapperAccessors are the accessor constructors of the wrapper fields, see yandex/dynamic_accessors.go.
+var wrapperAccessors = map[protoreflect.FullName]string{
+	"google.protobuf.Int64Value":  "int64ValueAccessor",
+	"google.protobuf.BoolValue":   "boolValueAccessor",
+	"google.protobuf.DoubleValue": "doubleValueAccessor",
+}
+
+type field struct {
+	key         string
+	goName      string
+	goType      string
+	accessor    string
+	description string
+	// validation is the value of the yandex.cloud.value option of the field
+	validation string
+}
+
+type message struct {
+	goName string
+	fields []field
+}
+
+type block struct {
+	name        string
+	unvalidated map[string]bool
+	message
+}
+
+type generator struct {
+	imports  map[string]string
+	messages []message
+	blocks   []block
+	comments map[string]map[string]map[string]string
+}
+
+func main() {
+	output := flag.String("output", "dynamic_accessors_gen.go", "file to write the accessors to")
+	blocksOutput := flag.String("blocks-output", "mdb_config_blocks_gen.go", "file to write the blocks to")
+	flag.Parse()
+
+	accessors := &generator{
+		imports:  map[string]string{},
+		comments: map[string]map[string]map[string]string{},
+	}
+	for _, m := range messages {
+		msg, err := accessors.message(m)
+		if err != nil {
+			log.Fatal(err)
+		}
+		accessors.messages = append(accessors.messages, msg)
+	}
+	write(*output, accessors.source)
+
+	blocksGen := &generator{
+		imports:  map[string]string{},
+		comments: accessors.comments,
+	}
+	for _, b := range blocks {
+		msg, err := blocksGen.message(b.message)
+		if err != nil {
+			log.Fatal(err)
+		}
+		unvalidated := map[string]bool{}
+		for _, key := range b.unvalidated {
+			unvalidated[key] = true
+		}
+		blocksGen.blocks = append(blocksGen.blocks, block{name: b.name, unvalidated: unvalidated, message: msg})
+	}
+	write(*blocksOutput, blocksGen.blocksSource)
+}
+
+func write(output string, source func() ([]byte, error)) {
+	src, err := source()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func (g *generator) message(m proto.Message) (message, error) {
+	t := reflect.TypeOf(m).Elem()
+	pkg := g.importAlias(t.PkgPath())
+	comments, err := g.packageComments(t.PkgPath())
+	if err != nil {
+		return message{}, err
+	}
+
+	md := m.ProtoReflect().Descriptor()
+	msg := message{goName: pkg + "." + t.Name()}
+
+	fds := md.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if fd.IsList() || fd.IsMap() || fd.ContainingOneof() != nil {
+			continue
+		}
+
+		f := field{
+			key:         string(fd.Name()),
+			goName:      goCamelCase(string(fd.Name())),
+			description: comments[t.Name()][goCamelCase(string(fd.Name()))],
+		}
+		if proto.HasExtension(fd.Options(), cloud.E_Value) {
+			f.validation = proto.GetExtension(fd.Options(), cloud.E_Value).(string)
+		}
+		switch fd.Kind() {
+		case protoreflect.MessageKind:
+			accessor, ok := wrapperAccessors[fd.Message().FullName()]
+			if !ok {
+				continue
+			}
+			f.accessor = accessor
+		case protoreflect.EnumKind:
+			f.accessor = "intAccessor"
+			f.goType = pkg + "." + goEnumName(fd.Enum())
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+			f.accessor = "intAccessor"
+			f.goType = "int32"
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			f.accessor = "intAccessor"
+			f.goType = "int64"
+		case protoreflect.FloatKind:
+			f.accessor = "floatAccessor"
+			f.goType = "float32"
+		case protoreflect.DoubleKind:
+			f.accessor = "floatAccessor"
+			f.goType = "float64"
+		case protoreflect.BoolKind:
+			f.accessor = "boolAccessor"
+		case protoreflect.StringKind:
+			f.accessor = "stringAccessor"
+		default:
+			continue
+		}
+		msg.fields = append(msg.fields, f)
+	}
+
+	return msg, nil
+}
+
+// importAlias returns the name the generated file imports the package under.
+// Config subpackages get the engine prefix, so that they don't clash with each other.
+func (g *generator) importAlias(path string) string {
+	if alias, ok := g.imports[path]; ok {
+		return alias
+	}
+
+	parts := strings.Split(path, "/")
+	alias := parts[len(parts)-2]
+	if parts[len(parts)-1] == "config" {
+		alias = parts[len(parts)-3] + "config"
+	}
+	g.imports[path] = alias
+	return alias
+}
+
+// packageComments returns the documentation of the struct fields of the Go package, by struct and field name.
+func (g *generator) packageComments(path string) (map[string]map[string]string, error) {
+	if comments, ok := g.comments[path]; ok {
+		return comments, nil
+	}
+
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", path).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the sources of %s: %s", path, err)
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, strings.TrimSpace(string(out)), func(fi os.FileInfo) bool {
+		return strings.HasSuffix(fi.Name(), ".pb.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the sources of %s: %s", path, err)
+	}
+
+	comments := map[string]map[string]string{}
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				ts, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+
+				fields := map[string]string{}
+				for _, f := range st.Fields.List {
+					doc := f.Doc
+					if doc == nil {
+						doc = f.Comment
+					}
+					if doc == nil {
+						continue
+					}
+					for _, name := range f.Names {
+						fields[name.Name] = strings.Join(strings.Fields(doc.Text()), " ")
+					}
+				}
+				comments[ts.Name.Name] = fields
+				return false
+			})
+		}
+	}
+
+	g.comments[path] = comments
+	return comments, nil
+}
+
+func (g *generator) source() ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by mdbconfiggen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package yandex\n\n")
+
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	fmt.Fprintf(&b, "import (\n\"reflect\"\n\n")
+	for _, path := range paths {
+		fmt.Fprintf(&b, "%s %q\n", g.imports[path], path)
+	}
+	fmt.Fprintf(&b, "\"google.golang.org/protobuf/types/known/wrapperspb\"\n)\n\n")
+
+	fmt.Fprintf(&b, "// generatedFieldsInfo holds the fields of the MDB config messages, by message type.\n")
+	fmt.Fprintf(&b, "var generatedFieldsInfo = map[reflect.Type]map[string]fieldReflectInfo{\n")
+	for _, m := range g.messages {
+		fmt.Fprintf(&b, "reflect.TypeOf((*%s)(nil)).Elem(): {\n", m.goName)
+		for _, f := range m.fields {
+			fmt.Fprintf(&b, "%q: %s(%q, %q,\n", f.key, f.accessor, f.goName, f.description)
+			fmt.Fprintf(&b, "func(m *%s) *%s { return &m.%s }),\n", m.goName, f.fieldType(), f.goName)
+		}
+		fmt.Fprintf(&b, "},\n")
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	return format.Source(b.Bytes())
+}
+
+func (g *generator) blocksSource() ([]byte, error) {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "// Code generated by mdbconfiggen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package yandex\n\n")
+
+	paths := make([]string, 0, len(g.imports))
+	for path := range g.imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	fmt.Fprintf(&b, "import (\n")
+	for _, path := range paths {
+		fmt.Fprintf(&b, "%s %q\n", g.imports[path], path)
+	}
+	fmt.Fprintf(&b, "\"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema\"\n")
+	if g.validated() {
+		fmt.Fprintf(&b, "\"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation\"\n")
+	}
+	fmt.Fprintf(&b, "\"google.golang.org/protobuf/types/known/wrapperspb\"\n)\n\n")
+
+	for _, bl := range g.blocks {
+		name := strings.ToLower(bl.name[:1]) + bl.name[1:]
+
+		fmt.Fprintf(&b, "// %sSchema returns the schema of the %s block.\n", name, bl.goName)
+		fmt.Fprintf(&b, "func %sSchema() map[string]*schema.Schema {\n", name)
+		fmt.Fprintf(&b, "return map[string]*schema.Schema{\n")
+		for _, f := range bl.fields {
+			valueType, _, err := f.blockType()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", bl.goName, err)
+			}
+			fmt.Fprintf(&b, "%q: {Type: %s, Optional: true, Computed: true", f.key, valueType)
+			if f.description != "" {
+				fmt.Fprintf(&b, ", Description: %q", f.description)
+			}
+			if f.validation != "" && !bl.unvalidated[f.key] {
+				validateFunc, err := validateFunc(valueType, f.validation)
+				if err != nil {
+					return nil, fmt.Errorf("%s.%s: %s", bl.goName, f.key, err)
+				}
+				fmt.Fprintf(&b, ", ValidateFunc: %s", validateFunc)
+			}
+			fmt.Fprintf(&b, "},\n")
+		}
+		fmt.Fprintf(&b, "}\n}\n\n")
+
+		fmt.Fprintf(&b, "// %sFields are the keys of the %s block.\n", name, bl.goName)
+		fmt.Fprintf(&b, "var %sFields = []string{\n", name)
+		for _, f := range bl.fields {
+			fmt.Fprintf(&b, "%q,\n", f.key)
+		}
+		fmt.Fprintf(&b, "}\n\n")
+
+		fmt.Fprintf(&b, "func expand%s(d *schema.ResourceData, rootKey string) (*%s, error) {\n", bl.name, bl.goName)
+		fmt.Fprintf(&b, "config := &%s{}\n", bl.goName)
+		for _, f := range bl.fields {
+			_, expand, _ := f.blockType()
+			fmt.Fprintf(&b, "if v, ok := d.GetOkExists(rootKey + %q); ok {\n", "."+f.key)
+			fmt.Fprintf(&b, "config.%s = %s\n}\n", f.goName, expand)
+		}
+		fmt.Fprintf(&b, "return config, nil\n}\n\n")
+
+		fmt.Fprintf(&b, "func flatten%s(c *%s) ([]map[string]interface{}, error) {\n", bl.name, bl.goName)
+		fmt.Fprintf(&b, "res := map[string]interface{}{}\n")
+		for _, f := range bl.fields {
+			fmt.Fprintf(&b, "if v := c.Get%s(); v != nil {\n", f.goName)
+			fmt.Fprintf(&b, "res[%q] = v.GetValue()\n}\n", f.key)
+		}
+		fmt.Fprintf(&b, "return []map[string]interface{}{res}, nil\n}\n\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// validated reports whether any field of the blocks is validated.
+func (g *generator) validated() bool {
+	for _, bl := range g.blocks {
+		for _, f := range bl.fields {
+			if f.validation != "" && !bl.unvalidated[f.key] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// blockType returns the Terraform type of the block field and the expression expanding its value v.
+func (f field) blockType() (valueType string, expand string, err error) {
+	switch f.accessor {
+	case "int64ValueAccessor":
+		return "schema.TypeInt", "wrapperspb.Int64(int64(v.(int)))", nil
+	case "boolValueAccessor":
+		return "schema.TypeBool", "wrapperspb.Bool(v.(bool))", nil
+	case "doubleValueAccessor":
+		return "schema.TypeFloat", "wrapperspb.Double(v.(float64))", nil
+	}
+	return "", "", fmt.Errorf("field %s of type %s is not supported in blocks", f.key, f.fieldType())
+}
+
+// validateFunc returns the validation of the yandex.cloud.value option, one of ">=min", ">min", "<=max", "<max" or "min-max".
+func validateFunc(valueType, spec string) (string, error) {
+	fn, parse := "Int", func(s string) (string, error) {
+		_, err := strconv.ParseInt(s, 10, 64)
+		return s, err
+	}
+	if valueType == "schema.TypeFloat" {
+		fn, parse = "Float", func(s string) (string, error) {
+			_, err := strconv.ParseFloat(s, 64)
+			return s, err
+		}
+	}
+
+	bound := func(op, s string) (string, error) {
+		v, err := parse(s)
+		if err != nil {
+			return "", fmt.Errorf("invalid value option %q: %s", spec, err)
+		}
+		if fn == "Int" && (op == ">" || op == "<") {
+			// strict bounds of integers are the inclusive bounds of the next integer
+			i, _ := strconv.ParseInt(v, 10, 64)
+			if op == ">" {
+				i++
+			} else {
+				i--
+			}
+			v = strconv.FormatInt(i, 10)
+		} else if op == ">" || op == "<" {
+			return "", fmt.Errorf("strict bounds of float values are not supported: %q", spec)
+		}
+		if op[0] == '>' {
+			return fmt.Sprintf("validation.%sAtLeast(%s)", fn, v), nil
+		}
+		return fmt.Sprintf("validation.%sAtMost(%s)", fn, v), nil
+	}
+
+	for _, op := range []string{">=", "<=", ">", "<"} {
+		if strings.HasPrefix(spec, op) {
+			return bound(op, strings.TrimPrefix(spec, op))
+		}
+	}
+
+	// the bounds of the range may be negative, so the separator is looked up after the first character
+	i := strings.Index(spec[1:], "-")
+	if i < 0 {
+		return "", fmt.Errorf("unsupported value option %q", spec)
+	}
+	min, err := parse(spec[:i+1])
+	if err != nil {
+		return "", fmt.Errorf("invalid value option %q: %s", spec, err)
+	}
+	max, err := parse(spec[i+2:])
+	if err != nil {
+		return "", fmt.Errorf("invalid value option %q: %s", spec, err)
+	}
+	return fmt.Sprintf("validation.%sBetween(%s, %s)", fn, min, max), nil
+}
+
+// fieldType returns the Go type of the struct field.
+func (f field) fieldType() string {
+	switch f.accessor {
+	case "int64ValueAccessor":
+		return "*wrapperspb.Int64Value"
+	case "boolValueAccessor":
+		return "*wrapperspb.BoolValue"
+	case "doubleValueAccessor":
+		return "*wrapperspb.DoubleValue"
+	case "boolAccessor":
+		return "bool"
+	case "stringAccessor":
+		return "string"
+	}
+	return f.goType
+}
+
+// goEnumName returns the name protoc-gen-go gives to the enum type.
+func goEnumName(ed protoreflect.EnumDescriptor) string {
+	name := strings.TrimPrefix(string(ed.FullName()), string(ed.ParentFile().Package())+".")
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+// goCamelCase returns the name protoc-gen-go gives to the struct field of the proto field.
+func goCamelCase(s string) string {
+	var b []byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_' && i == 0:
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isASCIILower(s[i+1]):
+		case isASCIIDigit(c):
+			b = append(b, c)
+		default:
+			if isASCIILower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isASCIILower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,337 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// The schema registry of a Kafka cluster is served by its brokers through the Confluent-compatible REST API
+// and authenticates Kafka users with HTTP basic auth. Errors are converted to gRPC statuses, the same way
+// as the ones of the REST API in rest_api.go.
+
+const (
+	kafkaSchemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+	kafkaSchemaRegistryUsernameEnv = "YC_KAFKA_SCHEMA_REGISTRY_USERNAME"
+	kafkaSchemaRegistryPasswordEnv = "YC_KAFKA_SCHEMA_REGISTRY_PASSWORD"
+)
+
+var (
+	kafkaSchemaTypes           = []string{"AVRO", "PROTOBUF", "JSON"}
+	kafkaSchemaCompatibilities = []string{
+		"BACKWARD", "BACKWARD_TRANSITIVE",
+		"FORWARD", "FORWARD_TRANSITIVE",
+		"FULL", "FULL_TRANSITIVE",
+		"NONE",
+	}
+)
+
+type kafkaSchemaRegistryClient struct {
+	baseURL    string
+	username   string
+	password   string
+	userAgent  string
+	httpClient *http.Client
+}
+
+type kafkaSchemaRegistrySchema struct {
+	Subject    string `json:"subject,omitempty"`
+	ID         int    `json:"id,omitempty"`
+	Version    int    `json:"version,omitempty"`
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+type kafkaSchemaRegistryError struct {
+	ErrorCode int    `json:"error_code"`
+	Message   string `json:"message"`
+}
+
+func newKafkaSchemaRegistryClient(config *Config, endpoint, username, password, caCertificate string) (*kafkaSchemaRegistryClient, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.Insecure}
+	if caCertificate != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCertificate)) {
+			return nil, fmt.Errorf("error parsing schema registry CA certificate: no PEM certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if username == "" {
+		username = os.Getenv(kafkaSchemaRegistryUsernameEnv)
+	}
+	if password == "" {
+		password = os.Getenv(kafkaSchemaRegistryPasswordEnv)
+	}
+
+	return &kafkaSchemaRegistryClient{
+		baseURL:   strings.TrimSuffix(endpoint, "/"),
+		username:  username,
+		password:  password,
+		userAgent: config.userAgent,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+	}, nil
+}
+
+// kafkaSchemaRegistryEndpoint returns the schema registry URL of the cluster, which is served by any of its brokers.
+func kafkaSchemaRegistryEndpoint(ctx context.Context, config *Config, clusterID string) (string, error) {
+	hosts, err := listKafkaHosts(ctx, config, clusterID)
+	if err != nil {
+		return "", err
+	}
+	for _, host := range hosts {
+		if host.Role == kafka.Host_KAFKA {
+			return fmt.Sprintf("https://%s:443", host.Name), nil
+		}
+	}
+	return "", fmt.Errorf("Kafka Cluster %q has no broker hosts to reach the schema registry at", clusterID)
+}
+
+func (c *kafkaSchemaRegistryClient) do(ctx context.Context, method, path string, query url.Values, body, result interface{}) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error encoding request body: %s", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", kafkaSchemaRegistryContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", kafkaSchemaRegistryContentType)
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	log.Printf("[DEBUG] %s %s", method, u)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response of %s %s: %s", method, path, err)
+	}
+
+	if resp.StatusCode >= 300 {
+		var regErr kafkaSchemaRegistryError
+		if err := json.Unmarshal(data, &regErr); err == nil && regErr.Message != "" {
+			data = []byte(fmt.Sprintf("%s (error code %d)", regErr.Message, regErr.ErrorCode))
+		}
+		return restAPIStatusError(resp.StatusCode, data)
+	}
+
+	if result == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("error decoding response of %s %s: %s", method, path, err)
+	}
+	return nil
+}
+
+func kafkaSchemaRegistrySubjectPath(subject string) string {
+	return "/subjects/" + url.PathEscape(subject)
+}
+
+func kafkaSchemaRegistryConfigPath(subject string) string {
+	return "/config/" + url.PathEscape(subject)
+}
+
+// registerSchema registers the schema under the subject, unless it's registered already, and returns its version.
+func (c *kafkaSchemaRegistryClient) registerSchema(ctx context.Context, subject, schemaType, schema string) (*kafkaSchemaRegistrySchema, error) {
+	req := &kafkaSchemaRegistrySchema{Schema: schema, SchemaType: schemaType}
+	if err := c.do(ctx, http.MethodPost, kafkaSchemaRegistrySubjectPath(subject)+"/versions", nil, req, &struct{}{}); err != nil {
+		return nil, fmt.Errorf("error registering schema of subject %q: %w", subject, err)
+	}
+
+	registered := &kafkaSchemaRegistrySchema{}
+	if err := c.do(ctx, http.MethodPost, kafkaSchemaRegistrySubjectPath(subject), nil, req, registered); err != nil {
+		return nil, fmt.Errorf("error looking up registered schema of subject %q: %w", subject, err)
+	}
+	return registered, nil
+}
+
+func (c *kafkaSchemaRegistryClient) latestSchema(ctx context.Context, subject string) (*kafkaSchemaRegistrySchema, error) {
+	latest := &kafkaSchemaRegistrySchema{}
+	if err := c.do(ctx, http.MethodGet, kafkaSchemaRegistrySubjectPath(subject)+"/versions/latest", nil, nil, latest); err != nil {
+		return nil, err
+	}
+	if latest.SchemaType == "" {
+		latest.SchemaType = "AVRO"
+	}
+	return latest, nil
+}
+
+// deleteSubject deletes all the versions of the subject. Soft-deleted versions are kept by the registry
+// and may be restored by registering the same schema again, unless permanent is set.
+func (c *kafkaSchemaRegistryClient) deleteSubject(ctx context.Context, subject string, permanent bool) error {
+	if err := c.do(ctx, http.MethodDelete, kafkaSchemaRegistrySubjectPath(subject), nil, nil, nil); err != nil {
+		return err
+	}
+	if !permanent {
+		return nil
+	}
+	return c.do(ctx, http.MethodDelete, kafkaSchemaRegistrySubjectPath(subject), url.Values{"permanent": {"true"}}, nil, nil)
+}
+
+// getCompatibility returns the compatibility level set for the subject, or an empty string if the subject
+// uses the global one.
+func (c *kafkaSchemaRegistryClient) getCompatibility(ctx context.Context, subject string) (string, error) {
+	resp := &struct {
+		CompatibilityLevel string `json:"compatibilityLevel"`
+	}{}
+	err := c.do(ctx, http.MethodGet, kafkaSchemaRegistryConfigPath(subject), nil, nil, resp)
+	if isStatusWithCode(err, codes.NotFound) {
+		return "", nil
+	}
+	return resp.CompatibilityLevel, err
+}
+
+func (c *kafkaSchemaRegistryClient) setCompatibility(ctx context.Context, subject, compatibility string) error {
+	if compatibility == "" {
+		err := c.do(ctx, http.MethodDelete, kafkaSchemaRegistryConfigPath(subject), nil, nil, nil)
+		if isStatusWithCode(err, codes.NotFound) {
+			return nil
+		}
+		return err
+	}
+
+	req := &struct {
+		Compatibility string `json:"compatibility"`
+	}{Compatibility: compatibility}
+	return c.do(ctx, http.MethodPut, kafkaSchemaRegistryConfigPath(subject), nil, req, nil)
+}
+
+// kafkaSchemaFingerprint returns the SHA-256 of the schema type and the canonical form of the schema,
+// so that formatting changes of the schema don't cause diffs.
+func kafkaSchemaFingerprint(schemaType, schema string) (string, error) {
+	canonical, err := canonicalKafkaSchema(schemaType, schema)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(schemaType + "\n" + canonical))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalKafkaSchema returns the schema without insignificant whitespace and comments.
+// Avro and JSON schemas are JSON documents, their keys are sorted as well.
+func canonicalKafkaSchema(schemaType, schema string) (string, error) {
+	switch schemaType {
+	case "AVRO", "JSON":
+		return canonicalJSONSchema(schema)
+	case "PROTOBUF":
+		return canonicalProtobufSchema(schema), nil
+	}
+	return "", fmt.Errorf("unsupported schema type %q", schemaType)
+}
+
+func canonicalJSONSchema(schema string) (string, error) {
+	dec := json.NewDecoder(strings.NewReader(schema))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return "", fmt.Errorf("error parsing schema: %s", err)
+	}
+	if dec.More() {
+		return "", fmt.Errorf("error parsing schema: unexpected data after the schema")
+	}
+
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// canonicalProtobufSchema drops the comments of the schema and the whitespace that doesn't separate tokens.
+func canonicalProtobufSchema(schema string) string {
+	var b strings.Builder
+	space := false
+	emit := func(c byte) {
+		if space && b.Len() > 0 && !isProtobufPunct(c) && !isProtobufPunct(b.String()[b.Len()-1]) {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteByte(c)
+	}
+
+	for i := 0; i < len(schema); i++ {
+		c := schema[i]
+		switch {
+		case c == '/' && i+1 < len(schema) && schema[i+1] == '/':
+			for i < len(schema) && schema[i] != '\n' {
+				i++
+			}
+			space = true
+		case c == '/' && i+1 < len(schema) && schema[i+1] == '*':
+			end := strings.Index(schema[i+2:], "*/")
+			if end < 0 {
+				i = len(schema)
+			} else {
+				i += end + 3
+			}
+			space = true
+		case c == '"' || c == '\'':
+			emit(c)
+			for i++; i < len(schema); i++ {
+				b.WriteByte(schema[i])
+				if schema[i] == '\\' && i+1 < len(schema) {
+					i++
+					b.WriteByte(schema[i])
+				} else if schema[i] == c {
+					break
+				}
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			space = true
+		default:
+			emit(c)
+		}
+	}
+	return b.String()
+}
+
+func isProtobufPunct(c byte) bool {
+	return strings.IndexByte("{}[]()<>;,=.\"'", c) >= 0
+}
@@ -2,6 +2,13 @@ package yandex
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 )
@@ -33,3 +40,158 @@ func (tm *KafkaTopicManager) DeleteKafkaTopic(ctx context.Context, d *schema.Res
 func (tm *KafkaTopicManager) UpdateKafkaTopic(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec, paths []string) error {
 	return updateKafkaTopic(ctx, tm.Config, d, topicSpec, paths)
 }
+
+// kafkaTopicsBatch holds the topic changes that bring the topics of a cluster to the declared ones.
+type kafkaTopicsBatch struct {
+	deletes []string
+	creates []*kafka.TopicSpec
+	updates []kafkaTopicUpdate
+}
+
+type kafkaTopicUpdate struct {
+	topicSpec *kafka.TopicSpec
+	paths     []string
+}
+
+// buildKafkaTopicsBatch compares the topics of the cluster with the ones declared in the "topic" list of d.
+// Topics that are not declared are deleted.
+func buildKafkaTopicsBatch(d *schema.ResourceData, current []*kafka.Topic, version string) (*kafkaTopicsBatch, error) {
+	currentTopics := map[string]map[string]interface{}{}
+	for _, topic := range flattenKafkaTopics(current) {
+		currentTopics[topic["name"].(string)] = normalizeKafkaTopic(topic)
+	}
+
+	versionSuffix := "3"
+	if strings.HasPrefix(version, "2") {
+		versionSuffix = strings.Replace(version, ".", "_", -1)
+	}
+
+	batch := &kafkaTopicsBatch{}
+	declared := map[string]struct{}{}
+	for i, t := range d.Get("topic").([]interface{}) {
+		topic := t.(map[string]interface{})
+		name := topic["name"].(string)
+		if _, ok := declared[name]; ok {
+			return nil, fmt.Errorf("topic %q is declared more than once", name)
+		}
+		declared[name] = struct{}{}
+
+		topicSpec, err := buildKafkaTopicSpec(d, fmt.Sprintf("topic.%d.", i), version)
+		if err != nil {
+			return nil, err
+		}
+
+		currentTopic, ok := currentTopics[name]
+		if !ok {
+			batch.creates = append(batch.creates, topicSpec)
+			continue
+		}
+		if paths := kafkaTopicUpdateMask(currentTopic, normalizeKafkaTopic(topic), versionSuffix); len(paths) > 0 {
+			sort.Strings(paths)
+			batch.updates = append(batch.updates, kafkaTopicUpdate{topicSpec: topicSpec, paths: paths})
+		}
+	}
+
+	for _, topic := range current {
+		if _, ok := declared[topic.Name]; !ok {
+			batch.deletes = append(batch.deletes, topic.Name)
+		}
+	}
+	sort.Strings(batch.deletes)
+
+	return batch, nil
+}
+
+// normalizeKafkaTopic brings a topic from the configuration or from flattenKafkaTopics to a comparable form.
+func normalizeKafkaTopic(topic map[string]interface{}) map[string]interface{} {
+	var rawConfig map[string]interface{}
+	switch c := topic["topic_config"].(type) {
+	case []interface{}:
+		if len(c) > 0 && c[0] != nil {
+			rawConfig = c[0].(map[string]interface{})
+		}
+	case []map[string]interface{}:
+		if len(c) > 0 {
+			rawConfig = c[0]
+		}
+	}
+
+	topicConfig := map[string]interface{}{}
+	for key, value := range rawConfig {
+		if value != nil && value != "" && value != false {
+			topicConfig[key] = fmt.Sprint(value)
+		}
+	}
+
+	return map[string]interface{}{
+		"name":               topic["name"],
+		"partitions":         fmt.Sprint(topic["partitions"]),
+		"replication_factor": fmt.Sprint(topic["replication_factor"]),
+		"topic_config":       []interface{}{topicConfig},
+	}
+}
+
+func (b *kafkaTopicsBatch) empty() bool {
+	return len(b.deletes) == 0 && len(b.creates) == 0 && len(b.updates) == 0
+}
+
+// apply runs the changes through topicModifier with at most concurrency of them in flight.
+// Deletions go first, so that the created topics can use the partitions they free.
+func (b *kafkaTopicsBatch) apply(ctx context.Context, d *schema.ResourceData, topicModifier KafkaTopicModifier, concurrency int) error {
+	var deletes []func() error
+	for _, topicName := range b.deletes {
+		topicName := topicName
+		deletes = append(deletes, func() error {
+			log.Printf("[DEBUG] Deleting Kafka topic %q", topicName)
+			return topicModifier.DeleteKafkaTopic(ctx, d, topicName)
+		})
+	}
+	if err := runKafkaTopicChanges(deletes, concurrency); err != nil {
+		return err
+	}
+
+	var changes []func() error
+	for _, topicSpec := range b.creates {
+		topicSpec := topicSpec
+		changes = append(changes, func() error {
+			log.Printf("[DEBUG] Creating Kafka topic %+v", topicSpec)
+			return topicModifier.CreateKafkaTopic(ctx, d, topicSpec)
+		})
+	}
+	for _, update := range b.updates {
+		update := update
+		changes = append(changes, func() error {
+			log.Printf("[DEBUG] Updating Kafka topic %q: %v", update.topicSpec.Name, update.paths)
+			return topicModifier.UpdateKafkaTopic(ctx, d, update.topicSpec, update.paths)
+		})
+	}
+	return runKafkaTopicChanges(changes, concurrency)
+}
+
+// runKafkaTopicChanges runs all the changes, at most concurrency at a time, and returns all their errors.
+func runKafkaTopicChanges(changes []func() error, concurrency int) error {
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		result error
+	)
+	sem := make(chan struct{}, concurrency)
+	for _, change := range changes {
+		change := change
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			if err := change(); err != nil {
+				mu.Lock()
+				result = multierror.Append(result, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return result
+}
@@ -0,0 +1,311 @@
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+// Password generation is not available in the pinned go-genproto version, secrets with
+// password_payload_specification are created, updated and read through the REST API.
+
+const yandexLockboxSecretsPath = "/lockbox/v1/secrets"
+
+type lockboxPasswordPayloadSpecification struct {
+	PasswordKey         string      `json:"passwordKey"`
+	Length              json.Number `json:"length,omitempty"`
+	IncludeUppercase    *bool       `json:"includeUppercase,omitempty"`
+	IncludeLowercase    *bool       `json:"includeLowercase,omitempty"`
+	IncludeDigits       *bool       `json:"includeDigits,omitempty"`
+	IncludePunctuation  *bool       `json:"includePunctuation,omitempty"`
+	IncludedPunctuation string      `json:"includedPunctuation,omitempty"`
+	ExcludedPunctuation string      `json:"excludedPunctuation,omitempty"`
+}
+
+type lockboxSecretWithPasswordPayload struct {
+	ID                           string                               `json:"id"`
+	PasswordPayloadSpecification *lockboxPasswordPayloadSpecification `json:"passwordPayloadSpecification"`
+}
+
+type lockboxCreateSecretWithPasswordPayloadRequest struct {
+	FolderID                     string                               `json:"folderId"`
+	Name                         string                               `json:"name,omitempty"`
+	Description                  string                               `json:"description,omitempty"`
+	Labels                       map[string]string                    `json:"labels,omitempty"`
+	KmsKeyID                     string                               `json:"kmsKeyId,omitempty"`
+	DeletionProtection           bool                                 `json:"deletionProtection,omitempty"`
+	PasswordPayloadSpecification *lockboxPasswordPayloadSpecification `json:"passwordPayloadSpecification"`
+}
+
+type lockboxUpdatePasswordPayloadSpecificationRequest struct {
+	UpdateMask                   string                               `json:"updateMask"`
+	PasswordPayloadSpecification *lockboxPasswordPayloadSpecification `json:"passwordPayloadSpecification"`
+}
+
+// lockboxPasswordPayloadSpecificationSchema is shared by the secret, where the specification can be updated,
+// and the version resources, where it can not.
+func lockboxPasswordPayloadSpecificationSchema(forceNew bool) *schema.Schema {
+	s := &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Generates the secret versions server-side, so that their value never gets to Terraform.",
+		Optional:    true,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"password_key": {
+					Type:         schema.TypeString,
+					Description:  "Key of the entry to store the generated password in.",
+					Required:     true,
+					ValidateFunc: validation.All(validation.StringMatch(regexp.MustCompile(`^([-_./\\@0-9a-zA-Z]+)$`), ""), validation.StringLenBetween(0, 256)),
+				},
+				"length": {
+					Type:         schema.TypeInt,
+					Description:  "Length of the password. A reasonable length is chosen if not set.",
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"include_uppercase": {
+					Type:        schema.TypeBool,
+					Description: "Whether the password contains at least one A..Z character.",
+					Optional:    true,
+					Default:     true,
+				},
+				"include_lowercase": {
+					Type:        schema.TypeBool,
+					Description: "Whether the password contains at least one a..z character.",
+					Optional:    true,
+					Default:     true,
+				},
+				"include_digits": {
+					Type:        schema.TypeBool,
+					Description: "Whether the password contains at least one 0..9 character.",
+					Optional:    true,
+					Default:     true,
+				},
+				"include_punctuation": {
+					Type:        schema.TypeBool,
+					Description: "Whether the password contains at least one punctuation character.",
+					Optional:    true,
+					Default:     true,
+				},
+				"included_punctuation": {
+					Type:          schema.TypeString,
+					Description:   "Punctuation characters to use instead of the default ones.",
+					Optional:      true,
+					ConflictsWith: []string{"password_payload_specification.0.excluded_punctuation"},
+				},
+				"excluded_punctuation": {
+					Type:        schema.TypeString,
+					Description: "Punctuation characters to exclude from the default ones.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+
+	for _, field := range s.Elem.(*schema.Resource).Schema {
+		field.ForceNew = forceNew
+	}
+	return s
+}
+
+func dataSourceLockboxPasswordPayloadSpecificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"password_key":         {Type: schema.TypeString, Computed: true},
+				"length":               {Type: schema.TypeInt, Computed: true},
+				"include_uppercase":    {Type: schema.TypeBool, Computed: true},
+				"include_lowercase":    {Type: schema.TypeBool, Computed: true},
+				"include_digits":       {Type: schema.TypeBool, Computed: true},
+				"include_punctuation":  {Type: schema.TypeBool, Computed: true},
+				"included_punctuation": {Type: schema.TypeString, Computed: true},
+				"excluded_punctuation": {Type: schema.TypeString, Computed: true},
+			},
+		},
+	}
+}
+
+func expandLockboxPasswordPayloadSpecification(v interface{}) *lockboxPasswordPayloadSpecification {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	m := list[0].(map[string]interface{})
+
+	boolPtr := func(key string) *bool {
+		b := m[key].(bool)
+		return &b
+	}
+
+	spec := &lockboxPasswordPayloadSpecification{
+		PasswordKey:         m["password_key"].(string),
+		IncludeUppercase:    boolPtr("include_uppercase"),
+		IncludeLowercase:    boolPtr("include_lowercase"),
+		IncludeDigits:       boolPtr("include_digits"),
+		IncludePunctuation:  boolPtr("include_punctuation"),
+		IncludedPunctuation: m["included_punctuation"].(string),
+		ExcludedPunctuation: m["excluded_punctuation"].(string),
+	}
+	if length := m["length"].(int); length > 0 {
+		spec.Length = json.Number(strconv.Itoa(length))
+	}
+	return spec
+}
+
+func flattenLockboxPasswordPayloadSpecification(spec *lockboxPasswordPayloadSpecification) ([]map[string]interface{}, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	// wrapped booleans are true by default
+	boolValue := func(b *bool) bool {
+		return b == nil || *b
+	}
+
+	var length int64
+	if spec.Length != "" {
+		var err error
+		if length, err = spec.Length.Int64(); err != nil {
+			return nil, fmt.Errorf("invalid password length %q: %s", spec.Length, err)
+		}
+	}
+
+	return []map[string]interface{}{{
+		"password_key":         spec.PasswordKey,
+		"length":               int(length),
+		"include_uppercase":    boolValue(spec.IncludeUppercase),
+		"include_lowercase":    boolValue(spec.IncludeLowercase),
+		"include_digits":       boolValue(spec.IncludeDigits),
+		"include_punctuation":  boolValue(spec.IncludePunctuation),
+		"included_punctuation": spec.IncludedPunctuation,
+		"excluded_punctuation": spec.ExcludedPunctuation,
+	}}, nil
+}
+
+// createLockboxSecretWithPasswordPayload creates the secret with its first version generated server-side.
+func createLockboxSecretWithPasswordPayload(ctx context.Context, config *Config, req *lockbox.CreateSecretRequest, spec *lockboxPasswordPayloadSpecification) (string, error) {
+	restReq := &lockboxCreateSecretWithPasswordPayloadRequest{
+		FolderID:                     req.FolderId,
+		Name:                         req.Name,
+		Description:                  req.Description,
+		Labels:                       req.Labels,
+		KmsKeyID:                     req.KmsKeyId,
+		DeletionProtection:           req.DeletionProtection,
+		PasswordPayloadSpecification: spec,
+	}
+
+	op, err := doRestAPIOperation(ctx, config, ycsdk.LockboxSecretServiceID, http.MethodPost, yandexLockboxSecretsPath, restReq)
+	id := ""
+	if op != nil {
+		id = op.metadataString("secretId")
+	}
+	if err != nil {
+		return id, err
+	}
+	if id == "" {
+		return "", fmt.Errorf("could not get Secret ID from create operation metadata")
+	}
+	return id, nil
+}
+
+func updateLockboxPasswordPayloadSpecification(ctx context.Context, config *Config, secretID string, spec *lockboxPasswordPayloadSpecification) error {
+	req := &lockboxUpdatePasswordPayloadSpecificationRequest{
+		UpdateMask:                   "passwordPayloadSpecification",
+		PasswordPayloadSpecification: spec,
+	}
+	_, err := doRestAPIOperation(ctx, config, ycsdk.LockboxSecretServiceID, http.MethodPatch, yandexLockboxSecretsPath+"/"+url.PathEscape(secretID), req)
+	return err
+}
+
+func getLockboxPasswordPayloadSpecification(ctx context.Context, config *Config, secretID string) (*lockboxPasswordPayloadSpecification, error) {
+	secret := &lockboxSecretWithPasswordPayload{}
+	err := doRestAPIRequest(ctx, config, ycsdk.LockboxSecretServiceID, http.MethodGet, yandexLockboxSecretsPath+"/"+url.PathEscape(secretID), nil, nil, secret)
+	if err != nil {
+		return nil, err
+	}
+	return secret.PasswordPayloadSpecification, nil
+}
+
+// addLockboxGeneratedSecretVersion adds a version with no payload, so that Lockbox generates it according to the
+// specification of the secret. The specification belongs to yandex_lockbox_secret, so the version fails if it
+// doesn't match the given one instead of changing it.
+func addLockboxGeneratedSecretVersion(ctx context.Context, config *Config, secretID, description string, spec *lockboxPasswordPayloadSpecification) (string, error) {
+	current, err := getLockboxPasswordPayloadSpecification(ctx, config, secretID)
+	if err != nil {
+		return "", fmt.Errorf("could not get password payload specification of secret %v: %s", secretID, err)
+	}
+
+	if !lockboxPasswordPayloadSpecificationsEqual(current, spec) {
+		return "", fmt.Errorf("password_payload_specification of the version differs from the one of secret %v, "+
+			"change password_payload_specification of the yandex_lockbox_secret resource instead", secretID)
+	}
+
+	req := &lockbox.AddVersionRequest{
+		SecretId:    secretID,
+		Description: description,
+	}
+
+	log.Printf("[INFO] adding generated Lockbox version for secret with ID: %s", secretID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().AddVersion(ctx, req))
+	if err != nil {
+		return "", fmt.Errorf("error while requesting API to add version: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return "", fmt.Errorf("error while getting operation metadata of add secret version: %s", err)
+	}
+
+	md, ok := protoMetadata.(*lockbox.AddVersionMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get Version ID from add version operation metadata")
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return md.VersionId, fmt.Errorf("error while waiting operation to add secret version: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return md.VersionId, fmt.Errorf("add secret version failed: %s", err)
+	}
+
+	return md.VersionId, nil
+}
+
+// lockboxPasswordPayloadSpecificationsEqual compares the specifications with the defaults filled in.
+// The server chooses the length if it is not set, so any length matches the unset one.
+func lockboxPasswordPayloadSpecificationsEqual(current, desired *lockboxPasswordPayloadSpecification) bool {
+	c, err := flattenLockboxPasswordPayloadSpecification(current)
+	if err != nil {
+		return false
+	}
+	d, err := flattenLockboxPasswordPayloadSpecification(desired)
+	if err != nil {
+		return false
+	}
+	if len(c) == 0 || len(d) == 0 {
+		return len(c) == len(d)
+	}
+	if desired.Length == "" {
+		delete(c[0], "length")
+		delete(d[0], "length")
+	}
+	return reflect.DeepEqual(c, d)
+}
@@ -0,0 +1,78 @@
+package yandex
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandLockboxPasswordPayloadSpecification(t *testing.T) {
+	raw := []interface{}{map[string]interface{}{
+		"password_key":         "password",
+		"length":               24,
+		"include_uppercase":    true,
+		"include_lowercase":    true,
+		"include_digits":       false,
+		"include_punctuation":  true,
+		"included_punctuation": "",
+		"excluded_punctuation": "'\"",
+	}}
+
+	spec := expandLockboxPasswordPayloadSpecification(raw)
+	require.NotNil(t, spec)
+
+	body, err := json.Marshal(spec)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"passwordKey": "password",
+		"length": 24,
+		"includeUppercase": true,
+		"includeLowercase": true,
+		"includeDigits": false,
+		"includePunctuation": true,
+		"excludedPunctuation": "'\""
+	}`, string(body))
+
+	flat, err := flattenLockboxPasswordPayloadSpecification(spec)
+	require.NoError(t, err)
+	assert.Equal(t, raw[0], flat[0])
+
+	assert.Nil(t, expandLockboxPasswordPayloadSpecification([]interface{}{}))
+}
+
+func TestFlattenLockboxPasswordPayloadSpecification(t *testing.T) {
+	// int64 values are strings in the proto JSON mapping, and wrapped booleans are omitted when true
+	spec := &lockboxPasswordPayloadSpecification{}
+	require.NoError(t, json.Unmarshal([]byte(`{"passwordKey": "password", "length": "20", "includeDigits": false}`), spec))
+
+	flat, err := flattenLockboxPasswordPayloadSpecification(spec)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{
+		"password_key":         "password",
+		"length":               20,
+		"include_uppercase":    true,
+		"include_lowercase":    true,
+		"include_digits":       false,
+		"include_punctuation":  true,
+		"included_punctuation": "",
+		"excluded_punctuation": "",
+	}}, flat)
+
+	flat, err = flattenLockboxPasswordPayloadSpecification(nil)
+	require.NoError(t, err)
+	assert.Empty(t, flat)
+}
+
+func TestLockboxPasswordPayloadSpecificationsEqual(t *testing.T) {
+	f := false
+	current := &lockboxPasswordPayloadSpecification{PasswordKey: "password", Length: "20"}
+
+	assert.True(t, lockboxPasswordPayloadSpecificationsEqual(current, &lockboxPasswordPayloadSpecification{PasswordKey: "password"}))
+	assert.True(t, lockboxPasswordPayloadSpecificationsEqual(current, &lockboxPasswordPayloadSpecification{PasswordKey: "password", Length: "20"}))
+	assert.False(t, lockboxPasswordPayloadSpecificationsEqual(current, &lockboxPasswordPayloadSpecification{PasswordKey: "password", Length: "32"}))
+	assert.False(t, lockboxPasswordPayloadSpecificationsEqual(current, &lockboxPasswordPayloadSpecification{PasswordKey: "password", IncludeDigits: &f}))
+	assert.False(t, lockboxPasswordPayloadSpecificationsEqual(current, &lockboxPasswordPayloadSpecification{PasswordKey: "secret"}))
+	assert.False(t, lockboxPasswordPayloadSpecificationsEqual(nil, current))
+}
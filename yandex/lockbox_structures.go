@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"os/exec"
 	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type lockboxEntryCheck struct {
@@ -36,6 +39,87 @@ func flattenLockboxVersion(v *lockbox.Version) ([]map[string]interface{}, error)
 	return []map[string]interface{}{m}, nil
 }
 
+func flattenLockboxVersions(versions []*lockbox.Version, currentVersionID string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, map[string]interface{}{
+			"id":                 v.GetId(),
+			"description":        v.GetDescription(),
+			"status":             v.GetStatus().String(),
+			"created_at":         getTimestamp(v.GetCreatedAt()),
+			"destroy_at":         getTimestamp(v.GetDestroyAt()),
+			"payload_entry_keys": v.GetPayloadEntryKeys(),
+			"current":            v.GetId() == currentVersionID,
+		})
+	}
+	return result
+}
+
+func listLockboxSecretVersions(ctx context.Context, config *Config, secretID string) ([]*lockbox.Version, error) {
+	var versions []*lockbox.Version
+	pageToken := ""
+	for {
+		resp, err := config.sdk.LockboxSecret().Secret().ListVersions(ctx, &lockbox.ListVersionsRequest{
+			SecretId:  secretID,
+			PageSize:  defaultListSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing versions of secret %q: %w", secretID, err)
+		}
+
+		versions = append(versions, resp.GetVersions()...)
+
+		if resp.GetNextPageToken() == "" {
+			return versions, nil
+		}
+		pageToken = resp.GetNextPageToken()
+	}
+}
+
+// getLockboxSecretVersion looks the version up in the version list, which unlike the payload
+// does not contain the secret values.
+func getLockboxSecretVersion(ctx context.Context, config *Config, secretID, versionID string) (*lockbox.Version, error) {
+	versions, err := listLockboxSecretVersions(ctx, config, secretID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.GetId() == versionID {
+			return v, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "version %q of secret %q not found", versionID, secretID)
+}
+
+// lockboxVersionsOverRetention returns the active versions which are not among the keepLast newest active ones.
+// The versions listed in keep are never returned.
+func lockboxVersionsOverRetention(versions []*lockbox.Version, keepLast int, keep ...string) []string {
+	active := make([]*lockbox.Version, 0, len(versions))
+	for _, v := range versions {
+		if v.GetStatus() == lockbox.Version_ACTIVE {
+			active = append(active, v)
+		}
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].GetCreatedAt().AsTime().After(active[j].GetCreatedAt().AsTime())
+	})
+
+	kept := make(map[string]bool, len(keep))
+	for _, id := range keep {
+		kept[id] = true
+	}
+
+	result := []string{}
+	for i, v := range active {
+		if i < keepLast || kept[v.GetId()] {
+			continue
+		}
+		result = append(result, v.GetId())
+	}
+	return result
+}
+
 func expandLockboxSecretVersionEntriesSlice(ctx context.Context, d *schema.ResourceData) ([]*lockbox.PayloadEntryChange, error) {
 	count := d.Get("entries.#").(int)
 	slice := make([]*lockbox.PayloadEntryChange, count)
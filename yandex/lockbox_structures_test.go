@@ -0,0 +1,34 @@
+package yandex
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestLockboxVersionsOverRetention(t *testing.T) {
+	now := time.Now()
+	version := func(id string, age time.Duration, status lockbox.Version_Status) *lockbox.Version {
+		return &lockbox.Version{
+			Id:        id,
+			CreatedAt: timestamppb.New(now.Add(-age)),
+			Status:    status,
+		}
+	}
+
+	versions := []*lockbox.Version{
+		version("v1", 5*time.Hour, lockbox.Version_ACTIVE),
+		version("v2", 4*time.Hour, lockbox.Version_SCHEDULED_FOR_DESTRUCTION),
+		version("v3", 3*time.Hour, lockbox.Version_ACTIVE),
+		version("v4", 2*time.Hour, lockbox.Version_ACTIVE),
+		version("v5", 1*time.Hour, lockbox.Version_ACTIVE),
+	}
+
+	assert.Equal(t, []string{"v3", "v1"}, lockboxVersionsOverRetention(versions, 2))
+	assert.Equal(t, []string{"v3"}, lockboxVersionsOverRetention(versions, 2, "v1"))
+	assert.Equal(t, []string{}, lockboxVersionsOverRetention(versions, 4))
+	assert.Equal(t, []string{}, lockboxVersionsOverRetention(nil, 1))
+}
@@ -575,71 +575,6 @@ func flattenClickHouseResources(r *clickhouse.Resources) ([]map[string]interface
 	return []map[string]interface{}{res}, nil
 }
 
-func flattenClickhouseMergeTreeConfig(c *clickhouseConfig.ClickhouseConfig_MergeTree) ([]map[string]interface{}, error) {
-	res := map[string]interface{}{}
-
-	if c.ReplicatedDeduplicationWindow != nil {
-		res["replicated_deduplication_window"] = c.ReplicatedDeduplicationWindow.Value
-	}
-	if c.ReplicatedDeduplicationWindowSeconds != nil {
-		res["replicated_deduplication_window_seconds"] = c.ReplicatedDeduplicationWindowSeconds.Value
-	}
-	if c.PartsToDelayInsert != nil {
-		res["parts_to_delay_insert"] = c.PartsToDelayInsert.Value
-	}
-	if c.PartsToThrowInsert != nil {
-		res["parts_to_throw_insert"] = c.PartsToThrowInsert.Value
-	}
-	if c.MaxReplicatedMergesInQueue != nil {
-		res["max_replicated_merges_in_queue"] = c.MaxReplicatedMergesInQueue.Value
-	}
-	if c.NumberOfFreeEntriesInPoolToLowerMaxSizeOfMerge != nil {
-		res["number_of_free_entries_in_pool_to_lower_max_size_of_merge"] = c.NumberOfFreeEntriesInPoolToLowerMaxSizeOfMerge.Value
-	}
-	if c.MaxBytesToMergeAtMinSpaceInPool != nil {
-		res["max_bytes_to_merge_at_min_space_in_pool"] = c.MaxBytesToMergeAtMinSpaceInPool.Value
-	}
-
-	if c.MinBytesForWidePart != nil {
-		res["min_bytes_for_wide_part"] = c.MinBytesForWidePart.Value
-	}
-	if c.MinRowsForWidePart != nil {
-		res["min_rows_for_wide_part"] = c.MinRowsForWidePart.Value
-	}
-	if c.TtlOnlyDropParts != nil {
-		res["ttl_only_drop_parts"] = c.TtlOnlyDropParts.Value
-	}
-	if c.MergeWithTtlTimeout != nil {
-		res["merge_with_ttl_timeout"] = c.MergeWithTtlTimeout.Value
-	}
-	if c.MergeWithRecompressionTtlTimeout != nil {
-		res["merge_with_recompression_ttl_timeout"] = c.MergeWithRecompressionTtlTimeout.Value
-	}
-	if c.MaxPartsInTotal != nil {
-		res["max_parts_in_total"] = c.MaxPartsInTotal.Value
-	}
-	if c.MaxNumberOfMergesWithTtlInPool != nil {
-		res["max_number_of_merges_with_ttl_in_pool"] = c.MaxNumberOfMergesWithTtlInPool.Value
-	}
-	if c.CleanupDelayPeriod != nil {
-		res["cleanup_delay_period"] = c.CleanupDelayPeriod.Value
-	}
-
-	if c.MaxAvgPartSizeForTooManyParts != nil {
-		res["max_avg_part_size_for_too_many_parts"] = c.MaxAvgPartSizeForTooManyParts.Value
-	}
-	if c.MinAgeToForceMergeSeconds != nil {
-		res["min_age_to_force_merge_seconds"] = c.MinAgeToForceMergeSeconds.Value
-	}
-	if c.MinAgeToForceMergeOnPartitionOnly != nil {
-		res["min_age_to_force_merge_on_partition_only"] = c.MinAgeToForceMergeOnPartitionOnly.Value
-	}
-	if c.MergeSelectingSleepMs != nil {
-		res["merge_selecting_sleep_ms"] = c.MergeSelectingSleepMs.Value
-	}
-	return []map[string]interface{}{res}, nil
-}
-
 func flattenClickhouseKafkaSettings(d *schema.ResourceData, keyPath string, c *clickhouseConfig.ClickhouseConfig_Kafka) ([]map[string]interface{}, error) {
 	res := map[string]interface{}{}
 
@@ -960,70 +895,6 @@ func expandClickHouseResources(d *schema.ResourceData, rootKey string) *clickhou
 	return resources
 }
 
-func expandClickhouseMergeTreeConfig(d *schema.ResourceData, rootKey string) (*clickhouseConfig.ClickhouseConfig_MergeTree, error) {
-	config := &clickhouseConfig.ClickhouseConfig_MergeTree{}
-	// TODO: SA1019: d.GetOkExists is deprecated: usage is discouraged due to undefined behaviors and may be removed in a future version of the SDK (staticcheck)
-	if v, ok := d.GetOkExists(rootKey + ".replicated_deduplication_window"); ok {
-		config.ReplicatedDeduplicationWindow = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".replicated_deduplication_window_seconds"); ok {
-		config.ReplicatedDeduplicationWindowSeconds = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".parts_to_delay_insert"); ok {
-		config.PartsToDelayInsert = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".parts_to_throw_insert"); ok {
-		config.PartsToThrowInsert = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".max_replicated_merges_in_queue"); ok {
-		config.MaxReplicatedMergesInQueue = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".number_of_free_entries_in_pool_to_lower_max_size_of_merge"); ok {
-		config.NumberOfFreeEntriesInPoolToLowerMaxSizeOfMerge = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".max_bytes_to_merge_at_min_space_in_pool"); ok {
-		config.MaxBytesToMergeAtMinSpaceInPool = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".min_bytes_for_wide_part"); ok {
-		config.MinBytesForWidePart = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".min_rows_for_wide_part"); ok {
-		config.MinRowsForWidePart = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".ttl_only_drop_parts"); ok {
-		config.TtlOnlyDropParts = &wrappers.BoolValue{Value: v.(bool)}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".merge_with_ttl_timeout"); ok {
-		config.MergeWithTtlTimeout = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".merge_with_recompression_ttl_timeout"); ok {
-		config.MergeWithRecompressionTtlTimeout = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".max_parts_in_total"); ok {
-		config.MaxPartsInTotal = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".max_number_of_merges_with_ttl_in_pool"); ok {
-		config.MaxNumberOfMergesWithTtlInPool = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".cleanup_delay_period"); ok {
-		config.CleanupDelayPeriod = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".max_avg_part_size_for_too_many_parts"); ok {
-		config.MaxAvgPartSizeForTooManyParts = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".min_age_to_force_merge_seconds"); ok {
-		config.MinAgeToForceMergeSeconds = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".min_age_to_force_merge_on_partition_only"); ok {
-		config.MinAgeToForceMergeOnPartitionOnly = &wrappers.BoolValue{Value: v.(bool)}
-	}
-	if v, ok := d.GetOkExists(rootKey + ".merge_selecting_sleep_ms"); ok {
-		config.MergeSelectingSleepMs = &wrappers.Int64Value{Value: int64(v.(int))}
-	}
-
-	return config, nil
-}
-
 func expandClickhouseKafkaSettings(d *schema.ResourceData, rootKey string) (*clickhouseConfig.ClickhouseConfig_Kafka, error) {
 	config := &clickhouseConfig.ClickhouseConfig_Kafka{}
 
@@ -5,7 +5,9 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 )
 
@@ -730,3 +732,48 @@ var targetHostsZooIn2Subnets = []*clickhouse.HostSpec{
 		SubnetId:  "subnet-a",
 	},
 }
+
+func TestClickhouseMergeTreeConfig(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"merge_tree": {
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Optional: true,
+			Elem:     &schema.Resource{Schema: clickhouseMergeTreeConfigSchema()},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"merge_tree": []interface{}{
+			map[string]interface{}{
+				"parts_to_delay_insert":          150,
+				"inactive_parts_to_throw_insert": 0,
+				"ttl_only_drop_parts":            true,
+			},
+		},
+	})
+
+	config, err := expandClickhouseMergeTreeConfig(d, "merge_tree.0")
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), config.GetPartsToDelayInsert().GetValue())
+	assert.NotNil(t, config.GetInactivePartsToThrowInsert(), "zero values are set explicitly")
+	assert.True(t, config.GetTtlOnlyDropParts().GetValue())
+	assert.Nil(t, config.GetMaxPartsInTotal())
+
+	flattened, err := flattenClickhouseMergeTreeConfig(config)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{
+		"parts_to_delay_insert":          int64(150),
+		"inactive_parts_to_throw_insert": int64(0),
+		"ttl_only_drop_parts":            true,
+	}}, flattened)
+
+	flattened, err = flattenClickhouseMergeTreeConfig(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{}}, flattened)
+
+	sleep := clickhouseMergeTreeConfigSchema()["merge_selecting_sleep_ms"]
+	assert.NotEmpty(t, sleep.Description)
+	assert.Nil(t, sleep.ValidateFunc, "the attributes of the block before it was generated must accept the values they accepted")
+}
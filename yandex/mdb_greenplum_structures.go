@@ -2,6 +2,7 @@ package yandex
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -135,7 +136,7 @@ func expandGreenplumMaintenanceWindow(d *schema.ResourceData) (*greenplum.Mainte
 	return out, nil
 }
 
-func flattenGreenplumClusterConfig(c *greenplum.ClusterConfigSet) (map[string]string, error) {
+func flattenGreenplumClusterConfig(c *greenplum.ClusterConfigSet) ([]interface{}, error) {
 	var gpConfig interface{}
 
 	if cf, ok := c.GreenplumConfig.(*greenplum.ClusterConfigSet_GreenplumConfigSet_6); ok {
@@ -143,7 +144,7 @@ func flattenGreenplumClusterConfig(c *greenplum.ClusterConfigSet) (map[string]st
 	} else if cf, ok := c.GreenplumConfig.(*greenplum.ClusterConfigSet_GreenplumConfigSet_6_22); ok {
 		gpConfig = cf.GreenplumConfigSet_6_22.UserConfig
 	}
-	return flattenResourceGenerateMapS(gpConfig, false, mdbGreenplumSettingsFieldsInfo, false, true, nil)
+	return flattenResourceGenerateBlock(gpConfig, mdbGreenplumSettingsFieldsInfo, nil)
 }
 
 func flattenGreenplumPoolerConfig(c *greenplum.ConnectionPoolerConfigSet) ([]interface{}, error) {
@@ -282,7 +283,7 @@ func expandGreenplumUpdatePath(d *schema.ResourceData, settingNames []string) []
 	gpFieldName := getGreenplumConfigFieldName(version)
 
 	for _, setting := range settingNames {
-		field := fmt.Sprintf("greenplum_config.%s", setting)
+		field := fmt.Sprintf("greenplum_config.0.%s", setting)
 		if d.HasChange(field) {
 			path := fmt.Sprintf("config_spec.%s.%s", gpFieldName, setting)
 			updatePath = append(updatePath, path)
@@ -328,7 +329,7 @@ func expandGreenplumConfigSpecGreenplumConfig(d *schema.ResourceData) (*greenplu
 			GreenplumConfig_6_22: &greenplum.GreenplumConfig6_22{},
 		}
 
-		settingNames, err := expandResourceGenerateNonSkippedFields(mdbGreenplumSettingsFieldsInfo, d, cfg.GreenplumConfig_6_22, "greenplum_config.", true)
+		settingNames, err := expandResourceGenerateNonSkippedFields(mdbGreenplumSettingsFieldsInfo, d, cfg.GreenplumConfig_6_22, "greenplum_config.0.", true)
 		if err != nil {
 			return nil, nil, []string{}, err
 		}
@@ -338,7 +339,7 @@ func expandGreenplumConfigSpecGreenplumConfig(d *schema.ResourceData) (*greenplu
 			GreenplumConfig_6: &greenplum.GreenplumConfig6{},
 		}
 
-		settingNames, err := expandResourceGenerateNonSkippedFields(mdbGreenplumSettingsFieldsInfo, d, cfg.GreenplumConfig_6, "greenplum_config.", true)
+		settingNames, err := expandResourceGenerateNonSkippedFields(mdbGreenplumSettingsFieldsInfo, d, cfg.GreenplumConfig_6, "greenplum_config.0.", true)
 		if err != nil {
 			return nil, nil, []string{}, err
 		}
@@ -420,3 +421,14 @@ func parseGreenplumPoolingMode(s string) (greenplum.ConnectionPoolerConfig_PoolM
 var mdbGreenplumSettingsFieldsInfo = newObjectFieldsInfo().
 	addType(greenplum.GreenplumConfig6_22{}).
 	addType(greenplum.GreenplumConfig6{})
+
+var mdbGreenplumVersionSettings = &mdbVersionSettings{
+	engine: "Greenplum",
+	configTypes: map[string]reflect.Type{
+		"6.22": reflect.TypeOf(greenplum.GreenplumConfig6_22{}),
+		"6.25": reflect.TypeOf(greenplum.GreenplumConfig6{}),
+	},
+	settingsFieldsInfo: mdbGreenplumSettingsFieldsInfo,
+	versionKey:         "version",
+	settingsKey:        "greenplum_config",
+}
@@ -1188,3 +1188,183 @@ func flattenExternalS3Storage(externalS3 *kafka.ExternalS3Storage) map[string]in
 		"region":        externalS3.Region,
 	}
 }
+
+// kafkaConnectorConfig is a type of connector of yandex_mdb_kafka_connector, configured with its own
+// connector_config_* block. Adding a connector type takes implementing the interface and listing it
+// in kafkaConnectorConfigs.
+type kafkaConnectorConfig interface {
+	// key returns the name of the connector_config_* block.
+	key() string
+	// schema returns the schema of the block.
+	schema() *schema.Schema
+	// expand sets the connector-specific config of the create request from the block.
+	expand(d *schema.ResourceData, spec *kafka.ConnectorSpec)
+	// expandUpdate sets the connector-specific config of the update request from the block.
+	expandUpdate(d *schema.ResourceData, spec *kafka.UpdateConnectorSpec)
+	// flatten returns the block of the connector, ok is false if the connector is of another type.
+	flatten(conn *kafka.Connector) (block []map[string]interface{}, ok bool, err error)
+	// updatePaths maps the attributes of the block to the update mask paths.
+	updatePaths(keyPrefix, valPrefix string) map[string]string
+}
+
+var kafkaConnectorConfigs = []kafkaConnectorConfig{
+	kafkaMirrorMakerConnectorConfig{},
+	kafkaS3SinkConnectorConfig{},
+}
+
+type kafkaMirrorMakerConnectorConfig struct{}
+
+func (kafkaMirrorMakerConnectorConfig) key() string {
+	return "connector_config_mirrormaker"
+}
+
+func (kafkaMirrorMakerConnectorConfig) schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"topics": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"source_cluster": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem:     resourceYandexMDBKafkaClusterConnectionSpec(),
+				},
+				"target_cluster": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem:     resourceYandexMDBKafkaClusterConnectionSpec(),
+				},
+				"replication_factor": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func (kafkaMirrorMakerConnectorConfig) expand(d *schema.ResourceData, spec *kafka.ConnectorSpec) {
+	spec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
+}
+
+func (kafkaMirrorMakerConnectorConfig) expandUpdate(d *schema.ResourceData, spec *kafka.UpdateConnectorSpec) {
+	spec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
+}
+
+func (kafkaMirrorMakerConnectorConfig) flatten(conn *kafka.Connector) ([]map[string]interface{}, bool, error) {
+	mm := conn.GetConnectorConfigMirrormaker()
+	if mm == nil {
+		return nil, false, nil
+	}
+	block, err := flattenKafkaConnectorMirrormaker(mm)
+	return block, true, err
+}
+
+func (kafkaMirrorMakerConnectorConfig) updatePaths(keyPrefix, valPrefix string) map[string]string {
+	paths := map[string]string{
+		keyPrefix + "topics":             valPrefix + "topics",
+		keyPrefix + "replication_factor": valPrefix + "replication_factor",
+	}
+
+	for _, source := range []string{"source_cluster", "target_cluster"} {
+		keyPrefix := keyPrefix + source + ".0."
+		valPrefix := valPrefix + source + "."
+		paths[keyPrefix+"alias"] = valPrefix + "alias"
+
+		keyPrefix = keyPrefix + "external_cluster.0."
+		valPrefix = valPrefix + "external_cluster."
+		paths[keyPrefix+"bootstrap_servers"] = valPrefix + "bootstrap_servers"
+		paths[keyPrefix+"sasl_username"] = valPrefix + "sasl_username"
+		paths[keyPrefix+"sasl_password"] = valPrefix + "sasl_password"
+		paths[keyPrefix+"sasl_mechanism"] = valPrefix + "sasl_mechanism"
+		paths[keyPrefix+"security_protocol"] = valPrefix + "security_protocol"
+	}
+	return paths
+}
+
+type kafkaS3SinkConnectorConfig struct{}
+
+func (kafkaS3SinkConnectorConfig) key() string {
+	return "connector_config_s3_sink"
+}
+
+func (kafkaS3SinkConnectorConfig) schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"topics": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"file_compression_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"file_max_records": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"s3_connection": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem:     resourceYandexMDBKafkaS3ConnectionSpec(),
+				},
+			},
+		},
+	}
+}
+
+func (kafkaS3SinkConnectorConfig) expand(d *schema.ResourceData, spec *kafka.ConnectorSpec) {
+	spec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpec(d))
+}
+
+func (kafkaS3SinkConnectorConfig) expandUpdate(d *schema.ResourceData, spec *kafka.UpdateConnectorSpec) {
+	spec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpecUpdate(d))
+}
+
+func (kafkaS3SinkConnectorConfig) flatten(conn *kafka.Connector) ([]map[string]interface{}, bool, error) {
+	s3Sink := conn.GetConnectorConfigS3Sink()
+	if s3Sink == nil {
+		return nil, false, nil
+	}
+	block, err := flattenKafkaConnectorS3Sink(s3Sink)
+	return block, true, err
+}
+
+func (kafkaS3SinkConnectorConfig) updatePaths(keyPrefix, valPrefix string) map[string]string {
+	paths := map[string]string{
+		keyPrefix + "topics":           valPrefix + "topics",
+		keyPrefix + "file_max_records": valPrefix + "file_max_records",
+	}
+
+	keyPrefix = keyPrefix + "s3_connection" + ".0."
+	valPrefix = valPrefix + "s3_connection" + "."
+	paths[keyPrefix+"bucket_name"] = valPrefix + "bucket_name"
+
+	keyPrefix = keyPrefix + "external_s3.0."
+	valPrefix = valPrefix + "external_s3."
+	paths[keyPrefix+"access_key_id"] = valPrefix + "access_key_id"
+	paths[keyPrefix+"secret_access_key"] = valPrefix + "secret_access_key"
+	paths[keyPrefix+"endpoint"] = valPrefix + "endpoint"
+	paths[keyPrefix+"region"] = valPrefix + "region"
+	return paths
+}
+
+// flattenKafkaConnectorState returns the state of the connector as set by the state attribute,
+// or an empty string while the connector status is unknown.
+func flattenKafkaConnectorState(conn *kafka.Connector) string {
+	if conn.GetStatus() == kafka.Connector_STATUS_UNKNOWN {
+		return ""
+	}
+	return conn.GetStatus().String()
+}
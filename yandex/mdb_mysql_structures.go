@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -1342,7 +1343,7 @@ func convertSQLModes80ToInt32(sqlModes []config.MysqlConfig8_0_SQLMode) []int32
 	return modes
 }
 
-func flattenMySQLSettingsSQLMode(settings map[string]string, modes []int32) (map[string]string, error) {
+func flattenMySQLSettingsSQLMode(settings map[string]interface{}, modes []int32) (map[string]interface{}, error) {
 	sqlMode, err := mdbMySQLSettingsFieldsInfo.intSliceToString("sql_mode", modes)
 	if err != nil {
 		return nil, err
@@ -1353,7 +1354,7 @@ func flattenMySQLSettingsSQLMode(settings map[string]string, modes []int32) (map
 	}
 
 	if settings == nil {
-		settings = make(map[string]string)
+		settings = make(map[string]interface{})
 	}
 
 	settings["sql_mode"] = sqlMode
@@ -1361,7 +1362,7 @@ func flattenMySQLSettingsSQLMode(settings map[string]string, modes []int32) (map
 	return settings, nil
 }
 
-func flattenMySQLConfig(c *mysql.ClusterConfig) (map[string]string, error) {
+func flattenMySQLConfig(c *mysql.ClusterConfig) ([]interface{}, error) {
 	var userConfig interface{}
 	var sqlModes []int32
 
@@ -1374,17 +1375,17 @@ func flattenMySQLConfig(c *mysql.ClusterConfig) (map[string]string, error) {
 		sqlModes = convertSQLModes57ToInt32(cf.MysqlConfig_5_7.EffectiveConfig.SqlMode)
 	}
 
-	settings, err := flattenResourceGenerateMapS(userConfig, false, mdbMySQLSettingsFieldsInfo, false, true, nil)
+	settings, err := flattenResourceGenerate(mdbMySQLSettingsFieldsInfo, userConfig, false, false, true, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	settings, err = flattenMySQLSettingsSQLMode(settings, sqlModes)
-	if err != nil {
+	if err != nil || settings == nil {
 		return nil, err
 	}
 
-	return settings, err
+	return []interface{}{settings}, nil
 }
 
 func expandMySQLConfigSpec(d *schema.ResourceData) (*mysql.ConfigSpec, error) {
@@ -1426,7 +1427,7 @@ func expandMySQLConfigSpecSettings(d *schema.ResourceData, configSpec *mysql.Con
 		}
 		configSpec.MysqlConfig = cfg
 
-		if err := expandResourceGenerate(mdbMySQLSettingsFieldsInfo, d, cfg.MysqlConfig_5_7, "mysql_config.", true); err != nil {
+		if err := expandResourceGenerate(mdbMySQLSettingsFieldsInfo, d, cfg.MysqlConfig_5_7, "mysql_config.0.", true); err != nil {
 			return err
 		}
 
@@ -1443,7 +1444,7 @@ func expandMySQLConfigSpecSettings(d *schema.ResourceData, configSpec *mysql.Con
 		}
 		configSpec.MysqlConfig = cfg
 
-		if err := expandResourceGenerate(mdbMySQLSettingsFieldsInfo, d, cfg.MysqlConfig_8_0, "mysql_config.", true); err != nil {
+		if err := expandResourceGenerate(mdbMySQLSettingsFieldsInfo, d, cfg.MysqlConfig_8_0, "mysql_config.0.", true); err != nil {
 			return err
 		}
 
@@ -1454,7 +1455,7 @@ func expandMySQLConfigSpecSettings(d *schema.ResourceData, configSpec *mysql.Con
 }
 
 func expandMySQLSqlModes(d *schema.ResourceData) ([]int32, error) {
-	sqlMode, ok := d.GetOkExists("mysql_config.sql_mode")
+	sqlMode, ok := d.GetOkExists("mysql_config.0.sql_mode")
 	if ok {
 		return mdbMySQLSettingsFieldsInfo.stringToIntSlice("sql_mode", sqlMode.(string))
 	}
@@ -1515,3 +1516,20 @@ var mdbMySQLSettingsFieldsInfo = newObjectFieldsInfo().
 	addEnumGeneratedNames("binlog_row_image", config.MysqlConfig8_0_BinlogRowImage_name).
 	addEnumGeneratedNames("slave_parallel_type", config.MysqlConfig8_0_SlaveParallelType_name).
 	addSkipEnumGeneratedNames("sql_mode", config.MysqlConfig8_0_SQLMode_name, defaultStringOfEnumsCheck("sql_mode"), defaultStringCompare)
+
+var mdbMySQLVersionSettings = &mdbVersionSettings{
+	engine: "MySQL",
+	configTypes: map[string]reflect.Type{
+		"5.7": reflect.TypeOf(config.MysqlConfig5_7{}),
+		"8.0": reflect.TypeOf(config.MysqlConfig8_0{}),
+	},
+	settingsFieldsInfo: mdbMySQLSettingsFieldsInfo,
+	versionKey:         "version",
+	settingsKey:        "mysql_config",
+}
+
+var mdbMySQLVersionUpgrade = &mdbVersionUpgrade{
+	mdbVersionSettings: mdbMySQLVersionSettings,
+	editions:           [][]string{{"5.7", "8.0"}},
+	allowDropKey:       "allow_dropping_settings",
+}
@@ -23,7 +23,7 @@ func TestFlattenMySQLSettingsEmpty(t *testing.T) {
 	}
 
 	if m != nil {
-		t.Errorf("FlattenMySQLSettings fail: flatten empty should return nil map but map is: %v", m)
+		t.Errorf("FlattenMySQLSettings fail: flatten empty should return nil block but block is: %v", m)
 	}
 }
 
@@ -57,16 +57,16 @@ func TestFlattenMySQLSettings_5_7(t *testing.T) {
 		t.Errorf("FlattenMySQLSettings fail: flatten 5_7 error: %v", err)
 	}
 
-	ethalon := map[string]string{
-		"binlog_transaction_dependency_tracking": "0",
-		"max_connections":                        "555",
+	ethalon := []interface{}{map[string]interface{}{
+		"binlog_transaction_dependency_tracking": 0,
+		"max_connections":                        555,
 		"sql_mode":                               "NO_BACKSLASH_ESCAPES,STRICT_ALL_TABLES",
-		"innodb_print_all_deadlocks":             "true",
-		"log_slow_rate_type":                     "0",
-	}
+		"innodb_print_all_deadlocks":             true,
+		"log_slow_rate_type":                     0,
+	}}
 
 	if !reflect.DeepEqual(ethalon, m) {
-		t.Errorf("FlattenMySQLSettings fail: flatten 5_7 should return %v map but map is: %v", ethalon, m)
+		t.Errorf("FlattenMySQLSettings fail: flatten 5_7 should return %v block but block is: %v", ethalon, m)
 	}
 }
 
@@ -100,16 +100,16 @@ func TestFlattenMySQLSettings_8_0(t *testing.T) {
 		t.Errorf("FlattenMySQLSettings fail: flatten 8_0 error: %v", err)
 	}
 
-	ethalon := map[string]string{
-		"binlog_transaction_dependency_tracking": "0",
-		"max_connections":                        "555",
+	ethalon := []interface{}{map[string]interface{}{
+		"binlog_transaction_dependency_tracking": 0,
+		"max_connections":                        555,
 		"sql_mode":                               "NO_BACKSLASH_ESCAPES,STRICT_ALL_TABLES",
-		"innodb_print_all_deadlocks":             "true",
-		"log_slow_rate_type":                     "0",
-	}
+		"innodb_print_all_deadlocks":             true,
+		"log_slow_rate_type":                     0,
+	}}
 
 	if !reflect.DeepEqual(ethalon, m) {
-		t.Errorf("FlattenMySQLSettings fail: flatten 8_0 should return %v map but map is: %v", ethalon, m)
+		t.Errorf("FlattenMySQLSettings fail: flatten 8_0 should return %v block but block is: %v", ethalon, m)
 	}
 }
 
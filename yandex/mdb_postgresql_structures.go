@@ -93,11 +93,11 @@ func flattenPGDiskSizeAutoscaling(p *postgresql.DiskSizeAutoscaling) []interface
 	return []interface{}{out}
 }
 
-func flattenPGSettingsSPL(settings map[string]string, c *postgresql.ClusterConfig) map[string]string {
+func flattenPGSettingsSPL(settings map[string]interface{}, c *postgresql.ClusterConfig) map[string]interface{} {
 	splEnums := convertPGSPLtoInts(c)
 	spl, _ := mdbPGSettingsFieldsInfo.intSliceToString("shared_preload_libraries", splEnums)
 	if settings == nil {
-		settings = make(map[string]string)
+		settings = make(map[string]interface{})
 	}
 	settings["shared_preload_libraries"] = spl
 	return settings
@@ -173,111 +173,98 @@ func convertPGSPLtoInts(c *postgresql.ClusterConfig) []int32 {
 	return out
 }
 
-func flattenPGSettings(c *postgresql.ClusterConfig) (map[string]string, error) {
+func flattenPGSettings(c *postgresql.ClusterConfig) ([]interface{}, error) {
 	// TODO refactor it using generics
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_16); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_16.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_16.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_15); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_15.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_15.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_15_1C); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_15_1C.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_15_1C.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_14); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_14.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_14.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_14_1C); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_14_1C.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_14_1C.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_13); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_13.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_13.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_13_1C); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_13_1C.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_13_1C.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_12); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_12.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_12.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_12_1C); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_12_1C.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_12_1C.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_11); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_11.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_11.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_11_1C); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_11_1C.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_11_1C.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_10); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_10.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_10.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 	if cf, ok := c.PostgresqlConfig.(*postgresql.ClusterConfig_PostgresqlConfig_10_1C); ok {
-		settings, err := flattenResourceGenerateMapS(cf.PostgresqlConfig_10_1C.UserConfig, false, mdbPGSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerate(mdbPGSettingsFieldsInfo, cf.PostgresqlConfig_10_1C.UserConfig, false, false, true, nil)
 		if err != nil {
 			return nil, err
 		}
-		settings = flattenPGSettingsSPL(settings, c)
-		return settings, nil
+		return []interface{}{flattenPGSettingsSPL(settings, c)}, nil
 	}
 
 	return nil, nil
@@ -323,7 +310,7 @@ func flattenPGUsers(us []*postgresql.User, passwords map[string]string,
 
 func flattenPGUser(u *postgresql.User,
 	fieldsInfo *objectFieldsInfo, knownDefault map[string]struct{}) (map[string]interface{}, error) {
-	settings, err := flattenResourceGenerateMapS(u.Settings, false, fieldsInfo, false, true, knownDefault)
+	settings, err := flattenResourceGenerateBlock(u.Settings, fieldsInfo, knownDefault)
 	if err != nil {
 		return nil, err
 	}
@@ -935,7 +922,6 @@ func expandPGParamsUpdatePath(d *schema.ResourceData, settingNames []string) []s
 		"name":                               "name",
 		"description":                        "description",
 		"labels":                             "labels",
-		"config.0.version":                   "config_spec.version",
 		"config.0.autofailover":              "config_spec.autofailover",
 		"config.0.pooler_config":             "config_spec.pooler_config",
 		"config.0.access":                    "config_spec.access",
@@ -947,7 +933,7 @@ func expandPGParamsUpdatePath(d *schema.ResourceData, settingNames []string) []s
 		"security_group_ids":                 "security_group_ids",
 		"maintenance_window":                 "maintenance_window",
 		"deletion_protection":                "deletion_protection",
-		"config.0.postgresql_config.shared_preload_libraries": fmt.Sprintf("config_spec.%s.shared_preload_libraries", pgFieldName),
+		"config.0.postgresql_config.0.shared_preload_libraries": fmt.Sprintf("config_spec.%s.shared_preload_libraries", pgFieldName),
 	}
 
 	updatePath := []string{}
@@ -958,7 +944,7 @@ func expandPGParamsUpdatePath(d *schema.ResourceData, settingNames []string) []s
 	}
 
 	for _, setting := range settingNames {
-		field := fmt.Sprintf("config.0.postgresql_config.%s", setting)
+		field := fmt.Sprintf("config.0.postgresql_config.0.%s", setting)
 		log.Printf("[DEBUG] HasChange(%s): %t", field, d.HasChange(field))
 		if d.HasChange(field) {
 			path := fmt.Sprintf("config_spec.%s.%s", pgFieldName, setting)
@@ -1139,7 +1125,7 @@ func expandPGUser(d *schema.ResourceData, user *postgresql.UserSpec, path string
 			user.Settings = &postgresql.UserSettings{}
 		}
 
-		err := expandResourceGenerate(mdbPGUserSettingsFieldsInfo, d, user.Settings, path+"settings.", true)
+		err := expandResourceGenerate(mdbPGUserSettingsFieldsInfo, d, user.Settings, path+"settings.0.", true)
 		if err != nil {
 			return nil, err
 		}
@@ -1420,7 +1406,7 @@ func expandPGMaintenanceWindow(d *schema.ResourceData) (*postgresql.MaintenanceW
 
 func expandPGSharedPreloadLibraries(d *schema.ResourceData) ([]int32, error) {
 	var sharedPreloadLibraries []int32
-	sharedPreloadLibValue, ok := d.GetOkExists("config.0.postgresql_config.shared_preload_libraries")
+	sharedPreloadLibValue, ok := d.GetOkExists("config.0.postgresql_config.0.shared_preload_libraries")
 	if ok {
 		splValue := sharedPreloadLibValue.(string)
 
@@ -1460,7 +1446,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_10, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_10, "config.0.postgresql_config.0.", true)
 	} else if version == "10-1c" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_10_1C{
 			PostgresqlConfig_10_1C: &config.PostgresqlConfig10_1C{},
@@ -1471,7 +1457,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_10_1C, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_10_1C, "config.0.postgresql_config.0.", true)
 	} else if version == "11" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_11{
 			PostgresqlConfig_11: &config.PostgresqlConfig11{},
@@ -1482,7 +1468,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_11, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_11, "config.0.postgresql_config.0.", true)
 	} else if version == "11-1c" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_11_1C{
 			PostgresqlConfig_11_1C: &config.PostgresqlConfig11_1C{},
@@ -1493,7 +1479,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_11_1C, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_11_1C, "config.0.postgresql_config.0.", true)
 	} else if version == "12-1c" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_12_1C{
 			PostgresqlConfig_12_1C: &config.PostgresqlConfig12_1C{},
@@ -1504,7 +1490,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_12_1C, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_12_1C, "config.0.postgresql_config.0.", true)
 	} else if version == "12" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_12{
 			PostgresqlConfig_12: &config.PostgresqlConfig12{},
@@ -1515,7 +1501,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_12, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_12, "config.0.postgresql_config.0.", true)
 	} else if version == "13" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_13{
 			PostgresqlConfig_13: &config.PostgresqlConfig13{},
@@ -1526,7 +1512,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_13, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_13, "config.0.postgresql_config.0.", true)
 	} else if version == "13-1c" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_13_1C{
 			PostgresqlConfig_13_1C: &config.PostgresqlConfig13_1C{},
@@ -1537,7 +1523,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_13_1C, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_13_1C, "config.0.postgresql_config.0.", true)
 	} else if version == "14" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_14{
 			PostgresqlConfig_14: &config.PostgresqlConfig14{},
@@ -1548,7 +1534,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_14, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_14, "config.0.postgresql_config.0.", true)
 	} else if version == "14-1c" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_14_1C{
 			PostgresqlConfig_14_1C: &config.PostgresqlConfig14_1C{},
@@ -1559,7 +1545,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_14_1C, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_14_1C, "config.0.postgresql_config.0.", true)
 	} else if version == "15" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_15{
 			PostgresqlConfig_15: &config.PostgresqlConfig15{},
@@ -1570,7 +1556,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_15, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_15, "config.0.postgresql_config.0.", true)
 	} else if version == "15-1c" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_15_1C{
 			PostgresqlConfig_15_1C: &config.PostgresqlConfig15_1C{},
@@ -1581,7 +1567,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_15_1C, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_15_1C, "config.0.postgresql_config.0.", true)
 	} else if version == "16" {
 		cfg := &postgresql.ConfigSpec_PostgresqlConfig_16{
 			PostgresqlConfig_16: &config.PostgresqlConfig16{},
@@ -1592,7 +1578,7 @@ func expandPGConfigSpecSettings(d *schema.ResourceData, configSpec *postgresql.C
 			}
 		}
 		configSpec.PostgresqlConfig = cfg
-		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_16, "config.0.postgresql_config.", true)
+		return expandResourceGenerateNonSkippedFields(mdbPGSettingsFieldsInfo, d, cfg.PostgresqlConfig_16, "config.0.postgresql_config.0.", true)
 	}
 
 	return []string{}, err
@@ -1776,3 +1762,34 @@ var mdbPGSettingsFieldsInfo = newObjectFieldsInfo().
 	addSkipEnumGeneratedNames("shared_preload_libraries", config.PostgresqlConfig14_SharedPreloadLibraries_name, defaultStringOfEnumsCheck("shared_preload_libraries"), defaultStringCompare).
 	addEnumGeneratedNames("pg_hint_plan_debug_print", config.PostgresqlConfig14_PgHintPlanDebugPrint_name).
 	addEnumGeneratedNames("pg_hint_plan_message_level", config.PostgresqlConfig14_LogLevel_name)
+
+var mdbPGVersionSettings = &mdbVersionSettings{
+	engine: "PostgreSQL",
+	configTypes: map[string]reflect.Type{
+		"10":    reflect.TypeOf(config.PostgresqlConfig10{}),
+		"10-1c": reflect.TypeOf(config.PostgresqlConfig10_1C{}),
+		"11":    reflect.TypeOf(config.PostgresqlConfig11{}),
+		"11-1c": reflect.TypeOf(config.PostgresqlConfig11_1C{}),
+		"12":    reflect.TypeOf(config.PostgresqlConfig12{}),
+		"12-1c": reflect.TypeOf(config.PostgresqlConfig12_1C{}),
+		"13":    reflect.TypeOf(config.PostgresqlConfig13{}),
+		"13-1c": reflect.TypeOf(config.PostgresqlConfig13_1C{}),
+		"14":    reflect.TypeOf(config.PostgresqlConfig14{}),
+		"14-1c": reflect.TypeOf(config.PostgresqlConfig14_1C{}),
+		"15":    reflect.TypeOf(config.PostgresqlConfig15{}),
+		"15-1c": reflect.TypeOf(config.PostgresqlConfig15_1C{}),
+		"16":    reflect.TypeOf(config.PostgresqlConfig16{}),
+	},
+	settingsFieldsInfo: mdbPGSettingsFieldsInfo,
+	versionKey:         "config.0.version",
+	settingsKey:        "config.0.postgresql_config",
+}
+
+var mdbPGVersionUpgrade = &mdbVersionUpgrade{
+	mdbVersionSettings: mdbPGVersionSettings,
+	editions: [][]string{
+		{"10", "11", "12", "13", "14", "15", "16"},
+		{"10-1c", "11-1c", "12-1c", "13-1c", "14-1c", "15-1c"},
+	},
+	allowDropKey: "allow_dropping_settings",
+}
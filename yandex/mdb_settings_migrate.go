@@ -0,0 +1,170 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The settings of the MDB clusters were string maps up to the schema version 0 of the resources,
+// they are blocks with an attribute for every setting since the schema version 1.
+
+// mdbSettingsMapSchemaV0 is the schema of a settings map of the schema version 0.
+func mdbSettingsMapSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// mdbResourceWithSettingsMapsV0 returns the resource of the schema version 0: a copy of r with the settings maps
+// in place of the settings blocks at the paths, like "config.postgresql_config".
+func mdbResourceWithSettingsMapsV0(r *schema.Resource, paths ...string) *schema.Resource {
+	s := r.Schema
+	for _, path := range paths {
+		s = mdbSchemaWithSettingsMapV0(s, strings.Split(path, "."))
+	}
+	return &schema.Resource{Schema: s}
+}
+
+func mdbSchemaWithSettingsMapV0(s map[string]*schema.Schema, path []string) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema, len(s))
+	for k, v := range s {
+		out[k] = v
+	}
+
+	if len(path) == 1 {
+		out[path[0]] = mdbSettingsMapSchemaV0()
+		return out
+	}
+
+	nested := *s[path[0]]
+	nested.Elem = &schema.Resource{Schema: mdbSchemaWithSettingsMapV0(nested.Elem.(*schema.Resource).Schema, path[1:])}
+	out[path[0]] = &nested
+	return out
+}
+
+// upgradeMDBSettingsMapV0 converts the settings map at the path of the raw state of the schema version 0
+// to the settings block of fieldsInfo. The path goes through the blocks of the state, every element of
+// the lists is converted.
+func upgradeMDBSettingsMapV0(rawState map[string]interface{}, fieldsInfo *objectFieldsInfo, path ...string) error {
+	v, ok := rawState[path[0]]
+	if !ok || v == nil {
+		return nil
+	}
+
+	if len(path) > 1 {
+		elems, _ := v.([]interface{})
+		for _, elem := range elems {
+			if m, ok := elem.(map[string]interface{}); ok {
+				if err := upgradeMDBSettingsMapV0(m, fieldsInfo, path[1:]...); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	block, err := upgradeSettingsMapToBlock(fieldsInfo, v)
+	if err != nil {
+		return fmt.Errorf("error upgrading %s: %s", path[0], err)
+	}
+	rawState[path[0]] = block
+	return nil
+}
+
+// upgradeSettingsMapToBlock converts the values of a settings map to the types of the attributes of the settings block,
+// the settings which have no attributes are dropped.
+func upgradeSettingsMapToBlock(fieldsInfo *objectFieldsInfo, v interface{}) ([]interface{}, error) {
+	settings, _ := v.(map[string]interface{})
+	if len(settings) == 0 {
+		return []interface{}{}, nil
+	}
+
+	attributes := generateBlockSchema(fieldsInfo)
+	block := make(map[string]interface{})
+	for field, value := range settings {
+		attribute, ok := attributes[field]
+		if !ok {
+			log.Printf("[WARN] setting %s has no attribute in the settings block, it is dropped from the state", field)
+			continue
+		}
+
+		s, _ := value.(string)
+		switch attribute.Type {
+		case schema.TypeInt:
+			i, err := fieldsInfo.stringToInt(field, s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q of %s: %s", s, field, err)
+			}
+			if i != nil {
+				block[field] = *i
+			}
+		case schema.TypeFloat:
+			f, err := fieldsInfo.stringToFloat(field, s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q of %s: %s", s, field, err)
+			}
+			if f != nil {
+				block[field] = *f
+			}
+		case schema.TypeBool:
+			b, err := fieldsInfo.stringToBool(field, s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q of %s: %s", s, field, err)
+			}
+			if b != nil {
+				block[field] = *b
+			}
+		default:
+			block[field] = s
+		}
+	}
+
+	return []interface{}{block}, nil
+}
+
+func resourceYandexMDBPostgreSQLClusterStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if err := upgradeMDBSettingsMapV0(rawState, mdbPGSettingsFieldsInfo, "config", "postgresql_config"); err != nil {
+		return nil, err
+	}
+	if err := upgradeMDBSettingsMapV0(rawState, mdbPGUserSettingsFieldsInfo, "user", "settings"); err != nil {
+		return nil, err
+	}
+	return rawState, nil
+}
+
+func resourceYandexMDBPostgreSQLUserStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if err := upgradeMDBSettingsMapV0(rawState, mdbPGUserSettingsFieldsInfo, "settings"); err != nil {
+		return nil, err
+	}
+	return rawState, nil
+}
+
+func resourceYandexMDBMySQLClusterStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if err := upgradeMDBSettingsMapV0(rawState, mdbMySQLSettingsFieldsInfo, "mysql_config"); err != nil {
+		return nil, err
+	}
+	return rawState, nil
+}
+
+func resourceYandexMDBGreenplumClusterStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if err := upgradeMDBSettingsMapV0(rawState, mdbGreenplumSettingsFieldsInfo, "greenplum_config"); err != nil {
+		return nil, err
+	}
+	return rawState, nil
+}
+
+func resourceYandexMDBSQLServerClusterStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if err := upgradeMDBSettingsMapV0(rawState, mdbSQLServerSettingsFieldsInfo, "sqlserver_config"); err != nil {
+		return nil, err
+	}
+	return rawState, nil
+}
@@ -0,0 +1,180 @@
+package yandex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceYandexMDBPostgreSQLClusterStateUpgradeV0(t *testing.T) {
+
+	cases := map[string]struct {
+		V0 map[string]any
+		V1 map[string]any
+	}{
+		"no_settings": {
+			V0: map[string]any{
+				"name": "test",
+				"config": []interface{}{
+					map[string]interface{}{"version": "15"},
+				},
+			},
+			V1: map[string]any{
+				"name": "test",
+				"config": []interface{}{
+					map[string]interface{}{"version": "15"},
+				},
+			},
+		},
+		"empty_settings": {
+			V0: map[string]any{
+				"config": []interface{}{
+					map[string]interface{}{
+						"postgresql_config": map[string]interface{}{},
+					},
+				},
+			},
+			V1: map[string]any{
+				"config": []interface{}{
+					map[string]interface{}{
+						"postgresql_config": []interface{}{},
+					},
+				},
+			},
+		},
+		"filled_settings": {
+			V0: map[string]any{
+				"config": []interface{}{
+					map[string]interface{}{
+						"version": "15",
+						"postgresql_config": map[string]interface{}{
+							"max_connections":                "395",
+							"enable_parallel_hash":           "true",
+							"autovacuum_vacuum_scale_factor": "0.34",
+							"default_transaction_isolation":  "TRANSACTION_ISOLATION_READ_UNCOMMITTED",
+							"shared_preload_libraries":       "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN",
+							"unknown_setting":                "1",
+						},
+					},
+				},
+				"user": []interface{}{
+					map[string]interface{}{
+						"name": "alice",
+						"settings": map[string]interface{}{
+							"default_transaction_isolation": "read committed",
+							"log_min_duration_statement":    "5000",
+						},
+					},
+					map[string]interface{}{
+						"name": "bob",
+					},
+				},
+			},
+			V1: map[string]any{
+				"config": []interface{}{
+					map[string]interface{}{
+						"version": "15",
+						"postgresql_config": []interface{}{
+							map[string]interface{}{
+								"max_connections":                395,
+								"enable_parallel_hash":           true,
+								"autovacuum_vacuum_scale_factor": 0.34,
+								"default_transaction_isolation":  "TRANSACTION_ISOLATION_READ_UNCOMMITTED",
+								"shared_preload_libraries":       "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN",
+							},
+						},
+					},
+				},
+				"user": []interface{}{
+					map[string]interface{}{
+						"name": "alice",
+						"settings": []interface{}{
+							map[string]interface{}{
+								"default_transaction_isolation": "read committed",
+								"log_min_duration_statement":    5000,
+							},
+						},
+					},
+					map[string]interface{}{
+						"name": "bob",
+					},
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			actualV1, err := resourceYandexMDBPostgreSQLClusterStateUpgradeV0(context.TODO(), tc.V0, nil)
+			if err != nil {
+				t.Fatalf("error migrating state: %s", err)
+			}
+
+			if !reflect.DeepEqual(tc.V1, actualV1) {
+				t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", tc.V1, actualV1)
+			}
+		})
+	}
+}
+
+func TestResourceYandexMDBMySQLClusterStateUpgradeV0(t *testing.T) {
+	v0 := map[string]any{
+		"mysql_config": map[string]interface{}{
+			"max_connections":            "555",
+			"innodb_print_all_deadlocks": "true",
+			"sql_mode":                   "NO_BACKSLASH_ESCAPES,STRICT_ALL_TABLES",
+		},
+	}
+	v1 := map[string]any{
+		"mysql_config": []interface{}{
+			map[string]interface{}{
+				"max_connections":            555,
+				"innodb_print_all_deadlocks": true,
+				"sql_mode":                   "NO_BACKSLASH_ESCAPES,STRICT_ALL_TABLES",
+			},
+		},
+	}
+
+	actualV1, err := resourceYandexMDBMySQLClusterStateUpgradeV0(context.TODO(), v0, nil)
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(v1, actualV1) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", v1, actualV1)
+	}
+}
+
+func TestResourceYandexMDBSQLServerClusterStateUpgradeV0InvalidValue(t *testing.T) {
+	v0 := map[string]any{
+		"sqlserver_config": map[string]interface{}{
+			"fill_factor_percent": "many",
+		},
+	}
+
+	if _, err := resourceYandexMDBSQLServerClusterStateUpgradeV0(context.TODO(), v0, nil); err == nil {
+		t.Fatal("expected an error migrating the invalid value of fill_factor_percent")
+	}
+}
+
+func TestMDBResourceWithSettingsMapsV0(t *testing.T) {
+	r := resourceYandexMDBPostgreSQLCluster()
+	v0 := mdbResourceWithSettingsMapsV0(r, "config.postgresql_config", "user.settings")
+
+	config := v0.Schema["config"].Elem.(*schema.Resource)
+	if got := config.Schema["postgresql_config"].Type; got != schema.TypeMap {
+		t.Errorf("postgresql_config of the schema version 0 is %v, want %v", got, schema.TypeMap)
+	}
+	user := v0.Schema["user"].Elem.(*schema.Resource)
+	if got := user.Schema["settings"].Type; got != schema.TypeMap {
+		t.Errorf("user settings of the schema version 0 is %v, want %v", got, schema.TypeMap)
+	}
+
+	// the schema of the current version stays unchanged
+	config = r.Schema["config"].Elem.(*schema.Resource)
+	if got := config.Schema["postgresql_config"].Type; got != schema.TypeList {
+		t.Errorf("postgresql_config of the current schema is %v, want %v", got, schema.TypeList)
+	}
+}
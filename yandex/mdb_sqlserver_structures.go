@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 
@@ -520,11 +521,11 @@ func databaseDiffSQLServer(ctx context.Context, config *Config, d *schema.Resour
 	return newDatabaseSpecs, dropDatabaseNames, nil
 }
 
-func flattenSQLServerSettings(c *sqlserver.ClusterConfig) (map[string]string, error) {
+func flattenSQLServerSettings(c *sqlserver.ClusterConfig) ([]interface{}, error) {
 
 	if cf, ok := c.SqlserverConfig.(*sqlserver.ClusterConfig_SqlserverConfig_2016Sp2Std); ok {
 
-		settings, err := flattenResourceGenerateMapS(cf.SqlserverConfig_2016Sp2Std.UserConfig, false, mdbSQLServerSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerateBlock(cf.SqlserverConfig_2016Sp2Std.UserConfig, mdbSQLServerSettingsFieldsInfo, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -532,7 +533,7 @@ func flattenSQLServerSettings(c *sqlserver.ClusterConfig) (map[string]string, er
 		return settings, nil
 	}
 	if cf, ok := c.SqlserverConfig.(*sqlserver.ClusterConfig_SqlserverConfig_2016Sp2Ent); ok {
-		settings, err := flattenResourceGenerateMapS(cf.SqlserverConfig_2016Sp2Ent.UserConfig, false, mdbSQLServerSettingsFieldsInfo, false, true, nil)
+		settings, err := flattenResourceGenerateBlock(cf.SqlserverConfig_2016Sp2Ent.UserConfig, mdbSQLServerSettingsFieldsInfo, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -573,7 +574,7 @@ func expandSQLServerConfigSpecSettings(d *schema.ResourceData, configSpec *sqlse
 		return "", nil, nil
 	}
 
-	fields, err = expandResourceGenerateNonSkippedFields(mdbSQLServerSettingsFieldsInfo, d, sqlserverConfig, path+".", true)
+	fields, err = expandResourceGenerateNonSkippedFields(mdbSQLServerSettingsFieldsInfo, d, sqlserverConfig, path+".0.", true)
 
 	if err != nil {
 		return "", nil, err
@@ -585,3 +586,14 @@ func expandSQLServerConfigSpecSettings(d *schema.ResourceData, configSpec *sqlse
 var mdbSQLServerSettingsFieldsInfo = newObjectFieldsInfo().
 	addType(config.SQLServerConfig2016Sp2Std{}).
 	addType(config.SQLServerConfig2016Sp2Ent{})
+
+var mdbSQLServerVersionSettings = &mdbVersionSettings{
+	engine: "SQL Server",
+	configTypes: map[string]reflect.Type{
+		"2016sp2std": reflect.TypeOf(config.SQLServerConfig2016Sp2Std{}),
+		"2016sp2ent": reflect.TypeOf(config.SQLServerConfig2016Sp2Ent{}),
+	},
+	settingsFieldsInfo: mdbSQLServerSettingsFieldsInfo,
+	versionKey:         "version",
+	settingsKey:        "sqlserver_config",
+}
@@ -1,10 +1,14 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var weeklyMaintenanceWindow_WeekDay_value = map[string]int32{
@@ -57,3 +61,268 @@ func expandMDBBackupWindowStart(d *schema.ResourceData, path string) *timeofday.
 
 	return out
 }
+
+// mdbRestoreSchema returns the restore block of an MDB cluster. The cluster is created from the backup
+// in the folder of the cluster, so a backup is cloned to another folder by setting folder_id of the cluster.
+func mdbRestoreSchema(elem *schema.Resource) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		ForceNew: true,
+		Elem:     elem,
+	}
+}
+
+// mdbRestoreResource returns the attributes of the restore block shared by all MDB clusters.
+// The time attribute is added only for the engines supporting the point-in-time recovery.
+func mdbRestoreResource(pointInTime bool) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+	if pointInTime {
+		r.Schema["time"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: stringToTimeValidateFunc,
+		}
+	}
+	return r
+}
+
+// expandMDBRestoreTime returns the point in time of the restore block, or nil if it is not set.
+func expandMDBRestoreTime(d *schema.ResourceData) (*timestamppb.Timestamp, error) {
+	v, ok := d.GetOk("restore.0.time")
+	if !ok {
+		return nil, nil
+	}
+
+	t, err := parseStringToTime(v.(string))
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing restore.0.time, value: %v error: %s", v, err)
+	}
+	return &timestamppb.Timestamp{Seconds: t.Unix()}, nil
+}
+
+// expandMDBRestoreTimeOrNow is expandMDBRestoreTime for the engines requiring the point in time of the recovery,
+// the latest state of the backup is restored if the time is not set.
+func expandMDBRestoreTimeOrNow(d *schema.ResourceData) (*timestamppb.Timestamp, error) {
+	t, err := expandMDBRestoreTime(d)
+	if err != nil || t != nil {
+		return t, err
+	}
+	return &timestamppb.Timestamp{Seconds: time.Now().Unix()}, nil
+}
+
+// mdbBackup is the engine independent view of an MDB backup.
+type mdbBackup struct {
+	ID               string
+	FolderID         string
+	SourceClusterID  string
+	SourceShardNames []string
+	StartedAt        *timestamppb.Timestamp
+	CreatedAt        *timestamppb.Timestamp
+	Size             int64
+	Type             string
+}
+
+// mdbBackupsLister lists the backups of the cluster if clusterID is not empty, or of the whole folder otherwise.
+type mdbBackupsLister func(ctx context.Context, config *Config, clusterID, folderID string) ([]*mdbBackup, error)
+
+func dataSourceYandexMDBBackups(engine string, list mdbBackupsLister) *schema.Resource {
+	return &schema.Resource{
+		Description: fmt.Sprintf("Get the list of backups of Yandex Managed Service for %s clusters.", engine),
+
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return dataSourceYandexMDBBackupsRead(ctx, d, meta, engine, list)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the cluster to list the backups of. The backups of all clusters of the folder are listed if it is not set.",
+				Optional:    true,
+			},
+
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the folder to list the backups in. The provider folder is used if it is not set.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"backups": {
+				Type:        schema.TypeList,
+				Description: "Backups, each one can be used in the restore block of the cluster.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"folder_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_cluster_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_shard_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"started_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexMDBBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}, engine string, list mdbBackupsLister) diag.Diagnostics {
+	config := meta.(*Config)
+
+	clusterID := d.Get("cluster_id").(string)
+	folderID := ""
+	if clusterID == "" {
+		var err error
+		folderID, err = getFolderID(d, config)
+		if err != nil {
+			return diag.Errorf("Error getting folder ID while listing %s backups: %s", engine, err)
+		}
+	}
+
+	backups, err := list(ctx, config, clusterID, folderID)
+	if err != nil {
+		return diag.Errorf("Error while listing %s backups: %s", engine, err)
+	}
+
+	if clusterID != "" {
+		d.SetId(clusterID)
+	} else {
+		d.SetId(folderID)
+		d.Set("folder_id", folderID)
+	}
+
+	if err := d.Set("backups", flattenMDBBackups(backups)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func flattenMDBBackups(backups []*mdbBackup) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(backups))
+	for _, b := range backups {
+		result = append(result, map[string]interface{}{
+			"id":                 b.ID,
+			"folder_id":          b.FolderID,
+			"source_cluster_id":  b.SourceClusterID,
+			"source_shard_names": b.SourceShardNames,
+			"started_at":         getTimestamp(b.StartedAt),
+			"created_at":         getTimestamp(b.CreatedAt),
+			"size":               int(b.Size),
+			"type":               b.Type,
+		})
+	}
+	return result
+}
+
+// mdbClusterIDFromMetadata returns the cluster ID from the metadata of either the create or the restore operation.
+func mdbClusterIDFromMetadata(md interface{}) (string, bool) {
+	m, ok := md.(interface{ GetClusterId() string })
+	if !ok {
+		return "", false
+	}
+	return m.GetClusterId(), true
+}
+
+// mdbAddedHostName returns the name of the single host added by the add hosts operation.
+func mdbAddedHostName(md interface{}) (string, bool) {
+	m, ok := md.(interface{ GetHostNames() []string })
+	if !ok || len(m.GetHostNames()) != 1 {
+		return "", false
+	}
+	return m.GetHostNames()[0], true
+}
+
+// suppressMDBUnmanagedHostsDiff ignores the changes of the host blocks of an existing cluster with
+// `manage_hosts = false`, its hosts are managed by the standalone host resources then.
+func suppressMDBUnmanagedHostsDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && !d.Get("manage_hosts").(bool)
+}
+
+// validateMDBClusterHosts requires the host blocks of a new cluster and of a cluster with `manage_hosts = true`.
+// A cluster is created with its host blocks anyway, but the ones of an existing cluster with `manage_hosts = false`
+// can be removed, as its hosts are managed by the standalone host resources then.
+func validateMDBClusterHosts(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("host") || (d.Id() != "" && !d.Get("manage_hosts").(bool)) {
+		return nil
+	}
+	if d.Get("host.#").(int) == 0 {
+		return fmt.Errorf("at least one host block is required to create the cluster or to manage its hosts")
+	}
+	return nil
+}
+
+// suppressMDBUnmanagedDiff ignores the changes of the blocks of an existing cluster with manageKey = false,
+// they are managed by the standalone resources then.
+func suppressMDBUnmanagedDiff(manageKey string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return d.Id() != "" && !mdbClusterManages(d, manageKey)
+	}
+}
+
+// mdbClusterManages reports whether the cluster manages the blocks of manageKey. The clusters in the state
+// written before the flag was added have no value of it and are managed, as by default.
+func mdbClusterManages(d *schema.ResourceData, manageKey string) bool {
+	// TODO: SA1019: d.GetOkExists is deprecated: usage is discouraged due to undefined behaviors and may be removed in a future version of the SDK (staticcheck)
+	if v, ok := d.GetOkExists(manageKey); ok {
+		return v.(bool)
+	}
+	return true
+}
+
+// importMDBClusterManaging imports the cluster managing the blocks of manageKeys, as by default.
+func importMDBClusterManaging(manageKeys ...string) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		for _, k := range manageKeys {
+			if err := d.Set(k, true); err != nil {
+				return nil, err
+			}
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// importMDBClusterWithManagedHosts imports the cluster with the hosts managed by its host blocks, as by default.
+func importMDBClusterWithManagedHosts(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("manage_hosts", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
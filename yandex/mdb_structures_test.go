@@ -0,0 +1,210 @@
+package yandex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestMDBRestoreResource(t *testing.T) {
+	if _, ok := mdbRestoreResource(false).Schema["time"]; ok {
+		t.Error("time should not be set without the point-in-time recovery")
+	}
+	if _, ok := mdbRestoreResource(true).Schema["time"]; !ok {
+		t.Error("time should be set with the point-in-time recovery")
+	}
+}
+
+func TestExpandMDBRestoreTime(t *testing.T) {
+	restore := map[string]*schema.Schema{"restore": mdbRestoreSchema(mdbRestoreResource(true))}
+
+	d := schema.TestResourceDataRaw(t, restore, map[string]interface{}{
+		"restore": []interface{}{
+			map[string]interface{}{"backup_id": "backup", "time": "2024-05-06T07:08:09"},
+		},
+	})
+	got, err := expandMDBRestoreTime(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := int64(1714979289); got.GetSeconds() != want {
+		t.Errorf("expandMDBRestoreTime() = %v, want %v seconds", got, want)
+	}
+
+	d = schema.TestResourceDataRaw(t, restore, map[string]interface{}{
+		"restore": []interface{}{
+			map[string]interface{}{"backup_id": "backup"},
+		},
+	})
+	got, err = expandMDBRestoreTime(d)
+	if err != nil || got != nil {
+		t.Errorf("expandMDBRestoreTime() = %v, %v, want nil without time", got, err)
+	}
+	if got, err = expandMDBRestoreTimeOrNow(d); err != nil || got == nil {
+		t.Errorf("expandMDBRestoreTimeOrNow() = %v, %v, want now without time", got, err)
+	}
+}
+
+func TestMDBClusterIDFromMetadata(t *testing.T) {
+	for _, md := range []interface{}{
+		&mysql.CreateClusterMetadata{ClusterId: "cid"},
+		&clickhouse.RestoreClusterMetadata{ClusterId: "cid", BackupId: "backup"},
+	} {
+		if id, ok := mdbClusterIDFromMetadata(md); !ok || id != "cid" {
+			t.Errorf("mdbClusterIDFromMetadata(%T) = %q, %v", md, id, ok)
+		}
+	}
+	if _, ok := mdbClusterIDFromMetadata(&mysql.Backup{}); ok {
+		t.Error("mdbClusterIDFromMetadata should fail on metadata without cluster ID")
+	}
+}
+
+func TestMDBAddedHostName(t *testing.T) {
+	md := &postgresql.AddClusterHostsMetadata{ClusterId: "cid", HostNames: []string{"host.mdb.yandexcloud.net"}}
+	if name, ok := mdbAddedHostName(md); !ok || name != "host.mdb.yandexcloud.net" {
+		t.Errorf("mdbAddedHostName() = %q, %v", name, ok)
+	}
+	if _, ok := mdbAddedHostName(&postgresql.AddClusterHostsMetadata{ClusterId: "cid"}); ok {
+		t.Error("mdbAddedHostName should fail on metadata without host names")
+	}
+}
+
+func TestSuppressMDBUnmanagedHostsDiff(t *testing.T) {
+	cluster := resourceYandexMDBPostgreSQLCluster().Schema
+	hosts := schema.InternalMap(map[string]*schema.Schema{
+		"host":         cluster["host"],
+		"manage_hosts": cluster["manage_hosts"],
+	})
+	state := func(manageHosts string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "cid",
+			Attributes: map[string]string{
+				"host.#":           "1",
+				"host.0.zone":      "ru-central1-a",
+				"host.0.fqdn":      "host-a.mdb.yandexcloud.net",
+				"manage_hosts":     manageHosts,
+				"host.0.name":      "",
+				"host.0.role":      "MASTER",
+				"host.0.subnet_id": "",
+			},
+		}
+	}
+	config := func(manageHosts bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"host": []interface{}{
+				map[string]interface{}{"zone": "ru-central1-a"},
+				map[string]interface{}{"zone": "ru-central1-b"},
+			},
+			"manage_hosts": manageHosts,
+		})
+	}
+
+	cases := []struct {
+		name        string
+		state       *terraform.InstanceState
+		config      *terraform.ResourceConfig
+		hostChanges bool
+	}{
+		{"managed", state("true"), config(true), true},
+		{"unmanaged", state("false"), config(false), false},
+		{"switched to unmanaged", state("true"), config(false), false},
+		{"switched to managed", state("false"), config(true), true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := hosts.Diff(context.Background(), tc.state, tc.config, nil, nil, true)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			hostChanges := false
+			if diff != nil {
+				_, hostChanges = diff.Attributes["host.#"]
+			}
+			if hostChanges != tc.hostChanges {
+				t.Errorf("host changes = %v, want %v: %v", hostChanges, tc.hostChanges, diff)
+			}
+		})
+	}
+}
+
+func TestMDBClusterManages(t *testing.T) {
+	cluster := resourceYandexMDBClickHouseCluster().Schema
+	databases := schema.InternalMap(map[string]*schema.Schema{
+		"database":         cluster["database"],
+		"manage_databases": cluster["manage_databases"],
+	})
+	data := func(attributes map[string]string) *schema.ResourceData {
+		d, err := databases.Data(&terraform.InstanceState{ID: "cid", Attributes: attributes}, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		return d
+	}
+
+	if !mdbClusterManages(data(map[string]string{}), "manage_databases") {
+		t.Error("a cluster without the flag in the state, imported or written by an older provider, must be managed")
+	}
+	if !mdbClusterManages(data(map[string]string{"manage_databases": "true"}), "manage_databases") {
+		t.Error("manage_databases = true must be managed")
+	}
+	if mdbClusterManages(data(map[string]string{"manage_databases": "false"}), "manage_databases") {
+		t.Error("manage_databases = false must not be managed")
+	}
+
+	imported, err := importMDBClusterManaging("manage_databases")(data(map[string]string{}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if v := imported[0].Get("manage_databases"); v != true {
+		t.Errorf("imported manage_databases = %v, want true", v)
+	}
+}
+
+func TestFlattenMDBBackups(t *testing.T) {
+	backups := []*mdbBackup{
+		flattenClickHouseBackup(&clickhouse.Backup{
+			Id:               "backup",
+			FolderId:         "folder",
+			SourceClusterId:  "cluster",
+			SourceShardNames: []string{"shard1"},
+			StartedAt:        &timestamppb.Timestamp{Seconds: 1714979289},
+			CreatedAt:        &timestamppb.Timestamp{Seconds: 1714979349},
+			Size:             1024,
+			Type:             clickhouse.Backup_AUTOMATED,
+		}),
+		flattenMySQLBackup(&mysql.Backup{Id: "mysql"}),
+	}
+
+	want := []map[string]interface{}{
+		{
+			"id":                 "backup",
+			"folder_id":          "folder",
+			"source_cluster_id":  "cluster",
+			"source_shard_names": []string{"shard1"},
+			"started_at":         "2024-05-06T07:08:09Z",
+			"created_at":         "2024-05-06T07:09:09Z",
+			"size":               1024,
+			"type":               "AUTOMATED",
+		},
+		{
+			"id":                 "mysql",
+			"folder_id":          "",
+			"source_cluster_id":  "",
+			"source_shard_names": []string(nil),
+			"started_at":         "",
+			"created_at":         "",
+			"size":               0,
+			"type":               "BACKUP_CREATION_TYPE_UNSPECIFIED",
+		},
+	}
+	if got := flattenMDBBackups(backups); !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenMDBBackups() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,153 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mdbVersionSettings describes the settings block of a cluster of an MDB engine whose settings type depends on its version.
+type mdbVersionSettings struct {
+	engine string
+	// configTypes maps the versions to the types of their settings in settingsFieldsInfo.
+	configTypes        map[string]reflect.Type
+	settingsFieldsInfo *objectFieldsInfo
+	versionKey         string
+	settingsKey        string
+}
+
+// unsupportedSettings returns the sorted names of the settings which are not supported by the version.
+func (s *mdbVersionSettings) unsupportedSettings(version string, settings map[string]interface{}) []string {
+	t, ok := s.configTypes[version]
+	if !ok {
+		return nil
+	}
+
+	supported := s.settingsFieldsInfo.fieldsReflect[t]
+	var unsupported []string
+	for name := range settings {
+		if _, ok := supported[name]; ok {
+			continue
+		}
+		// the settings filled manually are not bound to the types of the versions
+		if _, ok := s.settingsFieldsInfo.nameFieldsType[name]; !ok {
+			continue
+		}
+		unsupported = append(unsupported, name)
+	}
+	sort.Strings(unsupported)
+	return unsupported
+}
+
+// invalidSettings checks the names, the types and the values of the settings against the settings type of the version,
+// it returns the problems found sorted by the names of the settings.
+func (s *mdbVersionSettings) invalidSettings(version string, settings map[string]interface{}) []string {
+	t, ok := s.configTypes[version]
+	if !ok {
+		return nil
+	}
+
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fieldsInfo := s.settingsFieldsInfo
+	var problems []string
+	for _, name := range names {
+		value := settingString(settings[name])
+
+		if check := fieldsInfo.checkValueFunc(name); check != nil {
+			if err := check(value); err != nil {
+				problems = append(problems, fmt.Sprintf("invalid value %q of %s: %s", value, name, err))
+			}
+			continue
+		}
+
+		if _, ok := fieldsInfo.getFields(t)[name]; !ok {
+			if _, ok := fieldsInfo.nameFieldsType[name]; ok {
+				problems = append(problems, fmt.Sprintf("%s is not supported by version %s", name, version))
+			} else {
+				problems = append(problems, fmt.Sprintf("unknown setting %s%s", name, didYouMean(name, fieldsInfo.names(t))))
+			}
+			continue
+		}
+
+		if ok, err := checkValidate(fieldsInfo, name, value, t); !ok {
+			if err == nil {
+				err = fmt.Errorf("unsupported type")
+			}
+			problems = append(problems, fmt.Sprintf("invalid value %q of %s: %s", value, name, err))
+		}
+	}
+	return problems
+}
+
+// validateSettings checks at plan time that the settings are valid for the version of the cluster, so the settings
+// of the other versions and the wrong values are reported with the names of the settings instead of failing the apply.
+func (s *mdbVersionSettings) validateSettings(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(s.versionKey) {
+		return nil
+	}
+
+	version, _ := d.Get(s.versionKey).(string)
+	if problems := s.invalidSettings(version, s.configuredSettings(d)); len(problems) > 0 {
+		return fmt.Errorf("%s is not valid for %s version %s:\n  - %s",
+			s.settingsKey, s.engine, version, strings.Join(problems, "\n  - "))
+	}
+	return nil
+}
+
+// configuredSettings returns the settings set in the configuration of the settings block, by name. The block has
+// an attribute for every setting of all the versions, the ones which are not set are left out.
+func (s *mdbVersionSettings) configuredSettings(d interface {
+	GetRawConfig() cty.Value
+	Get(string) interface{}
+}) map[string]interface{} {
+	settings := make(map[string]interface{})
+	for _, name := range mdbConfiguredAttributes(d.GetRawConfig(), s.settingsKey+".0") {
+		settings[name] = d.Get(s.settingsKey + ".0." + name)
+	}
+	return settings
+}
+
+// mdbConfiguredAttributes returns the sorted names of the attributes of the block at the key which are set in the
+// configuration to known values.
+func mdbConfiguredAttributes(config cty.Value, key string) []string {
+	v := config
+	for _, part := range strings.Split(key, ".") {
+		if v.IsNull() || !v.IsKnown() {
+			return nil
+		}
+		if i, err := strconv.Atoi(part); err == nil {
+			if !v.CanIterateElements() || v.LengthInt() <= i {
+				return nil
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+			continue
+		}
+		if !v.Type().IsObjectType() || !v.Type().HasAttribute(part) {
+			return nil
+		}
+		v = v.GetAttr(part)
+	}
+	if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() {
+		return nil
+	}
+
+	var names []string
+	for name, attribute := range v.AsValueMap() {
+		if !attribute.IsNull() && attribute.IsWhollyKnown() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
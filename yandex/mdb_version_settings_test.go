@@ -0,0 +1,142 @@
+package yandex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestMDBVersionSettingsInvalidSettings(t *testing.T) {
+	cases := []struct {
+		name     string
+		settings *mdbVersionSettings
+		version  string
+		values   map[string]interface{}
+		want     []string
+	}{
+		{
+			name:     "valid",
+			settings: mdbPGVersionSettings,
+			version:  "16",
+			values: map[string]interface{}{
+				"max_connections":                "395",
+				"enable_parallel_hash":           "true",
+				"autovacuum_vacuum_scale_factor": "0.32",
+				"wal_level":                      "WAL_LEVEL_LOGICAL",
+				"shared_preload_libraries":       "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN,SHARED_PRELOAD_LIBRARIES_PG_HINT_PLAN",
+			},
+		},
+		{
+			name:     "unknown version",
+			settings: mdbPGVersionSettings,
+			version:  "17",
+			values:   map[string]interface{}{"max_conections": "100"},
+		},
+		{
+			name:     "typo",
+			settings: mdbPGVersionSettings,
+			version:  "16",
+			values:   map[string]interface{}{"max_conections": "100"},
+			want:     []string{`unknown setting max_conections, did you mean "max_connections"?`},
+		},
+		{
+			name:     "not a setting",
+			settings: mdbPGVersionSettings,
+			version:  "16",
+			values:   map[string]interface{}{"foo": "1"},
+			want:     []string{"unknown setting foo"},
+		},
+		{
+			name:     "setting of another version",
+			settings: mdbPGVersionSettings,
+			version:  "16",
+			values:   map[string]interface{}{"operator_precedence_warning": "true"},
+			want:     []string{"operator_precedence_warning is not supported by version 16"},
+		},
+		{
+			name:     "wrong types",
+			settings: mdbPGVersionSettings,
+			version:  "15",
+			values: map[string]interface{}{
+				"max_connections":      "many",
+				"enable_parallel_hash": "5",
+			},
+			want: []string{`invalid value "5" of enable_parallel_hash`, `invalid value "many" of max_connections`},
+		},
+		{
+			name:     "enum",
+			settings: mdbPGVersionSettings,
+			version:  "15",
+			values: map[string]interface{}{
+				"wal_level":                     "WAL_LEVEL_EVERYTHING",
+				"default_transaction_isolation": "42",
+				"shared_preload_libraries":      "SHARED_PRELOAD_LIBRARIES_FOO",
+			},
+			want: []string{
+				`invalid value "42" of default_transaction_isolation: should be one of TRANSACTION_ISOLATION_READ_COMMITTED`,
+				`invalid value "SHARED_PRELOAD_LIBRARIES_FOO" of shared_preload_libraries: should be one of SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN`,
+				`invalid value "WAL_LEVEL_EVERYTHING" of wal_level: should be one of WAL_LEVEL_LOGICAL`,
+			},
+		},
+		{
+			name:     "mysql",
+			settings: mdbMySQLVersionSettings,
+			version:  "8.0",
+			values: map[string]interface{}{
+				"innodb_buffer_pool_size": "1073741824",
+				"query_cache_size":        "1024",
+				"max_conections":          "100",
+			},
+			want: []string{
+				`unknown setting max_conections, did you mean "max_connections"?`,
+				"query_cache_size is not supported by version 8.0",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.settings.invalidSettings(tc.version, tc.values)
+			if len(got) != len(tc.want) {
+				t.Fatalf("invalidSettings() = %q, want %q", got, tc.want)
+			}
+			for i := range got {
+				if !strings.HasPrefix(got[i], tc.want[i]) {
+					t.Errorf("invalidSettings()[%d] = %q, want prefix %q", i, got[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMDBConfiguredAttributes(t *testing.T) {
+	t.Parallel()
+
+	config := cty.ObjectVal(map[string]cty.Value{
+		"config": cty.ListVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"postgresql_config": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{
+						"max_connections":      cty.NumberIntVal(395),
+						"work_mem":             cty.UnknownVal(cty.Number),
+						"shared_buffers":       cty.NullVal(cty.Number),
+						"enable_parallel_hash": cty.True,
+					}),
+				}),
+			}),
+		}),
+	})
+
+	cases := map[string][]string{
+		"config.0.postgresql_config.0": {"enable_parallel_hash", "max_connections"},
+		"config.0.postgresql_config.1": nil,
+		"config.0.mysql_config.0":      nil,
+	}
+	for key, want := range cases {
+		if got := mdbConfiguredAttributes(config, key); !reflect.DeepEqual(got, want) {
+			t.Errorf("mdbConfiguredAttributes(%s) = %v, want %v", key, got, want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// mdbVersionUpgrade describes how a cluster of an MDB engine is upgraded to a newer major version.
+// The API upgrades the cluster by one major version at a time, so the upgrade to a distant version
+// is performed step by step through all the versions in between.
+type mdbVersionUpgrade struct {
+	*mdbVersionSettings
+	// editions lists the major versions of each edition of the engine from the oldest to the newest.
+	editions [][]string
+	// allowDropKey is the attribute which allows the upgrade to drop the settings the target version doesn't support.
+	allowDropKey string
+}
+
+// path returns the versions the cluster is upgraded through, the last one is the target version.
+func (u *mdbVersionUpgrade) path(from, to string) ([]string, error) {
+	if from == to {
+		return nil, nil
+	}
+
+	for _, versions := range u.editions {
+		fromIndex, toIndex := mdbVersionIndex(versions, from), mdbVersionIndex(versions, to)
+		if fromIndex < 0 && toIndex < 0 {
+			continue
+		}
+		if fromIndex < 0 || toIndex < 0 {
+			return nil, fmt.Errorf("%s version can't be changed from %s to %s, only the upgrade within the same edition is supported", u.engine, from, to)
+		}
+		if toIndex < fromIndex {
+			return nil, fmt.Errorf("%s version can't be downgraded from %s to %s", u.engine, from, to)
+		}
+		return versions[fromIndex+1 : toIndex+1], nil
+	}
+
+	// the versions unknown to the provider are left to the API
+	return []string{to}, nil
+}
+
+func mdbVersionIndex(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// droppedSettings returns the settings of the cluster which are going to be dropped by the upgrade. The settings
+// block holds the zero values of the settings the cluster doesn't set, so only the other values are reported.
+func (u *mdbVersionUpgrade) droppedSettings(d interface {
+	GetChange(string) (interface{}, interface{})
+}) []string {
+	oldVersion, newVersion := d.GetChange(u.versionKey)
+	if oldVersion.(string) == newVersion.(string) {
+		return nil
+	}
+
+	settings := make(map[string]interface{})
+	oldSettings, _ := d.GetChange(u.settingsKey)
+	if blocks, _ := oldSettings.([]interface{}); len(blocks) > 0 {
+		block, _ := blocks[0].(map[string]interface{})
+		for name, value := range block {
+			if value != nil && !reflect.ValueOf(value).IsZero() {
+				settings[name] = value
+			}
+		}
+	}
+	return u.unsupportedSettings(newVersion.(string), settings)
+}
+
+// customizeDiff checks at plan time that the cluster can be upgraded and the configured settings are
+// supported by the target version, all the unsupported ones are listed at once. The settings of the cluster
+// the upgrade drops fail the plan too, unless the drop is allowed by allowDropKey.
+func (u *mdbVersionUpgrade) customizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange(u.versionKey) {
+		return nil
+	}
+
+	oldVersion, newVersion := d.GetChange(u.versionKey)
+	path, err := u.path(oldVersion.(string), newVersion.(string))
+	if err != nil {
+		return err
+	}
+
+	// the other problems of the settings are listed too, as the plan stops at the first failed check
+	if problems := u.invalidSettings(newVersion.(string), u.configuredSettings(d)); len(problems) > 0 {
+		return fmt.Errorf("%s is not valid for %s version %s, fix or remove the settings to upgrade the cluster:\n  - %s",
+			u.settingsKey, u.engine, newVersion, strings.Join(problems, "\n  - "))
+	}
+
+	if err := u.checkDroppedSettings(d); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] %s Cluster %q will be upgraded from version %s through %s", u.engine, d.Id(), oldVersion, strings.Join(path, ", "))
+	return nil
+}
+
+// checkDroppedSettings fails unless the settings of the cluster the upgrade drops are allowed to be dropped.
+func (u *mdbVersionUpgrade) checkDroppedSettings(d interface {
+	Id() string
+	Get(string) interface{}
+	GetChange(string) (interface{}, interface{})
+}) error {
+	dropped := u.droppedSettings(d)
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	_, newVersion := d.GetChange(u.versionKey)
+	if allow, _ := d.Get(u.allowDropKey).(bool); !allow {
+		return fmt.Errorf("settings %s of %s Cluster %q are not supported by version %s and would be dropped by the upgrade, "+
+			"remove them from the cluster or set %s = true to upgrade the cluster",
+			strings.Join(dropped, ", "), u.engine, d.Id(), newVersion, u.allowDropKey)
+	}
+	log.Printf("[WARN] %s Cluster %q settings %s are not supported by version %s and will be dropped",
+		u.engine, d.Id(), strings.Join(dropped, ", "), newVersion)
+	return nil
+}
+
+// run upgrades the cluster to every version of the path in turn, waiting for each step to complete.
+func (u *mdbVersionUpgrade) run(d *schema.ResourceData, upgrade func(version string) error) error {
+	oldVersion, newVersion := d.GetChange(u.versionKey)
+	path, err := u.path(oldVersion.(string), newVersion.(string))
+	if err != nil {
+		return err
+	}
+
+	current := oldVersion.(string)
+	for i, version := range path {
+		log.Printf("[INFO] Upgrading %s Cluster %q from version %s to %s (step %d of %d)", u.engine, d.Id(), current, version, i+1, len(path))
+		if err := upgrade(version); err != nil {
+			return fmt.Errorf("upgrade of %s Cluster %q from version %s to %s (step %d of %d) failed, the cluster remains at version %s: %s",
+				u.engine, d.Id(), current, version, i+1, len(path), current, err)
+		}
+		log.Printf("[INFO] %s Cluster %q is upgraded to version %s (step %d of %d)", u.engine, d.Id(), version, i+1, len(path))
+		current = version
+	}
+	return nil
+}
+
+// withWarnings reports the upgrade steps and the dropped settings as warnings of the update, along with its error
+// when it fails.
+func (u *mdbVersionUpgrade) withWarnings(f func(*schema.ResourceData, interface{}) error) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if d.HasChange(u.versionKey) {
+			oldVersion, newVersion := d.GetChange(u.versionKey)
+			if path, err := u.path(oldVersion.(string), newVersion.(string)); err == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("%s Cluster version upgrade", u.engine),
+					Detail: fmt.Sprintf("The upgrade of the cluster from version %s takes %d steps: %s.",
+						oldVersion, len(path), strings.Join(append([]string{oldVersion.(string)}, path...), " -> ")),
+				})
+			}
+			if dropped := u.droppedSettings(d); len(dropped) > 0 {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("%s settings dropped by the upgrade", u.engine),
+					Detail:   fmt.Sprintf("Settings %s are not supported by version %s and are dropped by the upgrade.", strings.Join(dropped, ", "), newVersion),
+				})
+			}
+		}
+
+		if err := f(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
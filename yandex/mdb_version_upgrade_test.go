@@ -0,0 +1,165 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestMDBVersionUpgradePath(t *testing.T) {
+	cases := []struct {
+		name    string
+		from    string
+		to      string
+		want    []string
+		wantErr bool
+	}{
+		{name: "same version", from: "14", to: "14"},
+		{name: "next version", from: "15", to: "16", want: []string{"16"}},
+		{name: "multi-hop", from: "12", to: "16", want: []string{"13", "14", "15", "16"}},
+		{name: "1c edition", from: "13-1c", to: "15-1c", want: []string{"14-1c", "15-1c"}},
+		{name: "downgrade", from: "16", to: "14", wantErr: true},
+		{name: "edition change", from: "14", to: "15-1c", wantErr: true},
+		{name: "unknown versions", from: "16", to: "17", wantErr: true},
+		{name: "both unknown", from: "17", to: "18", want: []string{"18"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := mdbPGVersionUpgrade.path(tc.from, tc.to)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("path(%q, %q) error = %v, wantErr %v", tc.from, tc.to, err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("path(%q, %q) = %v, want %v", tc.from, tc.to, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMDBVersionUpgradeUnsupportedSettings(t *testing.T) {
+	settings := map[string]interface{}{
+		"max_connections":                   "100",
+		"vacuum_cleanup_index_scale_factor": "0.1",
+		"operator_precedence_warning":       "true",
+		"shared_preload_libraries":          "PG_HINT_PLAN",
+	}
+
+	if got := mdbPGVersionUpgrade.unsupportedSettings("12", settings); len(got) != 0 {
+		t.Errorf("unsupportedSettings(12) = %v, want none", got)
+	}
+
+	want := []string{"operator_precedence_warning", "vacuum_cleanup_index_scale_factor"}
+	if got := mdbPGVersionUpgrade.unsupportedSettings("16", settings); !reflect.DeepEqual(got, want) {
+		t.Errorf("unsupportedSettings(16) = %v, want %v", got, want)
+	}
+
+	want = []string{"query_cache_type"}
+	got := mdbMySQLVersionUpgrade.unsupportedSettings("8.0", map[string]interface{}{
+		"query_cache_type": "1",
+		"max_connections":  "100",
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unsupportedSettings(8.0) = %v, want %v", got, want)
+	}
+}
+
+func TestMDBVersionUpgradeRun(t *testing.T) {
+	d, err := schema.InternalMap(resourceYandexMDBPostgreSQLCluster().Schema).Data(
+		&terraform.InstanceState{
+			ID:         "cid",
+			Attributes: map[string]string{"config.#": "1", "config.0.version": "12"},
+		},
+		&terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{"config.0.version": {Old: "12", New: "15"}},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var steps []string
+	err = mdbPGVersionUpgrade.run(d, func(version string) error {
+		steps = append(steps, version)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []string{"13", "14", "15"}; !reflect.DeepEqual(steps, want) {
+		t.Errorf("upgrade steps = %v, want %v", steps, want)
+	}
+
+	steps = nil
+	err = mdbPGVersionUpgrade.run(d, func(version string) error {
+		if version == "14" {
+			return fmt.Errorf("extension is not supported")
+		}
+		steps = append(steps, version)
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "from version 13 to 14 (step 2 of 3) failed, the cluster remains at version 13") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if want := []string{"13"}; !reflect.DeepEqual(steps, want) {
+		t.Errorf("upgrade steps = %v, want %v", steps, want)
+	}
+}
+
+func testMDBVersionUpgradePGData(t *testing.T, attributes map[string]string) *schema.ResourceData {
+	state := map[string]string{
+		"config.#":                     "1",
+		"config.0.version":             "14",
+		"config.0.postgresql_config.#": "1",
+		"config.0.postgresql_config.0.operator_precedence_warning": "true",
+		"config.0.postgresql_config.0.max_connections":             "100",
+	}
+	for k, v := range attributes {
+		state[k] = v
+	}
+
+	d, err := schema.InternalMap(resourceYandexMDBPostgreSQLCluster().Schema).Data(
+		&terraform.InstanceState{ID: "cid", Attributes: state},
+		&terraform.InstanceDiff{
+			Attributes: map[string]*terraform.ResourceAttrDiff{"config.0.version": {Old: "14", New: "15"}},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestMDBVersionUpgradeCheckDroppedSettings(t *testing.T) {
+	err := mdbPGVersionUpgrade.checkDroppedSettings(testMDBVersionUpgradePGData(t, nil))
+	if err == nil || !strings.Contains(err.Error(), "settings operator_precedence_warning of PostgreSQL Cluster \"cid\" are not supported by version 15") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err = mdbPGVersionUpgrade.checkDroppedSettings(testMDBVersionUpgradePGData(t, map[string]string{"allow_dropping_settings": "true"}))
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestMDBVersionUpgradeWithWarningsOnError(t *testing.T) {
+	update := mdbPGVersionUpgrade.withWarnings(func(d *schema.ResourceData, meta interface{}) error {
+		return fmt.Errorf("upgrade failed")
+	})
+
+	diags := update(context.Background(), testMDBVersionUpgradePGData(t, nil), nil)
+	if len(diags) != 3 {
+		t.Fatalf("diagnostics = %v, want the upgrade steps and the dropped settings warnings and the error", diags)
+	}
+	for i, severity := range []diag.Severity{diag.Warning, diag.Warning, diag.Error} {
+		if diags[i].Severity != severity {
+			t.Errorf("diagnostic %d %q has severity %v, want %v", i, diags[i].Summary, diags[i].Severity, severity)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/version"
 )
@@ -131,6 +132,12 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 				Optional:    true,
 				Description: common.Descriptions["profile"],
 			},
+			"security_group_rules_check": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  common.Descriptions["security_group_rules_check"],
+				ValidateFunc: validation.StringInSlice(common.SecurityGroupRulesCheckModes, false),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -162,6 +169,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_snapshot_schedule":                        dataSourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                 dataSourceYandexDnsZoneRecords(),
 			"yandex_function":                                         dataSourceYandexFunction(),
 			"yandex_function_scaling_policy":                          dataSourceYandexFunctionScalingPolicy(),
 			"yandex_function_trigger":                                 dataSourceYandexFunctionTrigger(),
@@ -179,26 +187,38 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_loadtesting_agent":                                dataSourceYandexLoadtestingAgent(),
 			"yandex_lockbox_secret":                                   dataSourceYandexLockboxSecret(),
 			"yandex_lockbox_secret_version":                           dataSourceYandexLockboxSecretVersion(),
+			"yandex_lockbox_secret_versions":                          dataSourceYandexLockboxSecretVersions(),
 			"yandex_kms_symmetric_key":                                dataSourceYandexKMSSymmetricKey(),
+			"yandex_kms_symmetric_key_versions":                       dataSourceYandexKMSSymmetricKeyVersions(),
 			"yandex_kms_asymmetric_encryption_key":                    dataSourceYandexKMSAsymmetricEncryptionKey(),
 			"yandex_kms_asymmetric_signature_key":                     dataSourceYandexKMSAsymmetricSignatureKey(),
+			"yandex_kms_asymmetric_encryption_public_key":             dataSourceYandexKMSAsymmetricEncryptionPublicKey(),
+			"yandex_kms_asymmetric_signature_public_key":              dataSourceYandexKMSAsymmetricSignaturePublicKey(),
+			"yandex_kms_asymmetric_signature_verification":            dataSourceYandexKMSAsymmetricSignatureVerification(),
 			"yandex_logging_group":                                    dataSourceYandexLoggingGroup(),
+			"yandex_mdb_clickhouse_backups":                           dataSourceYandexMDBClickHouseBackups(),
 			"yandex_mdb_clickhouse_cluster":                           dataSourceYandexMDBClickHouseCluster(),
 			"yandex_mdb_elasticsearch_cluster":                        dataSourceYandexMDBElasticsearchCluster(),
+			"yandex_mdb_greenplum_backups":                            dataSourceYandexMDBGreenplumBackups(),
 			"yandex_mdb_greenplum_cluster":                            dataSourceYandexMDBGreenplumCluster(),
 			"yandex_mdb_kafka_cluster":                                dataSourceYandexMDBKafkaCluster(),
 			"yandex_mdb_kafka_topic":                                  dataSourceYandexMDBKafkaTopic(),
 			"yandex_mdb_kafka_connector":                              dataSourceYandexMDBKafkaConnector(),
 			"yandex_mdb_kafka_user":                                   dataSourceYandexMDBKafkaUser(),
+			"yandex_mdb_mongodb_backups":                              dataSourceYandexMDBMongodbBackups(),
 			"yandex_mdb_mongodb_cluster":                              dataSourceYandexMDBMongodbCluster(),
+			"yandex_mdb_mysql_backups":                                dataSourceYandexMDBMySQLBackups(),
 			"yandex_mdb_mysql_cluster":                                dataSourceYandexMDBMySQLCluster(),
 			"yandex_mdb_mysql_database":                               dataSourceYandexMDBMySQLDatabase(),
 			"yandex_mdb_mysql_user":                                   dataSourceYandexMDBMySQLUser(),
 			"yandex_mdb_opensearch_cluster":                           dataSourceYandexMDBOpenSearchCluster(),
+			"yandex_mdb_postgresql_backups":                           dataSourceYandexMDBPostgreSQLBackups(),
 			"yandex_mdb_postgresql_cluster":                           dataSourceYandexMDBPostgreSQLCluster(),
 			"yandex_mdb_postgresql_database":                          dataSourceYandexMDBPostgreSQLDatabase(),
 			"yandex_mdb_postgresql_user":                              dataSourceYandexMDBPostgreSQLUser(),
+			"yandex_mdb_redis_backups":                                dataSourceYandexMDBRedisBackups(),
 			"yandex_mdb_redis_cluster":                                dataSourceYandexMDBRedisCluster(),
+			"yandex_mdb_sqlserver_backups":                            dataSourceYandexMDBSQLServerBackups(),
 			"yandex_mdb_sqlserver_cluster":                            dataSourceYandexMDBSQLServerCluster(),
 			"yandex_monitoring_dashboard":                             dataSourceYandexMonitoringDashboard(),
 			"yandex_message_queue":                                    dataSourceYandexMessageQueue(),
@@ -211,8 +231,10 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_resourcemanager_folder":                           dataSourceYandexResourceManagerFolder(),
 			"yandex_serverless_container":                             dataSourceYandexServerlessContainer(),
 			"yandex_vpc_address":                                      dataSourceYandexVPCAddress(),
+			"yandex_vpc_free_cidrs":                                   dataSourceYandexVPCFreeCidrs(),
 			"yandex_vpc_gateway":                                      dataSourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      dataSourceYandexVPCNetwork(),
+			"yandex_vpc_private_endpoint":                             dataSourceYandexVPCPrivateEndpoint(),
 			"yandex_vpc_route_table":                                  dataSourceYandexVPCRouteTable(),
 			"yandex_vpc_security_group":                               dataSourceYandexVPCSecurityGroup(),
 			"yandex_vpc_security_group_rule":                          dataSourceYandexVPCSecurityGroupRule(),
@@ -257,6 +279,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_dns_zone_iam_binding":                             resourceYandexDnsZoneIAMBinding(),
 			"yandex_dns_recordset":                                    resourceYandexDnsRecordSet(),
 			"yandex_dns_zone":                                         resourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                 resourceYandexDnsZoneRecords(),
 			"yandex_function":                                         resourceYandexFunction(),
 			"yandex_function_iam_binding":                             resourceYandexFunctionIAMBinding(),
 			"yandex_function_scaling_policy":                          resourceYandexFunctionScalingPolicy(),
@@ -274,10 +297,12 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_kms_secret_ciphertext":                            resourceYandexKMSSecretCiphertext(),
 			"yandex_kms_symmetric_key":                                resourceYandexKMSSymmetricKey(),
 			"yandex_kms_symmetric_key_iam_binding":                    resourceYandexKMSSymmetricKeyIAMBinding(),
+			"yandex_kms_symmetric_key_rotation":                       resourceYandexKMSSymmetricKeyRotation(),
 			"yandex_kms_asymmetric_encryption_key":                    resourceYandexKMSAsymmetricEncryptionKey(),
 			"yandex_kms_asymmetric_encryption_key_iam_binding":        resourceYandexKMSAsymmetricEncryptionKeyIAMBinding(),
 			"yandex_kms_asymmetric_signature_key":                     resourceYandexKMSAsymmetricSignatureKey(),
 			"yandex_kms_asymmetric_signature_key_iam_binding":         resourceYandexKMSAsymmetricSignatureKeyIAMBinding(),
+			"yandex_kms_asymmetric_signature":                         resourceYandexKMSAsymmetricSignature(),
 			"yandex_kubernetes_cluster":                               resourceYandexKubernetesCluster(),
 			"yandex_kubernetes_node_group":                            resourceYandexKubernetesNodeGroup(),
 			"yandex_lb_network_load_balancer":                         resourceYandexLBNetworkLoadBalancer(),
@@ -293,17 +318,23 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_mdb_greenplum_cluster":                            resourceYandexMDBGreenplumCluster(),
 			"yandex_mdb_kafka_cluster":                                resourceYandexMDBKafkaCluster(),
 			"yandex_mdb_kafka_topic":                                  resourceYandexMDBKafkaTopic(),
+			"yandex_mdb_kafka_topics":                                 resourceYandexMDBKafkaTopics(),
 			"yandex_mdb_kafka_connector":                              resourceYandexMDBKafkaConnector(),
 			"yandex_mdb_kafka_user":                                   resourceYandexMDBKafkaUser(),
+			"yandex_mdb_kafka_user_permissions":                       resourceYandexMDBKafkaUserPermissions(),
+			"yandex_mdb_kafka_schema_registry_subject":                resourceYandexMDBKafkaSchemaRegistrySubject(),
 			"yandex_mdb_mongodb_cluster":                              resourceYandexMDBMongodbCluster(),
 			"yandex_mdb_mysql_cluster":                                resourceYandexMDBMySQLCluster(),
 			"yandex_mdb_mysql_database":                               resourceYandexMDBMySQLDatabase(),
+			"yandex_mdb_mysql_host":                                   resourceYandexMDBMySQLHost(),
 			"yandex_mdb_mysql_user":                                   resourceYandexMDBMySQLUser(),
 			"yandex_mdb_opensearch_cluster":                           resourceYandexMDBOpenSearchCluster(),
 			"yandex_mdb_postgresql_cluster":                           resourceYandexMDBPostgreSQLCluster(),
 			"yandex_mdb_postgresql_database":                          resourceYandexMDBPostgreSQLDatabase(),
+			"yandex_mdb_postgresql_host":                              resourceYandexMDBPostgreSQLHost(),
 			"yandex_mdb_postgresql_user":                              resourceYandexMDBPostgreSQLUser(),
 			"yandex_mdb_redis_cluster":                                resourceYandexMDBRedisCluster(),
+			"yandex_mdb_redis_host":                                   resourceYandexMDBRedisHost(),
 			"yandex_mdb_sqlserver_cluster":                            resourceYandexMDBSQLServerCluster(),
 			"yandex_message_queue":                                    resourceYandexMessageQueue(),
 			"yandex_monitoring_dashboard":                             resourceYandexMonitoringDashboard(),
@@ -328,9 +359,11 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_storage_bucket":                                   resourceYandexStorageBucket(),
 			"yandex_storage_object":                                   resourceYandexStorageObject(),
 			"yandex_vpc_address":                                      resourceYandexVPCAddress(),
+			"yandex_vpc_cidr_reservation":                             resourceYandexVPCCidrReservation(),
 			"yandex_vpc_default_security_group":                       resourceYandexVPCDefaultSecurityGroup(),
 			"yandex_vpc_gateway":                                      resourceYandexVPCGateway(),
 			"yandex_vpc_network":                                      resourceYandexVPCNetwork(),
+			"yandex_vpc_private_endpoint":                             resourceYandexVPCPrivateEndpoint(),
 			"yandex_vpc_route_table":                                  resourceYandexVPCRouteTable(),
 			"yandex_vpc_security_group":                               resourceYandexVPCSecurityGroup(),
 			"yandex_vpc_security_group_rule":                          resourceYandexVpcSecurityGroupRule(),
@@ -425,6 +458,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		YMQEndpoint:                    setToDefaultIfNeeded(d.Get("ymq_endpoint").(string), "YC_MESSAGE_QUEUE_ENDPOINT", common.DefaultYMQEndpoint),
 		YMQAccessKey:                   setToDefaultIfNeeded(d.Get("ymq_access_key").(string), "YC_MESSAGE_QUEUE_ACCESS_KEY", ""),
 		YMQSecretKey:                   setToDefaultIfNeeded(d.Get("ymq_secret_key").(string), "YC_MESSAGE_QUEUE_SECRET_KEY", ""),
+		SecurityGroupRulesCheck:        setToDefaultIfNeeded(d.Get("security_group_rules_check").(string), "YC_SECURITY_GROUP_RULES_CHECK", common.DefaultSecurityGroupRulesCheck),
 
 		Plaintext:             setToDefaultBoolIfNeeded("YC_PLAINTEXT", d.Get("plaintext").(bool)),
 		Insecure:              setToDefaultBoolIfNeeded("YC_INSECURE", d.Get("insecure").(bool)),
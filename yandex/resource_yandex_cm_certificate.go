@@ -20,6 +20,8 @@ import (
 
 const (
 	yandexCMCertificateDefaultTimeout = 1 * time.Minute
+	// the certificate is validated during creation when managed.dns_zone_id is set
+	yandexCMCertificateCreateTimeout = 30 * time.Minute
 )
 
 func resourceYandexCMCertificate() *schema.Resource {
@@ -33,7 +35,7 @@ func resourceYandexCMCertificate() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(yandexCMCertificateDefaultTimeout),
+			Create: schema.DefaultTimeout(yandexCMCertificateCreateTimeout),
 			Read:   schema.DefaultTimeout(yandexCMCertificateDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexCMCertificateDefaultTimeout),
 			Delete: schema.DefaultTimeout(yandexCMCertificateDefaultTimeout),
@@ -131,6 +133,11 @@ func resourceYandexCMCertificate() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
+						"dns_zone_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the DNS zone to create the challenge records in. If set, the certificate is created once it is issued, and the records are removed after that.",
+							Optional:    true,
+						},
 					},
 				},
 			},
@@ -177,6 +184,31 @@ func resourceYandexCMCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dns_challenge_records": {
+				Type:        schema.TypeList,
+				Description: "Challenge records created by the provider in `managed.dns_zone_id`, until the certificate is issued.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"challenges": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -450,6 +482,17 @@ func resourceYandexCMCertificateCreateManagedByLetsEncrypt(ctx context.Context,
 	log.Printf("[INFO] requested Certificate with ID: %s", d.Id())
 	d.Partial(true)
 	result := yandexCMCertificateRead(d.Id(), ctx, d, meta, false)
+	if result.HasError() {
+		return result
+	}
+
+	if _, ok := d.GetOk("managed.0.dns_zone_id"); ok {
+		// the challenge records are created by the read above
+		if err := waitCMCertificateValidation(ctx, config, d); err != nil {
+			return diag.Errorf("error while waiting for certificate validation: %s", err)
+		}
+		result = yandexCMCertificateRead(d.Id(), ctx, d, meta, false)
+	}
 	d.Partial(false)
 	return result
 }
@@ -474,6 +517,7 @@ func resourceYandexCMCertificateRead(ctx context.Context, d *schema.ResourceData
 func yandexCMCertificateRead(id string, ctx context.Context, d *schema.ResourceData, meta interface{}, fromDataSource bool) diag.Diagnostics {
 	config := meta.(*Config)
 
+	var cert *certificatemanager.Certificate
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		req := &certificatemanager.GetCertificateRequest{
 			CertificateId: id,
@@ -666,6 +710,7 @@ func yandexCMCertificateRead(id string, ctx context.Context, d *schema.ResourceD
 		}
 
 		d.SetId(resp.Id)
+		cert = resp
 
 		log.Printf("[INFO] read Certificate with ID: %s", d.Id())
 		return nil
@@ -673,6 +718,13 @@ func yandexCMCertificateRead(id string, ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(err)
 	}
+
+	if !fromDataSource {
+		// also creates the records for the renewal challenges
+		if err := syncCMCertificateDnsChallenges(ctx, config, d, cert); err != nil {
+			return diag.Errorf("error while updating DNS challenge records of certificate %q: %s", d.Id(), err)
+		}
+	}
 	return nil
 }
 
@@ -757,6 +809,14 @@ func resourceYandexCMCertificateDelete(ctx context.Context, d *schema.ResourceDa
 		CertificateId: d.Id(),
 	}
 
+	for zoneID, records := range expandCMCertificateDnsChallengeRecords(d.Get("dns_challenge_records").([]interface{})) {
+		for _, rs := range records {
+			if err := removeDnsRecordSetData(ctx, config, zoneID, rs); err != nil {
+				return diag.Errorf("error while removing DNS challenge records of certificate %q: %s", d.Id(), err)
+			}
+		}
+	}
+
 	log.Printf("[INFO] deleting certificate: %s", protojson.Format(req))
 
 	op, err := config.sdk.WrapOperation(config.sdk.Certificates().Certificate().Delete(ctx, req))
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -153,6 +154,24 @@ func TestAccCMCertificate_managed(t *testing.T) {
 	})
 }
 
+func TestAccCMCertificate_managedDnsZone(t *testing.T) {
+	certName := "crt" + acctest.RandString(10) + "-dns-zone"
+	zoneName := "zone" + acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexCMCertificateAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				// The test domain is not delegated to the zone, so the certificate is never issued,
+				// but the challenge records are created and the provider waits for the validation
+				Config:      testAccCMCertificateManagedDnsZone(certName, zoneName),
+				ExpectError: regexp.MustCompile("error while waiting for certificate validation"),
+			},
+		},
+	})
+}
+
 func TestAccCMCertificate_selfManaged(t *testing.T) {
 	certName := "crt" + acctest.RandString(10) + "-self-managed"
 	certDesc := "Terraform Test Self Managed Certificate"
@@ -262,6 +281,29 @@ resource "yandex_cm_certificate" "managed_certificate" {
 `, name, desc, CMCertificateTestDomainName)
 }
 
+func testAccCMCertificateManagedDnsZone(name, zoneName string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "cm_zone" {
+  name   = "%v"
+  zone   = "%v."
+  public = true
+}
+
+resource "yandex_cm_certificate" "managed_certificate" {
+  name    = "%v"
+  domains = ["%v"]
+  managed {
+    challenge_type  = "DNS_CNAME"
+    challenge_count = 1
+    dns_zone_id     = yandex_dns_zone.cm_zone.id
+  }
+  timeouts {
+    create = "2m"
+  }
+}
+`, zoneName, CMCertificateTestDomainName, name, CMCertificateTestDomainName)
+}
+
 func testAccCMCertificateSelfManaged(name, desc string) string {
 	return fmt.Sprintf(`
 resource "yandex_cm_certificate" "self_managed_certificate" {
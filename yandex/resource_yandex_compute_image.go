@@ -32,6 +32,8 @@ func resourceYandexComputeImage() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexComputeImageDefaultTimeout),
 		},
 
+		CustomizeDiff: computeImageSourceFileChanged,
+
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -91,7 +93,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image", "source_file"},
 			},
 
 			"source_image": {
@@ -99,7 +101,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_snapshot": {
@@ -107,7 +109,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_disk": {
@@ -115,7 +117,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family", "source_file"},
 			},
 
 			"source_url": {
@@ -123,9 +125,68 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family", "source_file"},
+			},
+
+			"source_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_url", "source_family"},
+				RequiredWith:  []string{"source_file_staging"},
+			},
+
+			"source_file_staging": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"source_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+
+						"access_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"secret_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+						},
+
+						"delete_after_create": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"source_file_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+				ForceNew: true,
 			},
 
+			"hardware_generation": computeImageHardwareGenerationSchema(),
+
 			"product_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -196,30 +257,48 @@ func resourceYandexComputeImageCreate(d *schema.ResourceData, meta interface{})
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Create(ctx, &req))
-	if err != nil {
-		return fmt.Errorf("Error while requesting API to create image: %s", err)
-	}
+	if hw := expandComputeImageHardwareGeneration(d); hw != nil {
+		imageID, err := createComputeImageWithHardwareGeneration(ctx, config, &req, hw)
+		if imageID != "" {
+			d.SetId(imageID)
+		}
+		if err != nil {
+			return fmt.Errorf("Error while creating image: %s", err)
+		}
+		if d.Id() == "" {
+			return fmt.Errorf("could not get Image ID from create operation metadata")
+		}
+	} else {
+		op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Create(ctx, &req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to create image: %s", err)
+		}
 
-	protoMetadata, err := op.Metadata()
-	if err != nil {
-		return fmt.Errorf("Error while get image create operation metadata: %s", err)
-	}
+		protoMetadata, err := op.Metadata()
+		if err != nil {
+			return fmt.Errorf("Error while get image create operation metadata: %s", err)
+		}
 
-	md, ok := protoMetadata.(*compute.CreateImageMetadata)
-	if !ok {
-		return fmt.Errorf("could not get Image ID from create operation metadata")
-	}
+		md, ok := protoMetadata.(*compute.CreateImageMetadata)
+		if !ok {
+			return fmt.Errorf("could not get Image ID from create operation metadata")
+		}
 
-	d.SetId(md.ImageId)
+		d.SetId(md.ImageId)
 
-	err = op.Wait(ctx)
-	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create image: %s", err)
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error while waiting operation to create image: %s", err)
+		}
+
+		if _, err := op.Response(); err != nil {
+			return fmt.Errorf("Image creation failed: %s", err)
+		}
 	}
 
-	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("Image creation failed: %s", err)
+	// The image is created at this point, failing to delete the staging object must not taint it.
+	if err := cleanupComputeImageSourceFile(ctx, d, config); err != nil {
+		log.Printf("[WARN] Error while deleting staging object of image %q: %s", d.Id(), err)
 	}
 
 	return resourceYandexComputeImageRead(d, meta)
@@ -250,6 +329,14 @@ func resourceYandexComputeImageRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// the hardware generation is read with a separate call, which must not prevent images from refreshing
+	hw, err := readComputeImageHardwareGeneration(config.Context(), config, d.Id())
+	if err != nil {
+		log.Printf("[WARN] Error while reading hardware generation of image %q, keeping the previous value: %s", d.Id(), err)
+	} else if err := d.Set("hardware_generation", flattenComputeImageHardwareGeneration(hw)); err != nil {
+		return err
+	}
+
 	return d.Set("product_ids", image.ProductIds)
 }
 
@@ -366,7 +453,7 @@ func resourceYandexComputeImageDelete(d *schema.ResourceData, meta interface{})
 }
 
 func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceData, meta interface{}) error {
-	sourceAttrs := []string{"source_family", "source_disk", "source_image", "source_snapshot", "source_url"}
+	sourceAttrs := []string{"source_family", "source_disk", "source_image", "source_snapshot", "source_url", "source_file"}
 	var selectedSourceAttr string
 	var selectedSourceValue string
 
@@ -413,6 +500,15 @@ func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceDa
 		req.Source = &compute.CreateImageRequest_Uri{
 			Uri: selectedSourceValue,
 		}
+	case "source_file":
+		config := meta.(*Config)
+		uri, err := uploadComputeImageSourceFile(config.Context(), d, config)
+		if err != nil {
+			return fmt.Errorf("failed to upload image file %q: %s", selectedSourceValue, err)
+		}
+		req.Source = &compute.CreateImageRequest_Uri{
+			Uri: uri,
+		}
 	default:
 		// should not occur: validation must be done at Schema level
 		return fmt.Errorf("selected source attr %s not one from %s", selectedSourceAttr, sourceAttrs)
@@ -0,0 +1,145 @@
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Hardware generation of images is not available in the pinned go-genproto version yet, images with
+// hardware_generation are created and read through the REST API of Compute, see rest_api.go.
+const yandexComputeImagesPath = "/compute/v1/images"
+
+var computeImagePCITopologies = []string{"PCI_TOPOLOGY_V1", "PCI_TOPOLOGY_V2"}
+
+type computeImageHardwareGeneration struct {
+	LegacyFeatures *struct {
+		PCITopology string `json:"pciTopology,omitempty"`
+	} `json:"legacyFeatures,omitempty"`
+	Generation2Features *struct{} `json:"generation2Features,omitempty"`
+}
+
+type computeImageWithHardwareGeneration struct {
+	HardwareGeneration *computeImageHardwareGeneration `json:"hardwareGeneration,omitempty"`
+}
+
+func computeImageHardwareGenerationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"legacy_features": {
+					Type:          schema.TypeList,
+					Optional:      true,
+					Computed:      true,
+					ForceNew:      true,
+					MaxItems:      1,
+					ConflictsWith: []string{"hardware_generation.0.generation2_features"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"pci_topology": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(computeImagePCITopologies, false),
+							},
+						},
+					},
+				},
+
+				"generation2_features": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem:     &schema.Resource{Schema: map[string]*schema.Schema{}},
+				},
+			},
+		},
+	}
+}
+
+// expandComputeImageHardwareGeneration returns the hardware generation set in the configuration, or nil
+// if the image should get the default one. The configuration is used, since the attribute is computed.
+func expandComputeImageHardwareGeneration(d *schema.ResourceData) *computeImageHardwareGeneration {
+	hws := d.GetRawConfig().GetAttr("hardware_generation")
+	if hws.IsNull() || len(hws.AsValueSlice()) == 0 {
+		return nil
+	}
+
+	hw := &computeImageHardwareGeneration{}
+	if gen2, ok := hws.AsValueSlice()[0].AsValueMap()["generation2_features"]; ok && !gen2.IsNull() && len(gen2.AsValueSlice()) > 0 {
+		hw.Generation2Features = &struct{}{}
+		return hw
+	}
+
+	hw.LegacyFeatures = &struct {
+		PCITopology string `json:"pciTopology,omitempty"`
+	}{
+		PCITopology: d.Get("hardware_generation.0.legacy_features.0.pci_topology").(string),
+	}
+	return hw
+}
+
+func flattenComputeImageHardwareGeneration(hw *computeImageHardwareGeneration) []interface{} {
+	if hw == nil {
+		return nil
+	}
+
+	res := map[string]interface{}{
+		"legacy_features":      []interface{}{},
+		"generation2_features": []interface{}{},
+	}
+	if hw.LegacyFeatures != nil {
+		res["legacy_features"] = []interface{}{
+			map[string]interface{}{"pci_topology": hw.LegacyFeatures.PCITopology},
+		}
+	}
+	if hw.Generation2Features != nil {
+		res["generation2_features"] = []interface{}{map[string]interface{}{}}
+	}
+	return []interface{}{res}
+}
+
+// createComputeImageWithHardwareGeneration creates the image the same way as the gRPC API would, adding
+// the hardware generation to the request. The ID of the image is returned even if the creation failed.
+func createComputeImageWithHardwareGeneration(ctx context.Context, config *Config, req *compute.CreateImageRequest, hw *computeImageHardwareGeneration) (string, error) {
+	data, err := protojson.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("error encoding image create request: %s", err)
+	}
+	body := map[string]interface{}{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return "", fmt.Errorf("error encoding image create request: %s", err)
+	}
+	body["hardwareGeneration"] = hw
+
+	op, err := doRestAPIOperation(ctx, config, ycsdk.ComputeServiceID, http.MethodPost, yandexComputeImagesPath, body)
+	if op == nil {
+		return "", err
+	}
+	return op.metadataString("imageId"), err
+}
+
+func readComputeImageHardwareGeneration(ctx context.Context, config *Config, imageID string) (*computeImageHardwareGeneration, error) {
+	image := &computeImageWithHardwareGeneration{}
+	err := doRestAPIRequest(ctx, config, ycsdk.ComputeServiceID, http.MethodGet, yandexComputeImagesPath+"/"+url.PathEscape(imageID), nil, nil, image)
+	if err != nil {
+		return nil, err
+	}
+	return image.HardwareGeneration, nil
+}
@@ -0,0 +1,63 @@
+package yandex
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestComputeImageHardwareGenerationJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		json     string
+		expected []interface{}
+	}{
+		{
+			name: "legacy",
+			json: `{"hardwareGeneration":{"legacyFeatures":{"pciTopology":"PCI_TOPOLOGY_V2"}}}`,
+			expected: []interface{}{
+				map[string]interface{}{
+					"legacy_features": []interface{}{
+						map[string]interface{}{"pci_topology": "PCI_TOPOLOGY_V2"},
+					},
+					"generation2_features": []interface{}{},
+				},
+			},
+		},
+		{
+			name: "generation2",
+			json: `{"hardwareGeneration":{"generation2Features":{}}}`,
+			expected: []interface{}{
+				map[string]interface{}{
+					"legacy_features":      []interface{}{},
+					"generation2_features": []interface{}{map[string]interface{}{}},
+				},
+			},
+		},
+		{
+			name: "absent",
+			json: `{}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			image := &computeImageWithHardwareGeneration{}
+			if err := json.Unmarshal([]byte(tc.json), image); err != nil {
+				t.Fatal(err)
+			}
+			if got := flattenComputeImageHardwareGeneration(image.HardwareGeneration); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("flattenComputeImageHardwareGeneration() = %#v, want %#v", got, tc.expected)
+			}
+
+			// the request body must round-trip to the same JSON
+			data, err := json.Marshal(image)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != tc.json {
+				t.Errorf("json.Marshal() = %s, want %s", data, tc.json)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package yandex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	computeImageSourceFilePartSize    = 64 * 1024 * 1024
+	computeImageSourceFileURLLifetime = 12 * time.Hour
+	computeImageSourceFileHashMetaKey = "Sha256"
+)
+
+// computeImageSourceFileChanged recalculates the checksum of `source_file` on every plan,
+// so that replacing the local file contents without renaming it recreates the image.
+func computeImageSourceFileChanged(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	source, ok := d.GetOk("source_file")
+	if !ok {
+		return nil
+	}
+
+	sum, err := computeImageSourceFileSHA256(source.(string))
+	if err != nil {
+		if d.Id() == "" {
+			return err
+		}
+		// The file may legitimately be gone once the image has been created.
+		log.Printf("[WARN] Skipping checksum check of image %q source file: %s", d.Id(), err)
+		return nil
+	}
+
+	if d.Get("source_file_sha256").(string) == sum {
+		return nil
+	}
+
+	if err := d.SetNew("source_file_sha256", sum); err != nil {
+		return err
+	}
+	if d.Id() != "" {
+		return d.ForceNew("source_file_sha256")
+	}
+	return nil
+}
+
+func computeImageSourceFileSHA256(source string) (string, error) {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return "", fmt.Errorf("error expanding homedir in source_file (%s): %s", source, err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("error opening image source file (%s): %s", path, err)
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", fmt.Errorf("error reading image source file (%s): %s", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// computeImageSourceFileKey returns the staging object key: the explicit one, if any,
+// or a content-addressed key, so that identical files are uploaded only once.
+func computeImageSourceFileKey(key, source, sum string) string {
+	if key != "" {
+		return key
+	}
+	return fmt.Sprintf("%s/%s", sum, filepath.Base(source))
+}
+
+func getComputeImageSourceFileStaging(d *schema.ResourceData) map[string]interface{} {
+	staging := d.Get("source_file_staging").([]interface{})
+	if len(staging) == 0 || staging[0] == nil {
+		return map[string]interface{}{}
+	}
+	return staging[0].(map[string]interface{})
+}
+
+func getComputeImageSourceFileS3Client(ctx context.Context, staging map[string]interface{}, config *Config) (*s3.S3, error) {
+	accessKey, _ := staging["access_key"].(string)
+	secretKey, _ := staging["secret_key"].(string)
+	if (accessKey == "") != (secretKey == "") {
+		return nil, errNoAccessOrSecretKey
+	}
+
+	return getS3ClientByKeys(ctx, accessKey, secretKey, config)
+}
+
+// uploadComputeImageSourceFile uploads `source_file` to the staging bucket using multipart upload
+// and returns a presigned URL the Compute API can fetch the image from.
+func uploadComputeImageSourceFile(ctx context.Context, d *schema.ResourceData, config *Config) (string, error) {
+	source := d.Get("source_file").(string)
+	staging := getComputeImageSourceFileStaging(d)
+
+	s3conn, err := getComputeImageSourceFileS3Client(ctx, staging, config)
+	if err != nil {
+		return "", fmt.Errorf("error getting storage client: %s", err)
+	}
+
+	sum, err := computeImageSourceFileSHA256(source)
+	if err != nil {
+		return "", err
+	}
+
+	bucket := staging["bucket"].(string)
+	key := computeImageSourceFileKey(staging["key"].(string), source, sum)
+
+	uploaded, err := isComputeImageSourceFileUploaded(ctx, s3conn, bucket, key, sum)
+	if err != nil {
+		return "", err
+	}
+
+	if uploaded {
+		log.Printf("[DEBUG] Image source file %q is already uploaded to %s/%s", source, bucket, key)
+	} else {
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return "", fmt.Errorf("error expanding homedir in source_file (%s): %s", source, err)
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("error opening image source file (%s): %s", path, err)
+		}
+		defer file.Close()
+
+		log.Printf("[DEBUG] Uploading image source file %q to %s/%s", source, bucket, key)
+
+		uploader := s3manager.NewUploaderWithClient(s3conn, func(u *s3manager.Uploader) {
+			u.PartSize = computeImageSourceFilePartSize
+		})
+		_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+			Bucket:   aws.String(bucket),
+			Key:      aws.String(key),
+			Body:     file,
+			Metadata: map[string]*string{computeImageSourceFileHashMetaKey: aws.String(sum)},
+		})
+		if err != nil {
+			return "", fmt.Errorf("error uploading object %q to bucket %q: %s", key, bucket, err)
+		}
+	}
+
+	staging["key"] = key
+	if err := d.Set("source_file_staging", []interface{}{staging}); err != nil {
+		return "", err
+	}
+	if err := d.Set("source_file_sha256", sum); err != nil {
+		return "", err
+	}
+
+	getReq, _ := s3conn.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	uri, err := getReq.Presign(computeImageSourceFileURLLifetime)
+	if err != nil {
+		return "", fmt.Errorf("error presigning URL for object %q in bucket %q: %s", key, bucket, err)
+	}
+
+	return uri, nil
+}
+
+func isComputeImageSourceFileUploaded(ctx context.Context, s3conn *s3.S3, bucket, key, sum string) (bool, error) {
+	resp, err := s3conn.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.RequestFailure); ok && awsErr.StatusCode() == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("error reading object %q in bucket %q: %s", key, bucket, err)
+	}
+
+	for k, v := range resp.Metadata {
+		if strings.EqualFold(k, computeImageSourceFileHashMetaKey) {
+			return aws.StringValue(v) == sum, nil
+		}
+	}
+
+	return false, nil
+}
+
+func cleanupComputeImageSourceFile(ctx context.Context, d *schema.ResourceData, config *Config) error {
+	if _, ok := d.GetOk("source_file"); !ok {
+		return nil
+	}
+
+	staging := getComputeImageSourceFileStaging(d)
+	if !staging["delete_after_create"].(bool) {
+		return nil
+	}
+
+	s3conn, err := getComputeImageSourceFileS3Client(ctx, staging, config)
+	if err != nil {
+		return fmt.Errorf("error getting storage client: %s", err)
+	}
+
+	bucket := staging["bucket"].(string)
+	key := staging["key"].(string)
+
+	log.Printf("[DEBUG] Deleting image staging object %s/%s", bucket, key)
+
+	_, err = s3conn.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting object %q in bucket %q: %s", key, bucket, err)
+	}
+
+	return nil
+}
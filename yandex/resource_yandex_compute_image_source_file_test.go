@@ -0,0 +1,60 @@
+package yandex
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestComputeImageSourceFileSHA256(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "image.qcow2")
+	if err := os.WriteFile(path, []byte("qcow2 image"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	sum, err := computeImageSourceFileSHA256(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "60280030141de85f76f1df9e6560d62f16fa9f02ca4b8b4868e390486a3088b8"
+	if sum != expected {
+		t.Fatalf("computeImageSourceFileSHA256() = %q, want %q", sum, expected)
+	}
+
+	if _, err := computeImageSourceFileSHA256(filepath.Join(t.TempDir(), "missing.vmdk")); err == nil {
+		t.Fatal("expected error for missing file")
+	}
+}
+
+func TestComputeImageSourceFileKey(t *testing.T) {
+	cases := []struct {
+		name     string
+		key      string
+		source   string
+		sum      string
+		expected string
+	}{
+		{
+			name:     "explicit key",
+			key:      "images/custom.qcow2",
+			source:   "/tmp/build/image.qcow2",
+			sum:      "abc",
+			expected: "images/custom.qcow2",
+		},
+		{
+			name:     "content addressed key",
+			source:   "/tmp/build/image.qcow2",
+			sum:      "abc",
+			expected: "abc/image.qcow2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := computeImageSourceFileKey(tc.key, tc.source, tc.sum); got != tc.expected {
+				t.Errorf("computeImageSourceFileKey() = %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
 )
 
 const (
-	yandexComputeInstanceGroupDefaultTimeout = 30 * time.Minute
+	yandexComputeInstanceGroupDefaultTimeout      = 30 * time.Minute
+	yandexComputeInstanceGroupRolloutPollInterval = 15 * time.Second
 )
 
 func resourceYandexComputeInstanceGroup() *schema.Resource {
@@ -33,6 +35,8 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexComputeInstanceGroupDefaultTimeout),
 		},
 
+		CustomizeDiff: instanceGroupAppliedTemplateChanged,
+
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -741,6 +745,39 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 				},
 			},
 
+			"rollout_policy": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"wait_for_healthy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"pause_on_failure": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Default:       false,
+							ConflictsWith: []string{"rollout_policy.0.rollback_on_failure"},
+						},
+						"rollback_on_failure": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Default:       false,
+							ConflictsWith: []string{"rollout_policy.0.pause_on_failure"},
+						},
+					},
+				},
+			},
+
+			"applied_instance_template": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
 			"allocation_policy": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -1101,6 +1138,14 @@ func resourceYandexComputeInstanceGroupCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(instanceGroup.Id)
 
+	if err := waitInstanceGroupRollout(ctx, d, config, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if err := setInstanceGroupAppliedTemplate(d, req.InstanceTemplate); err != nil {
+		return err
+	}
+
 	return resourceYandexComputeInstanceGroupRead(d, meta)
 }
 
@@ -1119,15 +1164,19 @@ func resourceYandexComputeInstanceGroupRead(d *schema.ResourceData, meta interfa
 		return handleNotFoundError(err, d, fmt.Sprintf("Instance group %q", d.Id()))
 	}
 
-	instances, err := config.sdk.InstanceGroup().InstanceGroup().ListInstances(ctx, &instancegroup.ListInstanceGroupInstancesRequest{
-		InstanceGroupId: d.Id(),
-	})
-
+	instances, err := listInstanceGroupInstances(ctx, d.Id(), config)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Can't read instances for instance group with ID %q", d.Id()))
 	}
 
-	return flattenInstanceGroup(d, instanceGroup, instances.GetInstances())
+	return flattenInstanceGroup(d, instanceGroup, instances)
+}
+
+func listInstanceGroupInstances(ctx context.Context, id string, config *Config) ([]*instancegroup.ManagedInstance, error) {
+	it := config.sdk.InstanceGroup().InstanceGroup().InstanceGroupInstancesIterator(ctx, &instancegroup.ListInstanceGroupInstancesRequest{
+		InstanceGroupId: id,
+	})
+	return it.TakeAll()
 }
 
 func flattenInstanceGroup(d *schema.ResourceData, instanceGroup *instancegroup.InstanceGroup, instances []*instancegroup.ManagedInstance) error {
@@ -1229,11 +1278,30 @@ func resourceYandexComputeInstanceGroupUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	err = makeInstanceGroupUpdateRequest(req, d, meta)
+	previousTemplate, err := getInstanceGroupPreviousTemplate(d)
+	if err != nil {
+		return err
+	}
+
+	// the rollout is waited for within the same timeout as the update itself
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	err = makeInstanceGroupUpdateRequest(ctx, req, d, config)
 	if err != nil {
 		return err
 	}
 
+	if err := waitInstanceGroupRollout(ctx, d, config, previousTemplate, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		// keep the previous configuration in state, so the failed rollout is retried on the next apply
+		d.Partial(true)
+		return err
+	}
+
+	if err := setInstanceGroupAppliedTemplate(d, req.InstanceTemplate); err != nil {
+		return err
+	}
+
 	return resourceYandexComputeInstanceGroupRead(d, meta)
 }
 
@@ -1447,12 +1515,7 @@ func getStaticUpdatePath() []string {
 	}
 }
 
-func makeInstanceGroupUpdateRequest(req *instancegroup.UpdateInstanceGroupRequest, d *schema.ResourceData, meta interface{}) error {
-	config := meta.(*Config)
-
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
+func makeInstanceGroupUpdateRequest(ctx context.Context, req *instancegroup.UpdateInstanceGroupRequest, d *schema.ResourceData, config *Config) error {
 	op, err := config.sdk.WrapOperation(config.sdk.InstanceGroup().InstanceGroup().Update(ctx, req))
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to update Instance group %q: %s", d.Id(), err)
@@ -1465,3 +1528,155 @@ func makeInstanceGroupUpdateRequest(req *instancegroup.UpdateInstanceGroupReques
 
 	return nil
 }
+
+// getInstanceGroupPreviousTemplate returns the instance template last applied by Terraform. It's kept in state
+// rather than read from the API, so that changes made outside of Terraform don't become the rollback target.
+func getInstanceGroupPreviousTemplate(d *schema.ResourceData) (*instancegroup.InstanceTemplate, error) {
+	policy := expandInstanceGroupRolloutPolicy(d)
+	if !policy.waitForHealthy || !policy.rollbackOnFailure || !d.HasChange("instance_template") {
+		return nil, nil
+	}
+
+	applied, _ := d.GetChange("applied_instance_template")
+	if applied.(string) == "" {
+		log.Printf("[WARN] Instance group %q has no instance template applied by Terraform, rollback is not possible", d.Id())
+		return nil, nil
+	}
+
+	template := &instancegroup.InstanceTemplate{}
+	if err := protojson.Unmarshal([]byte(applied.(string)), template); err != nil {
+		return nil, fmt.Errorf("Error while decoding applied instance template of Instance group %q: %s", d.Id(), err)
+	}
+	return template, nil
+}
+
+// setInstanceGroupAppliedTemplate keeps the applied instance template in state, as long as it may be needed for a rollback.
+func setInstanceGroupAppliedTemplate(d *schema.ResourceData, template *instancegroup.InstanceTemplate) error {
+	// only the changes planned by instanceGroupAppliedTemplateChanged are made
+	if !d.IsNewResource() && !d.HasChange("applied_instance_template") && d.Get("applied_instance_template").(string) != "" {
+		return nil
+	}
+
+	policy := expandInstanceGroupRolloutPolicy(d)
+	if !policy.waitForHealthy || !policy.rollbackOnFailure || template == nil {
+		return d.Set("applied_instance_template", "")
+	}
+
+	data, err := protojson.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("Error while encoding applied instance template of Instance group %q: %s", d.Id(), err)
+	}
+	return d.Set("applied_instance_template", string(data))
+}
+
+// instanceGroupAppliedTemplateChanged plans the change of the applied instance template made by setInstanceGroupAppliedTemplate.
+func instanceGroupAppliedTemplateChanged(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rollback := d.Get("rollout_policy.0.wait_for_healthy").(bool) && d.Get("rollout_policy.0.rollback_on_failure").(bool)
+	switch {
+	case !rollback && d.Get("applied_instance_template").(string) != "":
+		return d.SetNew("applied_instance_template", "")
+	case rollback && (d.HasChange("instance_template") || d.Get("applied_instance_template").(string) == ""):
+		return d.SetNewComputed("applied_instance_template")
+	}
+	return nil
+}
+
+// waitInstanceGroupRollout waits for the instances to become healthy and handles a failed rollout according to
+// the rollout policy. The rollback or pause gets a timeout of its own, as the rollout may fail by running out of ctx.
+func waitInstanceGroupRollout(ctx context.Context, d *schema.ResourceData, config *Config, previousTemplate *instancegroup.InstanceTemplate, failureTimeout time.Duration) error {
+	policy := expandInstanceGroupRolloutPolicy(d)
+	if !policy.waitForHealthy {
+		return nil
+	}
+
+	var maxHealthWait time.Duration
+	if v := d.Get("max_checking_health_duration").(int); v > 0 {
+		maxHealthWait = time.Duration(v+d.Get("deploy_policy.0.startup_duration").(int)) * time.Second
+	}
+
+	rolloutErr := waitInstanceGroupHealthy(ctx, d.Id(), maxHealthWait, config)
+	if rolloutErr == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), failureTimeout)
+	defer cancel()
+
+	switch {
+	case policy.rollbackOnFailure && previousTemplate != nil:
+		log.Printf("[DEBUG] Rolling back instance template of Instance group %q", d.Id())
+
+		if err := rollbackInstanceGroupTemplate(ctx, d.Id(), previousTemplate, config); err != nil {
+			return fmt.Errorf("Rollout of Instance group %q failed: %s\nRollback failed: %s", d.Id(), rolloutErr, err)
+		}
+		if err := waitInstanceGroupHealthy(ctx, d.Id(), maxHealthWait, config); err != nil {
+			return fmt.Errorf("Rollout of Instance group %q failed: %s\nPrevious instance template was restored, but instances are not healthy: %s", d.Id(), rolloutErr, err)
+		}
+		return fmt.Errorf("Rollout of Instance group %q failed: %s\nPrevious instance template was restored", d.Id(), rolloutErr)
+	case policy.pauseOnFailure:
+		log.Printf("[DEBUG] Pausing processes of Instance group %q", d.Id())
+
+		op, err := config.sdk.WrapOperation(config.sdk.InstanceGroup().InstanceGroup().PauseProcesses(ctx, &instancegroup.PauseInstanceGroupProcessesRequest{
+			InstanceGroupId: d.Id(),
+		}))
+		if err == nil {
+			err = op.Wait(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("Rollout of Instance group %q failed: %s\nPausing processes failed: %s", d.Id(), rolloutErr, err)
+		}
+		return fmt.Errorf("Rollout of Instance group %q failed: %s\nInstance group processes were paused", d.Id(), rolloutErr)
+	}
+
+	return fmt.Errorf("Rollout of Instance group %q failed: %s", d.Id(), rolloutErr)
+}
+
+func waitInstanceGroupHealthy(ctx context.Context, id string, maxHealthWait time.Duration, config *Config) error {
+	for {
+		instanceGroup, err := config.sdk.InstanceGroup().InstanceGroup().Get(ctx, &instancegroup.GetInstanceGroupRequest{
+			InstanceGroupId: id,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while reading Instance group %q: %s", id, err)
+		}
+
+		instances, err := listInstanceGroupInstances(ctx, id, config)
+		if err != nil {
+			return fmt.Errorf("Error while reading instances of Instance group %q: %s", id, err)
+		}
+
+		done, err := checkInstanceGroupRollout(instanceGroup.GetManagedInstancesState(), instances, maxHealthWait, time.Now())
+		if err != nil || done {
+			return err
+		}
+
+		log.Printf("[DEBUG] Waiting for instances of Instance group %q to become healthy:\n%s", id,
+			describeInstanceGroupManagedInstances(instances))
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for instances to become healthy, instances status:\n%s",
+				describeInstanceGroupManagedInstances(instances))
+		case <-time.After(yandexComputeInstanceGroupRolloutPollInterval):
+		}
+	}
+}
+
+func rollbackInstanceGroupTemplate(ctx context.Context, id string, template *instancegroup.InstanceTemplate, config *Config) error {
+	req := &instancegroup.UpdateInstanceGroupRequest{
+		InstanceGroupId:  id,
+		InstanceTemplate: template,
+		UpdateMask:       &field_mask.FieldMask{Paths: []string{"instance_template"}},
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.InstanceGroup().InstanceGroup().Update(ctx, req))
+	if err != nil {
+		return err
+	}
+
+	return op.Wait(ctx)
+}
@@ -187,6 +187,46 @@ func TestAccComputeInstanceGroup_basic(t *testing.T) {
 
 }
 
+func TestAccComputeInstanceGroup_RolloutPolicy(t *testing.T) {
+	t.Parallel()
+
+	var ig instancegroup.InstanceGroup
+
+	name := acctest.RandomWithPrefix("tf-test")
+	saName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceGroupConfigRolloutPolicy(name, saName, "template_description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceGroupExists("yandex_compute_instance_group.group1", &ig),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.0.status", "RUNNING_ACTUAL"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.1.status", "RUNNING_ACTUAL"),
+				),
+			},
+			{
+				Config: testAccComputeInstanceGroupConfigRolloutPolicy(name, saName, "template_description_updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceGroupExists("yandex_compute_instance_group.group1", &ig),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instance_template.0.description", "template_description_updated"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.0.status", "RUNNING_ACTUAL"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.1.status", "RUNNING_ACTUAL"),
+				),
+			},
+			{
+				ResourceName:            "yandex_compute_instance_group.group1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rollout_policy", "applied_instance_template"},
+			},
+		},
+	})
+}
+
 func TestAccComputeInstanceGroup_Gpus(t *testing.T) {
 	var ig instancegroup.InstanceGroup
 
@@ -754,6 +794,97 @@ resource "yandex_resourcemanager_folder_iam_member" "test_account" {
 `, getExampleFolderID(), igName, saName)
 }
 
+func testAccComputeInstanceGroupConfigRolloutPolicy(igName string, saName string, description string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1604-lts"
+}
+
+data "yandex_resourcemanager_folder" "test_folder" {
+  folder_id = "%[1]s"
+}
+
+resource "yandex_compute_instance_group" "group1" {
+  depends_on         = ["yandex_iam_service_account.test_account", "yandex_resourcemanager_folder_iam_member.test_account"]
+  name               = "%[2]s"
+  folder_id          = "${data.yandex_resourcemanager_folder.test_folder.id}"
+  service_account_id = "${yandex_iam_service_account.test_account.id}"
+  instance_template {
+    platform_id = "standard-v2"
+    description = "%[4]s"
+
+    resources {
+      memory = 2
+      cores  = 2
+    }
+
+    boot_disk {
+      initialize_params {
+        image_id = "${data.yandex_compute_image.ubuntu.id}"
+        size     = 4
+      }
+    }
+
+    network_interface {
+      network_id = "${yandex_vpc_network.inst-group-test-network.id}"
+      subnet_ids = ["${yandex_vpc_subnet.inst-group-test-subnet.id}"]
+    }
+  }
+
+  scale_policy {
+    fixed_scale {
+      size = 2
+    }
+  }
+
+  allocation_policy {
+    zones = ["ru-central1-a"]
+  }
+
+  deploy_policy {
+    max_unavailable = 1
+    max_expansion   = 0
+  }
+
+  health_check {
+    tcp_options {
+      port = 22
+    }
+  }
+
+  max_checking_health_duration = 300
+
+  rollout_policy {
+    wait_for_healthy    = true
+    rollback_on_failure = true
+  }
+}
+
+resource "yandex_vpc_network" "inst-group-test-network" {
+  description = "tf-test"
+}
+
+resource "yandex_vpc_subnet" "inst-group-test-subnet" {
+  description    = "tf-test"
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-group-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+
+resource "yandex_iam_service_account" "test_account" {
+  name        = "%[3]s"
+  description = "tf-test"
+}
+
+resource "yandex_resourcemanager_folder_iam_member" "test_account" {
+  folder_id   = "${data.yandex_resourcemanager_folder.test_folder.id}"
+  member      = "serviceAccount:${yandex_iam_service_account.test_account.id}"
+  role        = "editor"
+  sleep_after = 30
+}
+`, getExampleFolderID(), igName, saName, description)
+}
+
 func testAccComputeInstanceGroupConfigDeletionProtection(igName string, saName string, deletionProtection bool) string {
 	return fmt.Sprintf(`
 data "yandex_compute_image" "ubuntu" {
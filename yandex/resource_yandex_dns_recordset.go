@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+	"google.golang.org/grpc/codes"
 )
 
 func resourceYandexDnsRecordSet() *schema.Resource {
@@ -73,7 +75,6 @@ func resourceYandexDnsRecordSet() *schema.Resource {
 
 func resourceYandexDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	sdk := getSDK(config)
 
 	rs := &dns.RecordSet{
 		Name: d.Get("name").(string),
@@ -90,17 +91,7 @@ func resourceYandexDnsRecordSetCreate(d *schema.ResourceData, meta interface{})
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
-	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, &req))
-	if err != nil {
-		return fmt.Errorf("Error while requesting API to create DnsRecordSet: %s", err)
-	}
-
-	err = op.Wait(ctx)
-	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create DnsRecordSet: %s", err)
-	}
-
-	if _, err := op.Response(); err != nil {
+	if err := updateDnsRecordSets(ctx, config, &req); err != nil {
 		return fmt.Errorf("DnsRecordSet creation failed: %s", err)
 	}
 
@@ -147,7 +138,6 @@ func resourceYandexDnsRecordSetUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceYandexDnsRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	sdk := getSDK(config)
 
 	rs := &dns.RecordSet{
 		Name: d.Get("name").(string),
@@ -164,18 +154,8 @@ func resourceYandexDnsRecordSetDelete(d *schema.ResourceData, meta interface{})
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
-	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, &req))
-	if err != nil {
-		return fmt.Errorf("Error while requesting API to create DnsRecordSet: %s", err)
-	}
-
-	err = op.Wait(ctx)
-	if err != nil {
-		return fmt.Errorf("Error while waiting operation to create DnsRecordSet: %s", err)
-	}
-
-	if _, err := op.Response(); err != nil {
-		return fmt.Errorf("DnsRecordSet creation failed: %s", err)
+	if err := updateDnsRecordSets(ctx, config, &req); err != nil {
+		return fmt.Errorf("DnsRecordSet deletion failed: %s", err)
 	}
 
 	log.Printf("[DEBUG] Finished deleting DnsRecordSet %s", rsId(d))
@@ -233,6 +213,97 @@ func makeDnsRecordSetUpdateRequest(req *dns.UpdateRecordSetsRequest, d *schema.R
 	return nil
 }
 
+// updateDnsRecordSets applies the deletions and additions of the request and waits for them.
+func updateDnsRecordSets(ctx context.Context, config *Config, req *dns.UpdateRecordSetsRequest) error {
+	sdk := getSDK(config)
+
+	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpdateRecordSets(ctx, req))
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update record sets in DNS zone %q: %w", req.DnsZoneId, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while waiting operation to update record sets in DNS zone %q: %w", req.DnsZoneId, err)
+	}
+
+	_, err = op.Response()
+	return err
+}
+
+// addDnsRecordSetData adds the data of rs to the record set with the same name and type, creating it if needed.
+// It is used for the records which are managed on behalf of other resources, like yandex_cm_certificate.
+func addDnsRecordSetData(ctx context.Context, config *Config, zoneID string, rs *dns.RecordSet) error {
+	existing, err := getDnsRecordSet(ctx, config, zoneID, rs)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return updateDnsRecordSets(ctx, config, &dns.UpdateRecordSetsRequest{
+			DnsZoneId: zoneID,
+			Additions: []*dns.RecordSet{rs},
+		})
+	}
+
+	data := append([]string{}, existing.Data...)
+	for _, v := range rs.Data {
+		if !slices.Contains(data, v) {
+			data = append(data, v)
+		}
+	}
+	if len(data) == len(existing.Data) {
+		return nil
+	}
+
+	return updateDnsRecordSets(ctx, config, &dns.UpdateRecordSetsRequest{
+		DnsZoneId: zoneID,
+		Deletions: []*dns.RecordSet{existing},
+		Additions: []*dns.RecordSet{{Name: existing.Name, Type: existing.Type, Ttl: existing.Ttl, Data: data}},
+	})
+}
+
+// removeDnsRecordSetData removes the data of rs from the record set with the same name and type, and the whole
+// record set if nothing is left in it.
+func removeDnsRecordSetData(ctx context.Context, config *Config, zoneID string, rs *dns.RecordSet) error {
+	existing, err := getDnsRecordSet(ctx, config, zoneID, rs)
+	if err != nil || existing == nil {
+		return err
+	}
+
+	var data []string
+	for _, v := range existing.Data {
+		if !slices.Contains(rs.Data, v) {
+			data = append(data, v)
+		}
+	}
+	if len(data) == len(existing.Data) {
+		return nil
+	}
+
+	req := &dns.UpdateRecordSetsRequest{
+		DnsZoneId: zoneID,
+		Deletions: []*dns.RecordSet{existing},
+	}
+	if len(data) > 0 {
+		req.Additions = []*dns.RecordSet{{Name: existing.Name, Type: existing.Type, Ttl: existing.Ttl, Data: data}}
+	}
+	return updateDnsRecordSets(ctx, config, req)
+}
+
+func getDnsRecordSet(ctx context.Context, config *Config, zoneID string, rs *dns.RecordSet) (*dns.RecordSet, error) {
+	existing, err := getSDK(config).DNS().DnsZone().GetRecordSet(ctx, &dns.GetDnsZoneRecordSetRequest{
+		DnsZoneId: zoneID,
+		Name:      rs.Name,
+		Type:      rs.Type,
+	})
+	if isStatusWithCode(err, codes.NotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while reading %s record set %q in DNS zone %q: %w", rs.Type, rs.Name, zoneID, err)
+	}
+	return existing, nil
+}
+
 func resourceDnsRecordSetImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) == 3 {
@@ -0,0 +1,552 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	miekgdns "github.com/miekg/dns"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
+)
+
+const (
+	dnsZoneRecordsModeAuthoritative = "authoritative"
+	dnsZoneRecordsModeAdditive      = "additive"
+
+	dnsZoneRecordsDefaultBatchSize = 100
+	// TTL of zone file records without an explicit TTL and $TTL directive
+	dnsZoneRecordsDefaultTTL = 3600
+)
+
+func resourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexDnsZoneRecordsCreate,
+		Read:   resourceYandexDnsZoneRecordsRead,
+		Update: resourceYandexDnsZoneRecordsUpdate,
+		Delete: resourceYandexDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexDnsZoneRecordsImportState,
+		},
+
+		CustomizeDiff: resourceYandexDnsZoneRecordsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"zone_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"record"},
+			},
+
+			"origin": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: dnsZoneRecordsOriginDiffSuppress,
+			},
+
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      dnsZoneRecordsModeAuthoritative,
+				ValidateFunc: validation.StringInSlice([]string{dnsZoneRecordsModeAuthoritative, dnsZoneRecordsModeAdditive}, false),
+			},
+
+			"batch_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      dnsZoneRecordsDefaultBatchSize,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+
+			"record": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"zone_file"},
+				Set:           dnsZoneRecordHash,
+				Elem:          dnsZoneRecordSchema(),
+			},
+		},
+	}
+}
+
+func dnsZoneRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 254),
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 20),
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+
+			"data": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func dnsZoneRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["type"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["ttl"].(int)))
+	data := convertStringSet(m["data"].(*schema.Set))
+	sort.Strings(data)
+	buf.WriteString(strings.Join(data, "-"))
+	return hashcode.String(buf.String())
+}
+
+// resourceYandexDnsZoneRecordsCustomizeDiff plans the records declared in the configuration. It runs on every plan,
+// even without changes in the configuration, so that records changed outside of Terraform are planned for update.
+func resourceYandexDnsZoneRecordsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	for _, attr := range []string{"zone_id", "zone_file", "origin", "record"} {
+		if !rawConfig.GetAttr(attr).IsWhollyKnown() {
+			log.Printf("[DEBUG] %s of yandex_dns_zone_records is not known yet, records will be known after apply", attr)
+			return d.SetNewComputed("record")
+		}
+	}
+
+	origin, err := dnsZoneRecordsOrigin(ctx, d.Get("zone_id").(string), d.Get("origin").(string), meta.(*Config))
+	if err != nil {
+		return err
+	}
+	if d.Get("origin").(string) == "" {
+		if err := d.SetNew("origin", origin); err != nil {
+			return err
+		}
+	}
+
+	var recordSets []*dns.RecordSet
+	if zoneFile, ok := d.GetOk("zone_file"); ok {
+		recordSets, err = parseDnsZoneFile(zoneFile.(string), origin)
+		if err != nil {
+			return err
+		}
+	} else {
+		recordSets = expandDnsZoneRecords(d.Get("record").(*schema.Set), origin)
+	}
+
+	return d.SetNew("record", flattenDnsZoneRecords(recordSets))
+}
+
+// dnsZoneRecordsOrigin returns the origin relative names are resolved against: the configured one
+// or the zone name.
+func dnsZoneRecordsOrigin(ctx context.Context, zoneID, origin string, config *Config) (string, error) {
+	if origin != "" {
+		return normalizeDnsZoneOrigin(origin), nil
+	}
+
+	zone, err := getSDK(config).DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: zoneID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting API to get DnsZone %q: %s", zoneID, err)
+	}
+
+	return normalizeDnsZoneOrigin(zone.Zone), nil
+}
+
+func normalizeDnsZoneOrigin(origin string) string {
+	return strings.ToLower(miekgdns.Fqdn(origin))
+}
+
+func dnsZoneRecordsOriginDiffSuppress(_, old, new string, _ *schema.ResourceData) bool {
+	return new != "" && normalizeDnsZoneOrigin(old) == normalizeDnsZoneOrigin(new)
+}
+
+func resourceYandexDnsZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	zoneID := d.Get("zone_id").(string)
+
+	if err := applyDnsZoneRecords(d, meta, nil); err != nil {
+		return err
+	}
+
+	d.SetId(zoneID)
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	origin, err := dnsZoneRecordsOrigin(config.Context(), d.Id(), d.Get("origin").(string), config)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Id()))
+	}
+
+	current, err := listDnsZoneRecordSets(config.Context(), config, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Id()))
+	}
+
+	var recordSets []*dns.RecordSet
+	if d.Get("mode").(string) == dnsZoneRecordsModeAdditive {
+		managed := dnsRecordSetsByKey(expandDnsZoneRecords(d.Get("record").(*schema.Set), origin))
+		for _, rs := range current {
+			if _, ok := managed[dnsRecordSetKey(rs)]; ok {
+				recordSets = append(recordSets, rs)
+			}
+		}
+	} else {
+		for _, rs := range current {
+			if !isDnsZoneManagedRecordSet(rs, origin) {
+				recordSets = append(recordSets, rs)
+			}
+		}
+	}
+
+	d.Set("zone_id", d.Id())
+	d.Set("origin", origin)
+	return d.Set("record", flattenDnsZoneRecords(recordSets))
+}
+
+func resourceYandexDnsZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	oldRecords, _ := d.GetChange("record")
+	origin := normalizeDnsZoneOrigin(d.Get("origin").(string))
+
+	if err := applyDnsZoneRecords(d, meta, expandDnsZoneRecords(oldRecords.(*schema.Set), origin)); err != nil {
+		return err
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	zoneID := d.Get("zone_id").(string)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	current, err := listDnsZoneRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", zoneID))
+	}
+
+	origin := normalizeDnsZoneOrigin(d.Get("origin").(string))
+	managed := expandDnsZoneRecords(d.Get("record").(*schema.Set), origin)
+	_, deletions := dnsZoneRecordsChanges(nil, current, managed, false, origin)
+
+	if err := upsertDnsZoneRecordSets(ctx, config, zoneID, nil, deletions, d.Get("batch_size").(int)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting records of DnsZone %q", zoneID)
+	return nil
+}
+
+func resourceYandexDnsZoneRecordsImportState(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("mode", dnsZoneRecordsModeAuthoritative)
+	d.Set("batch_size", dnsZoneRecordsDefaultBatchSize)
+	return []*schema.ResourceData{d}, nil
+}
+
+// applyDnsZoneRecords brings the zone to the planned records, previous are the records managed before
+// the change and are only used in the additive mode.
+func applyDnsZoneRecords(d *schema.ResourceData, meta interface{}, previous []*dns.RecordSet) error {
+	config := meta.(*Config)
+	zoneID := d.Get("zone_id").(string)
+	origin := normalizeDnsZoneOrigin(d.Get("origin").(string))
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
+	defer cancel()
+
+	current, err := listDnsZoneRecordSets(ctx, config, zoneID)
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to list record sets of DnsZone %q: %s", zoneID, err)
+	}
+
+	desired := expandDnsZoneRecords(d.Get("record").(*schema.Set), origin)
+	authoritative := d.Get("mode").(string) == dnsZoneRecordsModeAuthoritative
+	replacements, deletions := dnsZoneRecordsChanges(desired, current, previous, authoritative, origin)
+
+	log.Printf("[DEBUG] Updating records of DnsZone %q: %d replacements, %d deletions", zoneID, len(replacements), len(deletions))
+
+	return upsertDnsZoneRecordSets(ctx, config, zoneID, replacements, deletions, d.Get("batch_size").(int))
+}
+
+func upsertDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string, replacements, deletions []*dns.RecordSet, batchSize int) error {
+	sdk := getSDK(config)
+
+	for _, req := range batchDnsZoneRecordsChanges(zoneID, replacements, deletions, batchSize) {
+		op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpsertRecordSets(ctx, req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to upsert record sets of DnsZone %q: %s", zoneID, err)
+		}
+
+		err = op.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("Error while waiting operation to upsert record sets of DnsZone %q: %s", zoneID, err)
+		}
+
+		if _, err := op.Response(); err != nil {
+			return fmt.Errorf("Upsert of record sets of DnsZone %q failed: %s", zoneID, err)
+		}
+	}
+
+	return nil
+}
+
+func listDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string) ([]*dns.RecordSet, error) {
+	it := getSDK(config).DNS().DnsZone().DnsZoneRecordSetsIterator(ctx, &dns.ListDnsZoneRecordSetsRequest{
+		DnsZoneId: zoneID,
+	})
+	return it.TakeAll()
+}
+
+// dnsZoneRecordsChanges computes the minimal set of changes bringing the current record sets to the desired ones.
+// In the authoritative mode all other record sets of the zone are deleted, except the ones managed by the zone itself,
+// in the additive mode only the previously managed record sets that are no longer desired are.
+func dnsZoneRecordsChanges(desired, current, previous []*dns.RecordSet, authoritative bool, origin string) (replacements, deletions []*dns.RecordSet) {
+	currentByKey := dnsRecordSetsByKey(current)
+	desiredByKey := dnsRecordSetsByKey(desired)
+
+	for _, rs := range desired {
+		if cur, ok := currentByKey[dnsRecordSetKey(rs)]; ok && equalDnsRecordSets(cur, rs) {
+			continue
+		}
+		replacements = append(replacements, rs)
+	}
+
+	candidates := previous
+	if authoritative {
+		candidates = current
+	}
+	for _, rs := range candidates {
+		key := dnsRecordSetKey(rs)
+		if _, ok := desiredByKey[key]; ok {
+			continue
+		}
+		cur, ok := currentByKey[key]
+		if !ok || isDnsZoneManagedRecordSet(cur, origin) {
+			continue
+		}
+		deletions = append(deletions, cur)
+	}
+
+	sortDnsRecordSets(replacements)
+	sortDnsRecordSets(deletions)
+	return replacements, deletions
+}
+
+// batchDnsZoneRecordsChanges splits changes into requests of at most batchSize record sets. Deletions go first,
+// so that a record set of another type can take the name of a deleted one (e.g. CNAME).
+func batchDnsZoneRecordsChanges(zoneID string, replacements, deletions []*dns.RecordSet, batchSize int) []*dns.UpsertRecordSetsRequest {
+	var reqs []*dns.UpsertRecordSetsRequest
+	var req *dns.UpsertRecordSetsRequest
+
+	next := func() {
+		if req == nil || len(req.Deletions)+len(req.Replacements) >= batchSize {
+			req = &dns.UpsertRecordSetsRequest{DnsZoneId: zoneID}
+			reqs = append(reqs, req)
+		}
+	}
+
+	for _, rs := range deletions {
+		next()
+		req.Deletions = append(req.Deletions, rs)
+	}
+	for _, rs := range replacements {
+		next()
+		req.Replacements = append(req.Replacements, rs)
+	}
+
+	return reqs
+}
+
+// isDnsZoneManagedRecordSet reports whether the record set is maintained by Cloud DNS itself: SOA and NS of the apex.
+func isDnsZoneManagedRecordSet(rs *dns.RecordSet, origin string) bool {
+	return rs.Type == "SOA" || (rs.Type == "NS" && strings.EqualFold(rs.Name, origin))
+}
+
+// parseDnsZoneFile parses an RFC 1035 zone file into record sets with fully qualified names.
+// Records maintained by Cloud DNS itself are skipped.
+func parseDnsZoneFile(zoneFile, origin string) ([]*dns.RecordSet, error) {
+	zp := miekgdns.NewZoneParser(strings.NewReader(zoneFile), origin, "zone_file")
+	zp.SetDefaultTTL(dnsZoneRecordsDefaultTTL)
+
+	byKey := map[string]*dns.RecordSet{}
+	var recordSets []*dns.RecordSet
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		rs := dnsRecordSetFromRR(rr)
+		if isDnsZoneManagedRecordSet(rs, origin) {
+			log.Printf("[DEBUG] Skipping %s %s record from zone file, it is managed by Cloud DNS", rs.Name, rs.Type)
+			continue
+		}
+
+		key := dnsRecordSetKey(rs)
+		existing, ok := byKey[key]
+		if !ok {
+			byKey[key] = rs
+			recordSets = append(recordSets, rs)
+			continue
+		}
+		if existing.Ttl != rs.Ttl {
+			return nil, fmt.Errorf("records %s %s have different TTLs in zone file: %d and %d", rs.Name, rs.Type, existing.Ttl, rs.Ttl)
+		}
+		existing.Data = append(existing.Data, rs.Data...)
+	}
+	if err := zp.Err(); err != nil {
+		return nil, fmt.Errorf("error parsing zone file: %s", err)
+	}
+
+	sortDnsRecordSets(recordSets)
+	return recordSets, nil
+}
+
+func dnsRecordSetFromRR(rr miekgdns.RR) *dns.RecordSet {
+	header := rr.Header()
+	return &dns.RecordSet{
+		Name: strings.ToLower(header.Name),
+		Type: miekgdns.TypeToString[header.Rrtype],
+		Ttl:  int64(header.Ttl),
+		Data: []string{strings.TrimPrefix(rr.String(), header.String())},
+	}
+}
+
+// formatDnsZoneFile renders record sets as an RFC 1035 zone file.
+func formatDnsZoneFile(recordSets []*dns.RecordSet, origin string) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("$ORIGIN %s\n", origin))
+
+	sorted := append([]*dns.RecordSet{}, recordSets...)
+	sortDnsRecordSets(sorted)
+	for _, rs := range sorted {
+		data := append([]string{}, rs.Data...)
+		sort.Strings(data)
+		for _, value := range data {
+			line := fmt.Sprintf("%s\t%d\tIN\t%s\t%s", rs.Name, rs.Ttl, rs.Type, value)
+			// records are formatted in the canonical presentation format when possible
+			if rr, err := miekgdns.NewRR(line); err == nil && rr != nil {
+				line = rr.String()
+			}
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// expandDnsZoneRecords converts records to record sets, resolving relative names against origin.
+func expandDnsZoneRecords(records *schema.Set, origin string) []*dns.RecordSet {
+	var recordSets []*dns.RecordSet
+	for _, v := range records.List() {
+		record := v.(map[string]interface{})
+		data := convertStringSet(record["data"].(*schema.Set))
+		sort.Strings(data)
+		recordSets = append(recordSets, &dns.RecordSet{
+			Name: qualifyDnsRecordName(record["name"].(string), origin),
+			Type: strings.ToUpper(record["type"].(string)),
+			Ttl:  int64(record["ttl"].(int)),
+			Data: data,
+		})
+	}
+	sortDnsRecordSets(recordSets)
+	return recordSets
+}
+
+func flattenDnsZoneRecords(recordSets []*dns.RecordSet) []interface{} {
+	records := make([]interface{}, 0, len(recordSets))
+	for _, rs := range recordSets {
+		records = append(records, map[string]interface{}{
+			"name": rs.Name,
+			"type": rs.Type,
+			"ttl":  int(rs.Ttl),
+			"data": convertStringArrToInterface(rs.Data),
+		})
+	}
+	return records
+}
+
+func qualifyDnsRecordName(name, origin string) string {
+	switch {
+	case name == "@" || name == "":
+		name = origin
+	case !miekgdns.IsFqdn(name):
+		name = name + "." + origin
+	}
+	return strings.ToLower(name)
+}
+
+func dnsRecordSetKey(rs *dns.RecordSet) string {
+	return strings.ToLower(rs.Name) + " " + strings.ToUpper(rs.Type)
+}
+
+func dnsRecordSetsByKey(recordSets []*dns.RecordSet) map[string]*dns.RecordSet {
+	res := make(map[string]*dns.RecordSet, len(recordSets))
+	for _, rs := range recordSets {
+		res[dnsRecordSetKey(rs)] = rs
+	}
+	return res
+}
+
+func equalDnsRecordSets(a, b *dns.RecordSet) bool {
+	if a.Ttl != b.Ttl || len(a.Data) != len(b.Data) {
+		return false
+	}
+	aData := append([]string{}, a.Data...)
+	bData := append([]string{}, b.Data...)
+	sort.Strings(aData)
+	sort.Strings(bData)
+	for i := range aData {
+		if aData[i] != bData[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func sortDnsRecordSets(recordSets []*dns.RecordSet) {
+	sort.Slice(recordSets, func(i, j int) bool {
+		return dnsRecordSetKey(recordSets[i]) < dnsRecordSetKey(recordSets[j])
+	})
+}
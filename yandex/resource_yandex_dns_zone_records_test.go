@@ -0,0 +1,398 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+const testDnsZoneFile = `
+$TTL 600
+@        IN SOA ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900
+@        IN NS  ns1.yandexcloud.net.
+@        IN MX  10 mail
+www      IN A   192.168.0.1
+www      IN A   192.168.0.2
+ftp 300  IN CNAME www
+sub      IN NS  ns.other.example.
+`
+
+func TestParseDnsZoneFile(t *testing.T) {
+	got, err := parseDnsZoneFile(testDnsZoneFile, "example.com.")
+	if err != nil {
+		t.Fatalf("parseDnsZoneFile() error = %v", err)
+	}
+
+	expected := []*dns.RecordSet{
+		{Name: "example.com.", Type: "MX", Ttl: 600, Data: []string{"10 mail.example.com."}},
+		{Name: "ftp.example.com.", Type: "CNAME", Ttl: 300, Data: []string{"www.example.com."}},
+		{Name: "sub.example.com.", Type: "NS", Ttl: 600, Data: []string{"ns.other.example."}},
+		{Name: "www.example.com.", Type: "A", Ttl: 600, Data: []string{"192.168.0.1", "192.168.0.2"}},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("parseDnsZoneFile() = %v, want %v", got, expected)
+	}
+}
+
+func TestParseDnsZoneFileErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		zoneFile string
+	}{
+		{
+			name:     "syntax",
+			zoneFile: "www IN A not-an-address\n",
+		},
+		{
+			name:     "different ttls",
+			zoneFile: "www 300 IN A 192.168.0.1\nwww 600 IN A 192.168.0.2\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := parseDnsZoneFile(tc.zoneFile, "example.com."); err == nil {
+				t.Errorf("parseDnsZoneFile() expected an error")
+			}
+		})
+	}
+}
+
+func TestFormatDnsZoneFile(t *testing.T) {
+	recordSets, err := parseDnsZoneFile(testDnsZoneFile, "example.com.")
+	if err != nil {
+		t.Fatalf("parseDnsZoneFile() error = %v", err)
+	}
+
+	zoneFile := formatDnsZoneFile(recordSets, "example.com.")
+	parsed, err := parseDnsZoneFile(zoneFile, "example.com.")
+	if err != nil {
+		t.Fatalf("parseDnsZoneFile() of formatted zone file error = %v\n%s", err, zoneFile)
+	}
+	if !reflect.DeepEqual(parsed, recordSets) {
+		t.Errorf("formatted zone file parsed to %v, want %v", parsed, recordSets)
+	}
+}
+
+func TestQualifyDnsRecordName(t *testing.T) {
+	cases := map[string]string{
+		"@":             "example.com.",
+		"WWW":           "www.example.com.",
+		"a.b":           "a.b.example.com.",
+		"other.net.":    "other.net.",
+		"Example.COM.":  "example.com.",
+		"":              "example.com.",
+		"*.wildcard":    "*.wildcard.example.com.",
+		"_sip._tcp.www": "_sip._tcp.www.example.com.",
+	}
+
+	for name, expected := range cases {
+		if got := qualifyDnsRecordName(name, "example.com."); got != expected {
+			t.Errorf("qualifyDnsRecordName(%q) = %q, want %q", name, got, expected)
+		}
+	}
+}
+
+func TestDnsZoneRecordsChanges(t *testing.T) {
+	soa := &dns.RecordSet{Name: "example.com.", Type: "SOA", Ttl: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900"}}
+	ns := &dns.RecordSet{Name: "example.com.", Type: "NS", Ttl: 3600, Data: []string{"ns1.yandexcloud.net."}}
+	www := &dns.RecordSet{Name: "www.example.com.", Type: "A", Ttl: 600, Data: []string{"192.168.0.1"}}
+	wwwUpdated := &dns.RecordSet{Name: "www.example.com.", Type: "A", Ttl: 600, Data: []string{"192.168.0.2", "192.168.0.1"}}
+	ftp := &dns.RecordSet{Name: "ftp.example.com.", Type: "CNAME", Ttl: 600, Data: []string{"www.example.com."}}
+	mail := &dns.RecordSet{Name: "mail.example.com.", Type: "A", Ttl: 600, Data: []string{"192.168.0.3"}}
+
+	cases := []struct {
+		name                 string
+		desired              []*dns.RecordSet
+		current              []*dns.RecordSet
+		previous             []*dns.RecordSet
+		authoritative        bool
+		expectedReplacements []*dns.RecordSet
+		expectedDeletions    []*dns.RecordSet
+	}{
+		{
+			name:          "no changes",
+			desired:       []*dns.RecordSet{www},
+			current:       []*dns.RecordSet{soa, ns, {Name: "www.example.com.", Type: "A", Ttl: 600, Data: []string{"192.168.0.1"}}},
+			authoritative: true,
+		},
+		{
+			name:                 "authoritative",
+			desired:              []*dns.RecordSet{wwwUpdated, ftp},
+			current:              []*dns.RecordSet{soa, ns, www, mail},
+			authoritative:        true,
+			expectedReplacements: []*dns.RecordSet{ftp, wwwUpdated},
+			expectedDeletions:    []*dns.RecordSet{mail},
+		},
+		{
+			name:                 "additive",
+			desired:              []*dns.RecordSet{wwwUpdated},
+			current:              []*dns.RecordSet{soa, ns, www, ftp, mail},
+			previous:             []*dns.RecordSet{www, ftp},
+			expectedReplacements: []*dns.RecordSet{wwwUpdated},
+			expectedDeletions:    []*dns.RecordSet{ftp},
+		},
+		{
+			name:          "managed record sets are kept",
+			current:       []*dns.RecordSet{soa, ns},
+			previous:      []*dns.RecordSet{ns},
+			authoritative: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			replacements, deletions := dnsZoneRecordsChanges(tc.desired, tc.current, tc.previous, tc.authoritative, "example.com.")
+			if !reflect.DeepEqual(replacements, tc.expectedReplacements) {
+				t.Errorf("replacements = %v, want %v", replacements, tc.expectedReplacements)
+			}
+			if !reflect.DeepEqual(deletions, tc.expectedDeletions) {
+				t.Errorf("deletions = %v, want %v", deletions, tc.expectedDeletions)
+			}
+		})
+	}
+}
+
+func TestBatchDnsZoneRecordsChanges(t *testing.T) {
+	var replacements, deletions []*dns.RecordSet
+	for i := 0; i < 3; i++ {
+		deletions = append(deletions, &dns.RecordSet{Name: fmt.Sprintf("d%d.example.com.", i), Type: "A"})
+		replacements = append(replacements, &dns.RecordSet{Name: fmt.Sprintf("r%d.example.com.", i), Type: "A"})
+	}
+
+	reqs := batchDnsZoneRecordsChanges("zone", replacements, deletions, 4)
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(reqs))
+	}
+	if len(reqs[0].Deletions) != 3 || len(reqs[0].Replacements) != 1 {
+		t.Errorf("unexpected first request: %v", reqs[0])
+	}
+	if len(reqs[1].Deletions) != 0 || len(reqs[1].Replacements) != 2 {
+		t.Errorf("unexpected second request: %v", reqs[1])
+	}
+	for _, req := range reqs {
+		if req.DnsZoneId != "zone" {
+			t.Errorf("unexpected zone id %q", req.DnsZoneId)
+		}
+	}
+
+	if reqs := batchDnsZoneRecordsChanges("zone", nil, nil, 4); len(reqs) != 0 {
+		t.Errorf("expected no requests without changes, got %d", len(reqs))
+	}
+}
+
+func TestAccDNSZoneRecords_zoneFile(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+	var zoneID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecordsZoneFile(zoneName, fqdn, "192.168.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "origin", fqdn),
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "record.#", "2"),
+					testAccCheckDNSZoneRecordSet("yandex_dns_zone_records.records", "www."+fqdn, "A", "192.168.0.1"),
+					testAccCheckDNSZoneRecordSet("yandex_dns_zone_records.records", "ftp."+fqdn, "CNAME", "www."+fqdn),
+				),
+			},
+			{
+				Config: testAccDNSZoneRecordsZoneFile(zoneName, fqdn, "192.168.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSZoneRecordSet("yandex_dns_zone_records.records", "www."+fqdn, "A", "192.168.0.2"),
+					resource.TestCheckResourceAttrPair("data.yandex_dns_zone_records.export", "origin", "yandex_dns_zone_records.records", "origin"),
+					resource.TestCheckResourceAttrSet("data.yandex_dns_zone_records.export", "zone_file"),
+					func(s *terraform.State) error {
+						zoneID = s.RootModule().Resources["yandex_dns_zone.zone1"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// records added outside of Terraform are removed in the authoritative mode
+				PreConfig: testAccDNSZoneRecordsAddOutOfBand(&zoneID, "mail."+fqdn),
+				Config:    testAccDNSZoneRecordsZoneFile(zoneName, fqdn, "192.168.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "record.#", "2"),
+					testAccCheckDNSZoneRecordSetNotExists(&zoneID, "mail."+fqdn),
+				),
+			},
+			{
+				ResourceName:            "yandex_dns_zone_records.records",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+func TestAccDNSZoneRecords_additive(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecordsAdditive(zoneName, fqdn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "record.#", "1"),
+					testAccCheckDNSZoneRecordSet("yandex_dns_zone_records.records", "www."+fqdn, "A", "192.168.0.1"),
+					testAccCheckDNSZoneRecordSet("yandex_dns_zone_records.records", "srv."+fqdn, "A", "192.168.0.3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSZoneRecordSet(name, recordName, recordType, data string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := config.sdk.DNS().DnsZone().GetRecordSet(context.Background(), &dns.GetDnsZoneRecordSetRequest{
+			DnsZoneId: rs.Primary.Attributes["zone_id"],
+			Name:      recordName,
+			Type:      recordType,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, d := range found.Data {
+			if d == data {
+				return nil
+			}
+		}
+		return fmt.Errorf("Record set %s %s has no %q data: %v", recordName, recordType, data, found.Data)
+	}
+}
+
+func testAccDNSZoneRecordsAddOutOfBand(zoneID *string, name string) func() {
+	return func() {
+		config := testAccProvider.Meta().(*Config)
+		op, err := config.sdk.WrapOperation(config.sdk.DNS().DnsZone().UpsertRecordSets(context.Background(), &dns.UpsertRecordSetsRequest{
+			DnsZoneId: *zoneID,
+			Replacements: []*dns.RecordSet{
+				{Name: name, Type: "A", Ttl: 300, Data: []string{"192.168.0.3"}},
+			},
+		}))
+		if err == nil {
+			err = op.Wait(context.Background())
+		}
+		if err != nil {
+			panic(fmt.Sprintf("failed to add record set out of band: %s", err))
+		}
+	}
+}
+
+func testAccCheckDNSZoneRecordSetNotExists(zoneID *string, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		recordSets, err := listDnsZoneRecordSets(context.Background(), config, *zoneID)
+		if err != nil {
+			return err
+		}
+		for _, rs := range recordSets {
+			if rs.Name == name {
+				return fmt.Errorf("Record set %s still exists", name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDNSZoneRecordsDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_dns_zone" {
+			continue
+		}
+
+		_, err := config.sdk.DNS().DnsZone().Get(context.Background(), &dns.GetDnsZoneRequest{
+			DnsZoneId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("DnsZone still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccDNSZoneRecordsZoneFile(name, fqdn, address string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name   = "%[1]s"
+  zone   = "%[2]s"
+  public = false
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  zone_file = <<-EOT
+    $TTL 300
+    www IN A     %[3]s
+    ftp IN CNAME www
+  EOT
+}
+
+data "yandex_dns_zone_records" "export" {
+  zone_id = yandex_dns_zone_records.records.zone_id
+}
+`, name, fqdn, address)
+}
+
+func testAccDNSZoneRecordsAdditive(name, fqdn string) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name   = "%[1]s"
+  zone   = "%[2]s"
+  public = false
+}
+
+resource "yandex_dns_recordset" "srv" {
+  zone_id = yandex_dns_zone.zone1.id
+  name    = "srv"
+  type    = "A"
+  ttl     = 200
+  data    = ["192.168.0.3"]
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id = yandex_dns_zone.zone1.id
+  mode    = "additive"
+
+  record {
+    name = "www"
+    type = "A"
+    ttl  = 300
+    data = ["192.168.0.1"]
+  }
+
+  depends_on = [yandex_dns_recordset.srv]
+}
+`, name, fqdn)
+}
@@ -3,20 +3,53 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
+	"google.golang.org/grpc/codes"
 )
 
+var iamServiceAccountAPIKeyKind = &serviceAccountKeyKind{
+	description: "Api Key",
+	secretEntries: map[string]string{
+		"entry_for_secret_key": "Entry that will store the value of `secret_key`.",
+	},
+	create: func(ctx context.Context, d *schema.ResourceData, config *Config) (string, map[string]string, error) {
+		resp, err := config.sdk.IAM().ApiKey().Create(ctx, &iam.CreateApiKeyRequest{
+			ServiceAccountId: d.Get("service_account_id").(string),
+			Description:      d.Get("description").(string),
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("error creating api key: %s", err)
+		}
+		return resp.ApiKey.Id, map[string]string{"entry_for_secret_key": resp.Secret}, nil
+	},
+	exists: func(ctx context.Context, config *Config, id string) (bool, error) {
+		_, err := config.sdk.IAM().ApiKey().Get(ctx, &iam.GetApiKeyRequest{ApiKeyId: id})
+		if isStatusWithCode(err, codes.NotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	},
+	delete: func(ctx context.Context, config *Config, id string) error {
+		_, err := config.sdk.IAM().ApiKey().Delete(ctx, &iam.DeleteApiKeyRequest{ApiKeyId: id})
+		return err
+	},
+}
+
 func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceYandexIAMServiceAccountAPIKeyCreate,
 		Read:   resourceYandexIAMServiceAccountAPIKeyRead,
+		Update: resourceYandexIAMServiceAccountAPIKeyUpdate,
 		Delete: resourceYandexIAMServiceAccountAPIKeyDelete,
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: serviceAccountKeyRotationDiff(iamServiceAccountAPIKeyKind),
+
+		Schema: mergeSchemas(serviceAccountKeyRotationSchema(iamServiceAccountAPIKeyKind), map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,9 +65,10 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 			},
 
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "Use output_to_lockbox instead.",
 			},
 
 			"secret_key": {
@@ -57,7 +91,7 @@ func resourceYandexIAMServiceAccountAPIKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+		}),
 	}
 }
 
@@ -67,23 +101,21 @@ func resourceYandexIAMServiceAccountAPIKeyCreate(d *schema.ResourceData, meta in
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	resp, err := config.sdk.IAM().ApiKey().Create(ctx, &iam.CreateApiKeyRequest{
-		ServiceAccountId: d.Get("service_account_id").(string),
-		Description:      d.Get("description").(string),
-	})
+	material, err := createServiceAccountKey(ctx, d, config, iamServiceAccountAPIKeyKind)
 	if err != nil {
-		return fmt.Errorf("error creating api key: %s", err)
+		return err
 	}
 
-	d.SetId(resp.ApiKey.Id)
 	// Data only available on create.
-	if v, ok := d.GetOk("pgp_key"); ok {
+	if material == nil {
+		log.Printf("[DEBUG] Secret key of Api Key %q is written to Lockbox", d.Id())
+	} else if v, ok := d.GetOk("pgp_key"); ok {
 		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
 		if err != nil {
 			return err
 		}
 
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, resp.Secret, "Yandex Service Account API Key")
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, material["entry_for_secret_key"], "Yandex Service Account API Key")
 		if err != nil {
 			return err
 		}
@@ -91,7 +123,7 @@ func resourceYandexIAMServiceAccountAPIKeyCreate(d *schema.ResourceData, meta in
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_secret_key", encrypted)
 	} else {
-		d.Set("secret_key", resp.Secret)
+		d.Set("secret_key", material["entry_for_secret_key"])
 	}
 
 	return resourceYandexIAMServiceAccountAPIKeyRead(d, meta)
@@ -114,22 +146,29 @@ func resourceYandexIAMServiceAccountAPIKeyRead(d *schema.ResourceData, meta inte
 	d.Set("created_at", getTimestamp(ak.CreatedAt))
 	d.Set("description", ak.Description)
 
-	return nil
+	return refreshServiceAccountActiveKeys(ctx, d, config, iamServiceAccountAPIKeyKind)
 }
 
-func resourceYandexIAMServiceAccountAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountAPIKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
-	_, err := config.sdk.IAM().ApiKey().Delete(ctx, &iam.DeleteApiKeyRequest{
-		ApiKeyId: d.Id(),
-	})
-	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Api Key %q", d.Id()))
+	if d.HasChange("created_at") {
+		if err := rotateServiceAccountKey(ctx, d, config, iamServiceAccountAPIKeyKind); err != nil {
+			return err
+		}
 	}
 
-	d.SetId("")
-	return nil
+	return resourceYandexIAMServiceAccountAPIKeyRead(d, meta)
+}
+
+func resourceYandexIAMServiceAccountAPIKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	return deleteServiceAccountKeys(ctx, d, config, iamServiceAccountAPIKeyKind)
 }
@@ -3,13 +3,56 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
 )
 
+var iamServiceAccountKeyKind = &serviceAccountKeyKind{
+	description: "Service Account Key",
+	secretEntries: map[string]string{
+		"entry_for_private_key": "Entry that will store the value of `private_key`.",
+	},
+	rotatedAttributes: []string{"public_key"},
+	create: func(ctx context.Context, d *schema.ResourceData, config *Config) (string, map[string]string, error) {
+		format, err := parseIamKeyFormat(d.Get("format").(string))
+		if err != nil {
+			return "", nil, err
+		}
+
+		algorithm, err := parseIamKeyAlgorithm(d.Get("key_algorithm").(string))
+		if err != nil {
+			return "", nil, err
+		}
+
+		resp, err := config.sdk.IAM().Key().Create(ctx, &iam.CreateKeyRequest{
+			ServiceAccountId: d.Get("service_account_id").(string),
+			Description:      d.Get("description").(string),
+			Format:           format,
+			KeyAlgorithm:     algorithm,
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("error creating service account key: %s", err)
+		}
+		return resp.Key.Id, map[string]string{"entry_for_private_key": resp.PrivateKey}, nil
+	},
+	exists: func(ctx context.Context, config *Config, id string) (bool, error) {
+		_, err := config.sdk.IAM().Key().Get(ctx, &iam.GetKeyRequest{KeyId: id})
+		if isStatusWithCode(err, codes.NotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	},
+	delete: func(ctx context.Context, config *Config, id string) error {
+		_, err := config.sdk.IAM().Key().Delete(ctx, &iam.DeleteKeyRequest{KeyId: id})
+		return err
+	},
+}
+
 func resourceYandexIAMServiceAccountKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceYandexIAMServiceAccountKeyCreate,
@@ -17,7 +60,9 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 		Update: resourceYandexIAMServiceAccountKeyUpdate,
 		Delete: resourceYandexIAMServiceAccountKeyDelete,
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: serviceAccountKeyRotationDiff(iamServiceAccountKeyKind),
+
+		Schema: mergeSchemas(serviceAccountKeyRotationSchema(iamServiceAccountKeyKind), map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -46,9 +91,10 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 			},
 
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "Use output_to_lockbox instead.",
 			},
 
 			"public_key": {
@@ -76,7 +122,7 @@ func resourceYandexIAMServiceAccountKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+		}),
 	}
 }
 
@@ -86,35 +132,21 @@ func resourceYandexIAMServiceAccountKeyCreate(d *schema.ResourceData, meta inter
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	format, err := parseIamKeyFormat(d.Get("format").(string))
-	if err != nil {
-		return err
-	}
-
-	algorithm, err := parseIamKeyAlgorithm(d.Get("key_algorithm").(string))
+	material, err := createServiceAccountKey(ctx, d, config, iamServiceAccountKeyKind)
 	if err != nil {
 		return err
 	}
 
-	resp, err := config.sdk.IAM().Key().Create(ctx, &iam.CreateKeyRequest{
-		ServiceAccountId: d.Get("service_account_id").(string),
-		Description:      d.Get("description").(string),
-		Format:           format,
-		KeyAlgorithm:     algorithm,
-	})
-	if err != nil {
-		return fmt.Errorf("error creating service account key: %s", err)
-	}
-
-	d.SetId(resp.Key.Id)
 	// Data only available on create.
-	if v, ok := d.GetOk("pgp_key"); ok {
+	if material == nil {
+		log.Printf("[DEBUG] Private key of Service Account Key %q is written to Lockbox", d.Id())
+	} else if v, ok := d.GetOk("pgp_key"); ok {
 		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
 		if err != nil {
 			return err
 		}
 
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, resp.PrivateKey, "Yandex Service Account Key")
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, material["entry_for_private_key"], "Yandex Service Account Key")
 		if err != nil {
 			return err
 		}
@@ -122,7 +154,7 @@ func resourceYandexIAMServiceAccountKeyCreate(d *schema.ResourceData, meta inter
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_private_key", encrypted)
 	} else {
-		d.Set("private_key", resp.PrivateKey)
+		d.Set("private_key", material["entry_for_private_key"])
 	}
 
 	return resourceYandexIAMServiceAccountKeyRead(d, meta)
@@ -153,7 +185,7 @@ func resourceYandexIAMServiceAccountKeyRead(d *schema.ResourceData, meta interfa
 	d.Set("key_algorithm", iam.Key_Algorithm_name[int32(key.KeyAlgorithm)])
 	d.Set("public_key", key.PublicKey)
 
-	return nil
+	return refreshServiceAccountActiveKeys(ctx, d, config, iamServiceAccountKeyKind)
 }
 
 func resourceYandexIAMServiceAccountKeyUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -162,6 +194,12 @@ func resourceYandexIAMServiceAccountKeyUpdate(d *schema.ResourceData, meta inter
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
+	if d.HasChange("created_at") {
+		if err := rotateServiceAccountKey(ctx, d, config, iamServiceAccountKeyKind); err != nil {
+			return err
+		}
+	}
+
 	req := &iam.UpdateKeyRequest{
 		KeyId:       d.Id(),
 		Description: d.Get("description").(string),
@@ -192,13 +230,5 @@ func resourceYandexIAMServiceAccountKeyDelete(d *schema.ResourceData, meta inter
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
 	defer cancel()
 
-	_, err := config.sdk.IAM().Key().Delete(ctx, &iam.DeleteKeyRequest{
-		KeyId: d.Id(),
-	})
-	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account Key %q", d.Id()))
-	}
-
-	d.SetId("")
-	return nil
+	return deleteServiceAccountKeys(ctx, d, config, iamServiceAccountKeyKind)
 }
@@ -3,20 +3,58 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/encryption"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1/awscompatibility"
+	"google.golang.org/grpc/codes"
 )
 
+var iamServiceAccountStaticAccessKeyKind = &serviceAccountKeyKind{
+	description: "Service Account Static Access Key",
+	secretEntries: map[string]string{
+		"entry_for_access_key": "Entry that will store the value of `access_key`.",
+		"entry_for_secret_key": "Entry that will store the value of `secret_key`.",
+	},
+	rotatedAttributes: []string{"access_key"},
+	create: func(ctx context.Context, d *schema.ResourceData, config *Config) (string, map[string]string, error) {
+		resp, err := config.sdk.IAM().AWSCompatibility().AccessKey().Create(ctx, &awscompatibility.CreateAccessKeyRequest{
+			ServiceAccountId: d.Get("service_account_id").(string),
+			Description:      d.Get("description").(string),
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("error creating service account key: %s", err)
+		}
+		return resp.AccessKey.Id, map[string]string{
+			"entry_for_access_key": resp.AccessKey.KeyId,
+			"entry_for_secret_key": resp.Secret,
+		}, nil
+	},
+	exists: func(ctx context.Context, config *Config, id string) (bool, error) {
+		_, err := config.sdk.IAM().AWSCompatibility().AccessKey().Get(ctx, &awscompatibility.GetAccessKeyRequest{AccessKeyId: id})
+		if isStatusWithCode(err, codes.NotFound) {
+			return false, nil
+		}
+		return err == nil, err
+	},
+	delete: func(ctx context.Context, config *Config, id string) error {
+		_, err := config.sdk.IAM().AWSCompatibility().AccessKey().Delete(ctx, &awscompatibility.DeleteAccessKeyRequest{AccessKeyId: id})
+		return err
+	},
+}
+
 func resourceYandexIAMServiceAccountStaticAccessKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceYandexIAMServiceAccountStaticAccessKeyCreate,
 		Read:   resourceYandexIAMServiceAccountStaticAccessKeyRead,
+		Update: resourceYandexIAMServiceAccountStaticAccessKeyUpdate,
 		Delete: resourceYandexIAMServiceAccountStaticAccessKeyDelete,
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: serviceAccountKeyRotationDiff(iamServiceAccountStaticAccessKeyKind),
+
+		Schema: mergeSchemas(serviceAccountKeyRotationSchema(iamServiceAccountStaticAccessKeyKind), map[string]*schema.Schema{
 			"service_account_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -32,9 +70,10 @@ func resourceYandexIAMServiceAccountStaticAccessKey() *schema.Resource {
 			},
 
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				ForceNew:   true,
+				Deprecated: "Use output_to_lockbox instead.",
 			},
 
 			"access_key": {
@@ -62,7 +101,7 @@ func resourceYandexIAMServiceAccountStaticAccessKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-		},
+		}),
 	}
 }
 
@@ -72,23 +111,21 @@ func resourceYandexIAMServiceAccountStaticAccessKeyCreate(d *schema.ResourceData
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	resp, err := config.sdk.IAM().AWSCompatibility().AccessKey().Create(ctx, &awscompatibility.CreateAccessKeyRequest{
-		ServiceAccountId: d.Get("service_account_id").(string),
-		Description:      d.Get("description").(string),
-	})
+	material, err := createServiceAccountKey(ctx, d, config, iamServiceAccountStaticAccessKeyKind)
 	if err != nil {
-		return fmt.Errorf("error creating service account key: %s", err)
+		return err
 	}
 
-	d.SetId(resp.AccessKey.Id)
 	// Data only available on create.
-	if v, ok := d.GetOk("pgp_key"); ok {
+	if material == nil {
+		log.Printf("[DEBUG] Secret key of Service Account Static Access Key %q is written to Lockbox", d.Id())
+	} else if v, ok := d.GetOk("pgp_key"); ok {
 		encryptionKey, err := encryption.RetrieveGPGKey(v.(string))
 		if err != nil {
 			return err
 		}
 
-		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, resp.Secret, "Yandex Service Account Static Access Key")
+		fingerprint, encrypted, err := encryption.EncryptValue(encryptionKey, material["entry_for_secret_key"], "Yandex Service Account Static Access Key")
 		if err != nil {
 			return err
 		}
@@ -96,7 +133,7 @@ func resourceYandexIAMServiceAccountStaticAccessKeyCreate(d *schema.ResourceData
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_secret_key", encrypted)
 	} else {
-		d.Set("secret_key", resp.Secret)
+		d.Set("secret_key", material["entry_for_secret_key"])
 	}
 
 	return resourceYandexIAMServiceAccountStaticAccessKeyRead(d, meta)
@@ -120,22 +157,29 @@ func resourceYandexIAMServiceAccountStaticAccessKeyRead(d *schema.ResourceData,
 	d.Set("description", sak.Description)
 	d.Set("access_key", sak.KeyId)
 
-	return nil
+	return refreshServiceAccountActiveKeys(ctx, d, config, iamServiceAccountStaticAccessKeyKind)
 }
 
-func resourceYandexIAMServiceAccountStaticAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceYandexIAMServiceAccountStaticAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
-	_, err := config.sdk.IAM().AWSCompatibility().AccessKey().Delete(ctx, &awscompatibility.DeleteAccessKeyRequest{
-		AccessKeyId: d.Id(),
-	})
-	if err != nil {
-		return handleNotFoundError(err, d, fmt.Sprintf("Service Account Static Access Key %q", d.Id()))
+	if d.HasChange("created_at") {
+		if err := rotateServiceAccountKey(ctx, d, config, iamServiceAccountStaticAccessKeyKind); err != nil {
+			return err
+		}
 	}
 
-	d.SetId("")
-	return nil
+	return resourceYandexIAMServiceAccountStaticAccessKeyRead(d, meta)
+}
+
+func resourceYandexIAMServiceAccountStaticAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	return deleteServiceAccountKeys(ctx, d, config, iamServiceAccountStaticAccessKeyKind)
 }
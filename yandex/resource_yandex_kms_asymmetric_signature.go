@@ -0,0 +1,128 @@
+package yandex
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	kmsasymmetricsignature "github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1/asymmetricsignature"
+)
+
+const (
+	yandexKMSAsymmetricSignatureDefaultTimeout = 1 * time.Minute
+)
+
+func resourceYandexKMSAsymmetricSignature() *schema.Resource {
+	return &schema.Resource{
+		Description: "Signs a message or a precomputed digest with a Yandex KMS asymmetric signature key.",
+
+		Create: resourceYandexKMSAsymmetricSignatureCreate,
+		Read:   resourceYandexKMSAsymmetricSignatureRead,
+		Delete: resourceYandexKMSAsymmetricSignatureDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexKMSAsymmetricSignatureDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexKMSAsymmetricSignatureDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexKMSAsymmetricSignatureDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"asymmetric_signature_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the asymmetric signature key to sign with.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"message": {
+				Type:         schema.TypeString,
+				Description:  "Message to sign.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 32768),
+				ExactlyOneOf: []string{"message", "digest"},
+			},
+
+			"digest": {
+				Type:         schema.TypeString,
+				Description:  "Base64-encoded digest of the message to sign, computed with the hash function of the key signature algorithm.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
+
+			"signature": {
+				Type:        schema.TypeString,
+				Description: "Base64-encoded signature.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexKMSAsymmetricSignatureCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	keyID := d.Get("asymmetric_signature_key_id").(string)
+	client := config.sdk.KMSAsymmetricSignatureCrypto().AsymmetricSignatureCrypto()
+
+	var signature []byte
+	if v, ok := d.GetOk("digest"); ok {
+		digest, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error decoding digest: %s", err)
+		}
+
+		resp, err := client.SignHash(ctx, &kmsasymmetricsignature.AsymmetricSignHashRequest{
+			KeyId: keyID,
+			Hash:  digest,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to sign digest with KMS asymmetric signature key: %s", err)
+		}
+		signature = resp.Signature
+	} else {
+		resp, err := client.Sign(ctx, &kmsasymmetricsignature.AsymmetricSignRequest{
+			KeyId:   keyID,
+			Message: []byte(d.Get("message").(string)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to sign message with KMS asymmetric signature key: %s", err)
+		}
+		signature = resp.Signature
+	}
+
+	d.Set("signature", base64.StdEncoding.EncodeToString(signature))
+	d.SetId(fmt.Sprintf("%s/%x", keyID, sha256.Sum256(signature)))
+
+	return resourceYandexKMSAsymmetricSignatureRead(d, meta)
+}
+
+func resourceYandexKMSAsymmetricSignatureRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	_, err := config.sdk.KMSAsymmetricSignature().AsymmetricSignatureKey().Get(ctx, &kmsasymmetricsignature.GetAsymmetricSignatureKeyRequest{
+		KeyId: d.Get("asymmetric_signature_key_id").(string),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("KMS Asymmetric Signature Key %q", d.Get("asymmetric_signature_key_id").(string)))
+	}
+
+	return nil
+}
+
+func resourceYandexKMSAsymmetricSignatureDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+
+	return nil
+}
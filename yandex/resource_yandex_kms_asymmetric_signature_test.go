@@ -0,0 +1,77 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKMSAsymmetricSignature_basic(t *testing.T) {
+	t.Parallel()
+
+	keyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexKmsAsymmetricSignatureKeyAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMSAsymmetricSignature(keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("yandex_kms_asymmetric_signature.message", "signature"),
+					resource.TestCheckResourceAttrSet("yandex_kms_asymmetric_signature.digest", "signature"),
+					resource.TestCheckResourceAttr("data.yandex_kms_asymmetric_signature_verification.message", "valid", "true"),
+					resource.TestCheckResourceAttr("data.yandex_kms_asymmetric_signature_verification.digest", "valid", "true"),
+					resource.TestCheckResourceAttr("data.yandex_kms_asymmetric_signature_verification.other_message", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMSAsymmetricSignature(keyName string) string {
+	return fmt.Sprintf(`
+resource "yandex_kms_asymmetric_signature_key" "key" {
+  name                = "%s"
+  signature_algorithm = "ECDSA_NIST_P256_SHA_256"
+}
+
+data "yandex_kms_asymmetric_signature_public_key" "key" {
+  asymmetric_signature_key_id = yandex_kms_asymmetric_signature_key.key.id
+}
+
+resource "yandex_kms_asymmetric_signature" "message" {
+  asymmetric_signature_key_id = yandex_kms_asymmetric_signature_key.key.id
+  message                     = "release 1.0.0"
+}
+
+resource "yandex_kms_asymmetric_signature" "digest" {
+  asymmetric_signature_key_id = yandex_kms_asymmetric_signature_key.key.id
+  digest                      = base64sha256("release 1.0.0")
+}
+
+data "yandex_kms_asymmetric_signature_verification" "message" {
+  public_key          = data.yandex_kms_asymmetric_signature_public_key.key.public_key
+  signature_algorithm = yandex_kms_asymmetric_signature_key.key.signature_algorithm
+  message             = "release 1.0.0"
+  signature           = yandex_kms_asymmetric_signature.message.signature
+}
+
+data "yandex_kms_asymmetric_signature_verification" "digest" {
+  public_key          = data.yandex_kms_asymmetric_signature_public_key.key.public_key
+  signature_algorithm = yandex_kms_asymmetric_signature_key.key.signature_algorithm
+  digest              = base64sha256("release 1.0.0")
+  signature           = yandex_kms_asymmetric_signature.digest.signature
+}
+
+data "yandex_kms_asymmetric_signature_verification" "other_message" {
+  public_key          = data.yandex_kms_asymmetric_signature_public_key.key.public_key
+  signature_algorithm = yandex_kms_asymmetric_signature_key.key.signature_algorithm
+  message             = "release 1.0.1"
+  signature           = yandex_kms_asymmetric_signature.message.signature
+}
+`, keyName)
+}
@@ -0,0 +1,332 @@
+package yandex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
+)
+
+const (
+	yandexKMSSymmetricKeyRotationDefaultTimeout = 1 * time.Minute
+	defaultKMSVersionDestructionPendingPeriod   = "168h"
+)
+
+func resourceYandexKMSSymmetricKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Rotates a Yandex KMS symmetric key on demand and schedules destruction of its versions.",
+
+		Create: resourceYandexKMSSymmetricKeyRotationCreate,
+		Read:   resourceYandexKMSSymmetricKeyRotationRead,
+		Update: resourceYandexKMSSymmetricKeyRotationUpdate,
+		Delete: resourceYandexKMSSymmetricKeyRotationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexKMSSymmetricKeyRotationDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexKMSSymmetricKeyRotationDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexKMSSymmetricKeyRotationDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexKMSSymmetricKeyRotationDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"symmetric_key_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the symmetric key to rotate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values which rotate the key once more when changed.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"scheduled_destruction": {
+				Type:        schema.TypeSet,
+				Description: "Versions of the key to destroy. Removing a version from the set cancels its destruction if it is still pending.",
+				Optional:    true,
+				Set:         kmsSymmetricKeyVersionDestructionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the version to destroy.",
+							Required:    true,
+						},
+						"pending_period": {
+							Type:             schema.TypeString,
+							Description:      "Time between the destruction request and the actual destruction, during which the destruction can be cancelled.",
+							Optional:         true,
+							Default:          defaultKMSVersionDestructionPendingPeriod,
+							ValidateFunc:     validateParsableValue(parsePositiveDuration),
+							DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+						},
+						"destroy_at": {
+							Type:        schema.TypeString,
+							Description: "Time when the version is going to be destroyed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"primary_version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the primary version of the key.",
+				Computed:    true,
+			},
+
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Description: "Time of the last key rotation.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// kmsSymmetricKeyVersionDestructionHash ignores the computed destroy_at, so that the refreshed
+// destruction time does not change the set.
+func kmsSymmetricKeyVersionDestructionHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["version_id"].(string)))
+	if period, err := parseDuration(m["pending_period"].(string)); err == nil && period != nil {
+		buf.WriteString(formatDuration(period))
+	}
+	return hashcode.String(buf.String())
+}
+
+func resourceYandexKMSSymmetricKeyRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	keyID := d.Get("symmetric_key_id").(string)
+	if err := rotateKMSSymmetricKey(ctx, config, keyID); err != nil {
+		return err
+	}
+	d.SetId(keyID)
+
+	if err := updateKMSSymmetricKeyVersionDestructions(ctx, config, keyID, nil, d.Get("scheduled_destruction").(*schema.Set).List()); err != nil {
+		return err
+	}
+
+	return resourceYandexKMSSymmetricKeyRotationRead(d, meta)
+}
+
+func resourceYandexKMSSymmetricKeyRotationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	key, err := config.sdk.KMS().SymmetricKey().Get(ctx, &kms.GetSymmetricKeyRequest{
+		KeyId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("KMS Symmetric Key %q", d.Id()))
+	}
+
+	versions, err := listKMSSymmetricKeyVersions(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("symmetric_key_id", key.GetId())
+	d.Set("primary_version_id", key.GetPrimaryVersion().GetId())
+	d.Set("rotated_at", getTimestamp(key.GetRotatedAt()))
+
+	return d.Set("scheduled_destruction", refreshKMSSymmetricKeyVersionDestructions(d.Get("scheduled_destruction").(*schema.Set).List(), versions))
+}
+
+func resourceYandexKMSSymmetricKeyRotationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	if d.HasChange("triggers") {
+		if err := rotateKMSSymmetricKey(ctx, config, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("scheduled_destruction") {
+		o, n := d.GetChange("scheduled_destruction")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+		if err := updateKMSSymmetricKeyVersionDestructions(ctx, config, d.Id(), oldSet.Difference(newSet).List(), newSet.Difference(oldSet).List()); err != nil {
+			return err
+		}
+	}
+
+	return resourceYandexKMSSymmetricKeyRotationRead(d, meta)
+}
+
+// resourceYandexKMSSymmetricKeyRotationDelete cancels the pending destructions. The rotations can not be undone,
+// so the key keeps its primary version.
+func resourceYandexKMSSymmetricKeyRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	err := updateKMSSymmetricKeyVersionDestructions(ctx, config, d.Id(), d.Get("scheduled_destruction").(*schema.Set).List(), nil)
+	if err != nil && !isStatusWithCode(err, codes.NotFound) {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func rotateKMSSymmetricKey(ctx context.Context, config *Config, keyID string) error {
+	op, err := config.sdk.WrapOperation(config.sdk.KMS().SymmetricKey().Rotate(ctx, &kms.RotateSymmetricKeyRequest{
+		KeyId: keyID,
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to rotate KMS Symmetric Key %q: %s", keyID, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error rotating KMS Symmetric Key %q: %s", keyID, err)
+	}
+
+	if protoMetadata, err := op.Metadata(); err == nil {
+		if md, ok := protoMetadata.(*kms.RotateSymmetricKeyMetadata); ok {
+			log.Printf("[DEBUG] Rotated KMS Symmetric Key %q, new primary version is %q", keyID, md.NewPrimaryVersionId)
+		}
+	}
+
+	return nil
+}
+
+// updateKMSSymmetricKeyVersionDestructions cancels the destruction of the removed versions which is still pending
+// and schedules the destruction of the added ones.
+func updateKMSSymmetricKeyVersionDestructions(ctx context.Context, config *Config, keyID string, removed, added []interface{}) error {
+	if len(removed) == 0 && len(added) == 0 {
+		return nil
+	}
+
+	versions, err := listKMSSymmetricKeyVersions(ctx, config, keyID)
+	if err != nil {
+		return err
+	}
+	statuses := map[string]kms.SymmetricKeyVersion_Status{}
+	for _, v := range versions {
+		statuses[v.GetId()] = v.GetStatus()
+	}
+
+	cancel, schedule, err := kmsSymmetricKeyVersionDestructionChanges(keyID, statuses, removed, added)
+	if err != nil {
+		return err
+	}
+
+	for _, versionID := range cancel {
+		op, err := config.sdk.WrapOperation(config.sdk.KMS().SymmetricKey().CancelVersionDestruction(ctx, &kms.CancelSymmetricKeyVersionDestructionRequest{
+			KeyId:     keyID,
+			VersionId: versionID,
+		}))
+		if err == nil {
+			err = op.Wait(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("Error cancelling destruction of KMS Symmetric Key %q version %q: %s", keyID, versionID, err)
+		}
+	}
+
+	for _, m := range schedule {
+		versionID := m["version_id"].(string)
+		pendingPeriod, err := parsePositiveDuration(m["pending_period"].(string))
+		if err != nil {
+			return err
+		}
+
+		op, err := config.sdk.WrapOperation(config.sdk.KMS().SymmetricKey().ScheduleVersionDestruction(ctx, &kms.ScheduleSymmetricKeyVersionDestructionRequest{
+			KeyId:         keyID,
+			VersionId:     versionID,
+			PendingPeriod: pendingPeriod,
+		}))
+		if err == nil {
+			err = op.Wait(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("Error scheduling destruction of KMS Symmetric Key %q version %q: %s", keyID, versionID, err)
+		}
+	}
+
+	return nil
+}
+
+// kmsSymmetricKeyVersionDestructionChanges returns the versions whose pending destruction is cancelled and the
+// destructions to schedule. A cancelled version is active again, so a destruction whose pending_period changed
+// is cancelled and then scheduled with the new period.
+func kmsSymmetricKeyVersionDestructionChanges(keyID string, statuses map[string]kms.SymmetricKeyVersion_Status, removed, added []interface{}) (cancel []string, schedule []map[string]interface{}, err error) {
+	for _, r := range removed {
+		versionID := r.(map[string]interface{})["version_id"].(string)
+		if statuses[versionID] != kms.SymmetricKeyVersion_SCHEDULED_FOR_DESTRUCTION {
+			log.Printf("[DEBUG] Destruction of KMS Symmetric Key %q version %q is not pending, nothing to cancel", keyID, versionID)
+			continue
+		}
+		cancel = append(cancel, versionID)
+		statuses[versionID] = kms.SymmetricKeyVersion_ACTIVE
+	}
+
+	for _, a := range added {
+		m := a.(map[string]interface{})
+		versionID := m["version_id"].(string)
+		status, ok := statuses[versionID]
+		if !ok {
+			return nil, nil, fmt.Errorf("KMS Symmetric Key %q has no version %q", keyID, versionID)
+		}
+		switch status {
+		case kms.SymmetricKeyVersion_ACTIVE:
+		case kms.SymmetricKeyVersion_SCHEDULED_FOR_DESTRUCTION, kms.SymmetricKeyVersion_DESTROYED:
+			log.Printf("[DEBUG] KMS Symmetric Key %q version %q is %s, not scheduling its destruction", keyID, versionID, status)
+			continue
+		default:
+			// the version would be left out of the state on refresh and planned again forever
+			return nil, nil, fmt.Errorf("Unable to schedule destruction of KMS Symmetric Key %q version %q in status %s", keyID, versionID, status)
+		}
+		schedule = append(schedule, m)
+	}
+
+	return cancel, schedule, nil
+}
+
+// refreshKMSSymmetricKeyVersionDestructions sets the destruction time of the versions in the state. Versions whose
+// destruction was cancelled outside of Terraform are left out, so that the destruction is planned again.
+func refreshKMSSymmetricKeyVersionDestructions(destructions []interface{}, versions []*kms.SymmetricKeyVersion) []interface{} {
+	byID := map[string]*kms.SymmetricKeyVersion{}
+	for _, v := range versions {
+		byID[v.GetId()] = v
+	}
+
+	result := make([]interface{}, 0, len(destructions))
+	for _, raw := range destructions {
+		m := raw.(map[string]interface{})
+		v, ok := byID[m["version_id"].(string)]
+		if !ok {
+			continue
+		}
+		switch v.GetStatus() {
+		case kms.SymmetricKeyVersion_SCHEDULED_FOR_DESTRUCTION, kms.SymmetricKeyVersion_DESTROYED:
+			result = append(result, map[string]interface{}{
+				"version_id":     m["version_id"],
+				"pending_period": m["pending_period"],
+				"destroy_at":     getTimestamp(v.GetDestroyAt()),
+			})
+		}
+	}
+	return result
+}
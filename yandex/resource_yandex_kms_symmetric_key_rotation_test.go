@@ -0,0 +1,156 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/kms/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAccKMSSymmetricKeyRotation_basic(t *testing.T) {
+	t.Parallel()
+
+	keyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	rotationName := "yandex_kms_symmetric_key_rotation.rotation"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKMSSymmetricKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMSSymmetricKeyRotation(keyName, "1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(rotationName, "primary_version_id"),
+					resource.TestCheckResourceAttrSet(rotationName, "rotated_at"),
+					resource.TestCheckResourceAttr("data.yandex_kms_symmetric_key_versions.versions", "versions.#", "2"),
+				),
+			},
+			{
+				Config: testAccKMSSymmetricKeyRotation(keyName, "1", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rotationName, "scheduled_destruction.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(rotationName, "scheduled_destruction.*", map[string]string{"pending_period": "24h"}),
+					resource.TestCheckResourceAttr("data.yandex_kms_symmetric_key_versions.versions", "versions.#", "2"),
+				),
+			},
+			{
+				Config: testAccKMSSymmetricKeyRotation(keyName, "2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rotationName, "scheduled_destruction.#", "0"),
+					resource.TestCheckResourceAttr("data.yandex_kms_symmetric_key_versions.versions", "versions.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKMSSymmetricKeyRotation(keyName, trigger string, destroyOldest bool) string {
+	destruction := ""
+	if destroyOldest {
+		destruction = `
+  scheduled_destruction {
+    version_id     = [for v in data.yandex_kms_symmetric_key_versions.initial.versions : v.id if !v.primary][0]
+    pending_period = "24h"
+  }`
+	}
+
+	return fmt.Sprintf(`
+resource "yandex_kms_symmetric_key" "key" {
+  name = "%s"
+}
+
+resource "yandex_kms_symmetric_key_rotation" "rotation" {
+  symmetric_key_id = yandex_kms_symmetric_key.key.id
+
+  triggers = {
+    rotation = "%s"
+  }
+%s
+}
+
+data "yandex_kms_symmetric_key_versions" "initial" {
+  symmetric_key_id = yandex_kms_symmetric_key.key.id
+}
+
+data "yandex_kms_symmetric_key_versions" "versions" {
+  symmetric_key_id = yandex_kms_symmetric_key_rotation.rotation.symmetric_key_id
+}
+`, keyName, trigger, destruction)
+}
+
+func TestRefreshKMSSymmetricKeyVersionDestructions(t *testing.T) {
+	destroyAt := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+	versions := []*kms.SymmetricKeyVersion{
+		{Id: "scheduled", Status: kms.SymmetricKeyVersion_SCHEDULED_FOR_DESTRUCTION, DestroyAt: timestamppb.New(destroyAt)},
+		{Id: "destroyed", Status: kms.SymmetricKeyVersion_DESTROYED, DestroyAt: timestamppb.New(destroyAt)},
+		{Id: "cancelled", Status: kms.SymmetricKeyVersion_ACTIVE},
+	}
+	destructions := []interface{}{
+		map[string]interface{}{"version_id": "scheduled", "pending_period": "168h", "destroy_at": ""},
+		map[string]interface{}{"version_id": "destroyed", "pending_period": "24h", "destroy_at": ""},
+		map[string]interface{}{"version_id": "cancelled", "pending_period": "168h", "destroy_at": ""},
+		map[string]interface{}{"version_id": "missing", "pending_period": "168h", "destroy_at": ""},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{"version_id": "scheduled", "pending_period": "168h", "destroy_at": destroyAt.Format(defaultTimeFormat)},
+		map[string]interface{}{"version_id": "destroyed", "pending_period": "24h", "destroy_at": destroyAt.Format(defaultTimeFormat)},
+	}
+
+	if actual := refreshKMSSymmetricKeyVersionDestructions(destructions, versions); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("refreshKMSSymmetricKeyVersionDestructions() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestKMSSymmetricKeyVersionDestructionChanges(t *testing.T) {
+	statuses := map[string]kms.SymmetricKeyVersion_Status{
+		"scheduled": kms.SymmetricKeyVersion_SCHEDULED_FOR_DESTRUCTION,
+		"active":    kms.SymmetricKeyVersion_ACTIVE,
+		"destroyed": kms.SymmetricKeyVersion_DESTROYED,
+	}
+	removed := []interface{}{
+		map[string]interface{}{"version_id": "scheduled", "pending_period": "168h", "destroy_at": ""},
+		map[string]interface{}{"version_id": "destroyed", "pending_period": "24h", "destroy_at": ""},
+	}
+	added := []interface{}{
+		// pending_period changed
+		map[string]interface{}{"version_id": "scheduled", "pending_period": "336h", "destroy_at": ""},
+		map[string]interface{}{"version_id": "active", "pending_period": "24h", "destroy_at": ""},
+		map[string]interface{}{"version_id": "destroyed", "pending_period": "48h", "destroy_at": ""},
+	}
+
+	cancel, schedule, err := kmsSymmetricKeyVersionDestructionChanges("key", statuses, removed, added)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []string{"scheduled"}; !reflect.DeepEqual(cancel, expected) {
+		t.Errorf("cancel = %v, expected %v", cancel, expected)
+	}
+	if expected := []map[string]interface{}{added[0].(map[string]interface{}), added[1].(map[string]interface{})}; !reflect.DeepEqual(schedule, expected) {
+		t.Errorf("schedule = %v, expected the changed and the new destructions %v", schedule, expected)
+	}
+
+	_, _, err = kmsSymmetricKeyVersionDestructionChanges("key", map[string]kms.SymmetricKeyVersion_Status{}, nil, added[1:2])
+	if err == nil {
+		t.Errorf("expected an error for a missing version")
+	}
+}
+
+func TestKMSSymmetricKeyVersionDestructionHash(t *testing.T) {
+	a := map[string]interface{}{"version_id": "v1", "pending_period": "168h", "destroy_at": ""}
+	b := map[string]interface{}{"version_id": "v1", "pending_period": "168h0m0s", "destroy_at": "2024-03-08T00:00:00Z"}
+	c := map[string]interface{}{"version_id": "v1", "pending_period": "24h", "destroy_at": ""}
+
+	if kmsSymmetricKeyVersionDestructionHash(a) != kmsSymmetricKeyVersionDestructionHash(b) {
+		t.Errorf("expected the hash to ignore destroy_at and the duration format")
+	}
+	if kmsSymmetricKeyVersionDestructionHash(a) == kmsSymmetricKeyVersionDestructionHash(c) {
+		t.Errorf("expected the hash to depend on pending_period")
+	}
+}
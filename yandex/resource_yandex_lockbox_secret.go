@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/lockbox/v1"
@@ -16,7 +17,8 @@ import (
 )
 
 const (
-	yandexLockboxSecretDefaultTimeout = 1 * time.Minute
+	yandexLockboxSecretDefaultTimeout             = 1 * time.Minute
+	defaultLockboxVersionDestructionPendingPeriod = "168h"
 )
 
 func resourceYandexLockboxSecret() *schema.Resource {
@@ -87,11 +89,66 @@ func resourceYandexLockboxSecret() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 100),
 			},
 
+			"password_payload_specification": lockboxPasswordPayloadSpecificationSchema(false),
+
+			"version_retention": {
+				Type:        schema.TypeList,
+				Description: "Schedules destruction of the old active versions of the secret.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keep_last": {
+							Type:         schema.TypeInt,
+							Description:  "Number of the newest active versions to keep.",
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"pending_period": {
+							Type:             schema.TypeString,
+							Description:      "Time between the destruction request and the actual destruction, during which the destruction can be cancelled.",
+							Optional:         true,
+							Default:          defaultLockboxVersionDestructionPendingPeriod,
+							ValidateFunc:     validateParsableValue(parsePositiveDuration),
+							DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+						},
+					},
+				},
+			},
+
+			"versions_over_retention": {
+				Type:        schema.TypeList,
+				Description: "IDs of the active versions beyond `version_retention`, which are scheduled for destruction at the next apply.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"current_version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the current version. Setting it to a previous version rolls the secret back to it.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"rollback_version_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the version created as a copy of `current_version_id` on rollback.",
+				Computed:    true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("password_payload_specification", func(ctx context.Context, old, new, meta interface{}) bool {
+				// generation can not be turned off once the secret has it
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
+			resourceYandexLockboxSecretVersionRetentionDiff,
+		),
 	}
 }
 
@@ -114,6 +171,24 @@ func resourceYandexLockboxSecretCreate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[INFO] creating Lockbox secret: %s", protojson.Format(req))
 
+	if spec := expandLockboxPasswordPayloadSpecification(d.Get("password_payload_specification")); spec != nil {
+		id, err := createLockboxSecretWithPasswordPayload(ctx, config, req, spec)
+		if id != "" {
+			d.SetId(id)
+		}
+		if err != nil {
+			return diag.Errorf("error while creating secret with password payload specification: %s", err)
+		}
+
+		log.Printf("[INFO] created Lockbox secret with ID: %s", d.Id())
+
+		if err := updateLockboxSecretVersions(ctx, config, d); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return resourceYandexLockboxSecretRead(ctx, d, meta)
+	}
+
 	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().Create(ctx, req))
 	if err != nil {
 		return diag.Errorf("error while requesting API to create secret: %s", err)
@@ -143,6 +218,10 @@ func resourceYandexLockboxSecretCreate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[INFO] created Lockbox secret with ID: %s", d.Id())
 
+	if err := updateLockboxSecretVersions(ctx, config, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceYandexLockboxSecretRead(ctx, d, meta)
 }
 
@@ -215,6 +294,45 @@ func yandexLockboxSecretRead(id string, isDataSource bool, ctx context.Context,
 		return diag.FromErr(err)
 	}
 
+	// The specification is only available in the REST API.
+	spec, err := getLockboxPasswordPayloadSpecification(ctx, config, id)
+	if err != nil {
+		return diag.Errorf("error while reading password payload specification of secret %q: %s", id, err)
+	}
+	flat, err := flattenLockboxPasswordPayloadSpecification(spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("password_payload_specification", flat); err != nil {
+		log.Printf("[ERROR] failed set field password_payload_specification: %s", err)
+		return diag.FromErr(err)
+	}
+
+	if !isDataSource {
+		currentVersionID := secret.GetCurrentVersion().GetId()
+		// the rollback copy stands for the pinned version, until another version is added
+		if rollbackVersionID := d.Get("rollback_version_id").(string); rollbackVersionID != "" && rollbackVersionID == currentVersionID {
+			currentVersionID = d.Get("current_version_id").(string)
+		}
+		if err := d.Set("current_version_id", currentVersionID); err != nil {
+			log.Printf("[ERROR] failed set field current_version_id: %s", err)
+			return diag.FromErr(err)
+		}
+
+		overRetention := []string{}
+		if keepLast, ok := d.GetOk("version_retention.0.keep_last"); ok {
+			versions, err := listLockboxSecretVersions(ctx, config, id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			overRetention = lockboxVersionsOverRetention(versions, keepLast.(int), secret.GetCurrentVersion().GetId(), currentVersionID)
+		}
+		if err := d.Set("versions_over_retention", overRetention); err != nil {
+			log.Printf("[ERROR] failed set field versions_over_retention: %s", err)
+			return diag.FromErr(err)
+		}
+	}
+
 	log.Printf("[INFO] read Lockbox secret with ID: %s", d.Id())
 
 	return nil
@@ -223,6 +341,24 @@ func yandexLockboxSecretRead(id string, isDataSource bool, ctx context.Context,
 func resourceYandexLockboxSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
+	if d.HasChange("password_payload_specification") {
+		spec := expandLockboxPasswordPayloadSpecification(d.Get("password_payload_specification"))
+
+		log.Printf("[INFO] updating password payload specification of Lockbox secret with ID: %s", d.Id())
+
+		if err := updateLockboxPasswordPayloadSpecification(ctx, config, d.Id(), spec); err != nil {
+			return diag.Errorf("error while updating password payload specification of secret: %s", err)
+		}
+	}
+
+	paths := generateFieldMasks(d, resourceYandexLockboxSecretUpdateFieldsMap)
+	if len(paths) == 0 {
+		if err := updateLockboxSecretVersions(ctx, config, d); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceYandexLockboxSecretRead(ctx, d, meta)
+	}
+
 	req := &lockbox.UpdateSecretRequest{
 		SecretId:           d.Id(),
 		Name:               d.Get("name").(string),
@@ -230,7 +366,7 @@ func resourceYandexLockboxSecretUpdate(ctx context.Context, d *schema.ResourceDa
 		Labels:             expandStringStringMap(d.Get("labels").(map[string]interface{})),
 		DeletionProtection: d.Get("deletion_protection").(bool),
 		UpdateMask: &fieldmaskpb.FieldMask{
-			Paths: generateFieldMasks(d, resourceYandexLockboxSecretUpdateFieldsMap),
+			Paths: paths,
 		},
 	}
 
@@ -253,6 +389,10 @@ func resourceYandexLockboxSecretUpdate(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("[INFO] updated Lockbox secret with ID: %s", d.Id())
 
+	if err := updateLockboxSecretVersions(ctx, config, d); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceYandexLockboxSecretRead(ctx, d, meta)
 }
 
@@ -285,6 +425,124 @@ func resourceYandexLockboxSecretDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// resourceYandexLockboxSecretVersionRetentionDiff plans the rollback copy and the destruction of the versions which got beyond the retention
+// since the last apply, e.g. because yandex_lockbox_secret_version added a new one.
+func resourceYandexLockboxSecretVersionRetentionDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("current_version_id") {
+		if err := d.SetNewComputed("rollback_version_id"); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("version_retention"); !ok || d.Id() == "" {
+		return nil
+	}
+	if len(d.Get("versions_over_retention").([]interface{})) == 0 {
+		return nil
+	}
+	return d.SetNew("versions_over_retention", []string{})
+}
+
+// updateLockboxSecretVersions rolls the secret back to the pinned current_version_id and schedules destruction
+// of the versions beyond the retention.
+func updateLockboxSecretVersions(ctx context.Context, config *Config, d *schema.ResourceData) error {
+	secret, err := config.sdk.LockboxSecret().Secret().Get(ctx, &lockbox.GetSecretRequest{
+		SecretId: d.Id(),
+	})
+	if err != nil {
+		return fmt.Errorf("error while reading secret %q: %w", d.Id(), err)
+	}
+	currentVersionID := secret.GetCurrentVersion().GetId()
+
+	pinnedVersionID := d.Get("current_version_id").(string)
+	rolledBack := d.Get("rollback_version_id").(string) == currentVersionID && !d.HasChange("current_version_id")
+	if pinnedVersionID != "" && pinnedVersionID != currentVersionID && !rolledBack {
+		rollbackVersionID, err := rollbackLockboxSecret(ctx, config, d.Id(), pinnedVersionID)
+		if err != nil {
+			return err
+		}
+		d.Set("rollback_version_id", rollbackVersionID)
+		currentVersionID = rollbackVersionID
+	}
+
+	keepLast, ok := d.GetOk("version_retention.0.keep_last")
+	if !ok {
+		return nil
+	}
+	pendingPeriod, err := parsePositiveDuration(d.Get("version_retention.0.pending_period").(string))
+	if err != nil {
+		return err
+	}
+
+	versions, err := listLockboxSecretVersions(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, versionID := range lockboxVersionsOverRetention(versions, keepLast.(int), currentVersionID, pinnedVersionID) {
+		req := &lockbox.ScheduleVersionDestructionRequest{
+			SecretId:      d.Id(),
+			VersionId:     versionID,
+			PendingPeriod: pendingPeriod,
+		}
+
+		log.Printf("[INFO] scheduling destruction of Lockbox version: %s", protojson.Format(req))
+
+		op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().ScheduleVersionDestruction(ctx, req))
+		if err == nil {
+			err = op.Wait(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("error while scheduling destruction of secret %q version %q: %s", d.Id(), versionID, err)
+		}
+	}
+
+	return nil
+}
+
+// rollbackLockboxSecret adds a copy of the version, which becomes the current one. Lockbox has no other way to make
+// a previous version current.
+func rollbackLockboxSecret(ctx context.Context, config *Config, secretID, versionID string) (string, error) {
+	version, err := getLockboxSecretVersion(ctx, config, secretID, versionID)
+	if err != nil {
+		return "", fmt.Errorf("error while reading secret %q version %q to roll back to: %w", secretID, versionID, err)
+	}
+	if version.GetStatus() != lockbox.Version_ACTIVE {
+		return "", fmt.Errorf("can not roll secret %q back to version %q, it is %s", secretID, versionID, version.GetStatus())
+	}
+
+	req := &lockbox.AddVersionRequest{
+		SecretId:      secretID,
+		BaseVersionId: versionID,
+		Description:   fmt.Sprintf("Rollback to version %s", versionID),
+	}
+
+	log.Printf("[INFO] rolling Lockbox secret back: %s", protojson.Format(req))
+
+	op, err := config.sdk.WrapOperation(config.sdk.LockboxSecret().Secret().AddVersion(ctx, req))
+	if err != nil {
+		return "", fmt.Errorf("error while requesting API to roll secret %q back: %s", secretID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return "", fmt.Errorf("error while getting operation metadata of add secret version: %s", err)
+	}
+
+	md, ok := protoMetadata.(*lockbox.AddVersionMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get Version ID from add version operation metadata")
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return md.VersionId, fmt.Errorf("error while waiting operation to roll secret %q back: %s", secretID, err)
+	}
+
+	log.Printf("[INFO] rolled Lockbox secret with ID: %s back to version %s with version %s", secretID, versionID, md.VersionId)
+
+	return md.VersionId, nil
+}
+
 var resourceYandexLockboxSecretUpdateFieldsMap = map[string]string{
 	"name":                "name",
 	"description":         "description",
@@ -2,10 +2,13 @@ package yandex
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -131,6 +134,253 @@ func TestAccLockboxSecret_kms(t *testing.T) {
 	})
 }
 
+func TestAccLockboxSecret_passwordPayloadSpecification(t *testing.T) {
+	secretName := "a" + acctest.RandString(10)
+	secretResource := "yandex_lockbox_secret.generated_secret"
+	versionResource := "yandex_lockbox_secret_version.generated_version"
+	dataSource := "data.yandex_lockbox_secret.generated_secret"
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				// Create secret with a generated version
+				Config: testAccLockboxSecretWithPasswordPayloadSpecification(secretName, 20, `included_punctuation = "-_"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(secretResource, nil),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.#", "1"),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.0.password_key", "password"),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.0.length", "20"),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.0.include_digits", "true"),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.0.included_punctuation", "-_"),
+				),
+			},
+			{
+				// Update the specification and generate a new version
+				Config: testAccLockboxSecretWithPasswordPayloadSpecification(secretName, 32, `include_punctuation = false`) +
+					testAccLockboxSecretGeneratedVersion(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(versionResource, nil),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.0.length", "32"),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.0.include_punctuation", "false"),
+					resource.TestCheckResourceAttr(secretResource, "password_payload_specification.0.included_punctuation", ""),
+					resource.TestCheckNoResourceAttr(versionResource, "entries.#"),
+				),
+			},
+			{
+				// The data source exposes the key only
+				Config: testAccLockboxSecretWithPasswordPayloadSpecification(secretName, 32, `include_punctuation = false`) +
+					testAccLockboxSecretGeneratedVersion() + `
+data "yandex_lockbox_secret" "generated_secret" {
+  secret_id  = yandex_lockbox_secret.generated_secret.id
+  depends_on = [yandex_lockbox_secret_version.generated_version]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSource, "current_version.0.id", versionResource, "id"),
+					resource.TestCheckResourceAttr(dataSource, "current_version.0.payload_entry_keys.#", "1"),
+					resource.TestCheckResourceAttr(dataSource, "current_version.0.payload_entry_keys.0", "password"),
+					resource.TestCheckResourceAttr(dataSource, "password_payload_specification.0.password_key", "password"),
+					resource.TestCheckResourceAttr(dataSource, "password_payload_specification.0.length", "32"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLockboxSecret_versions(t *testing.T) {
+	secretName := "a" + acctest.RandString(10)
+	secretResource := "yandex_lockbox_secret.versioned_secret"
+	versionResource := "yandex_lockbox_secret_version.versioned_version"
+	dataSource := "data.yandex_lockbox_secret_versions.versioned_secret"
+	firstVersionID := ""
+	secondVersionID := ""
+	thirdVersionID := ""
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckYandexLockboxSecretAllDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLockboxSecretVersions(secretName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(versionResource, &firstVersionID),
+					resource.TestCheckResourceAttr(secretResource, "version_retention.0.keep_last", "2"),
+					resource.TestCheckResourceAttr(secretResource, "version_retention.0.pending_period", "168h"),
+				),
+			},
+			{
+				Config: testAccLockboxSecretVersions(secretName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(versionResource, &secondVersionID),
+				),
+			},
+			{
+				// The first version gets beyond the retention after the third one is added
+				Config: testAccLockboxSecretVersions(secretName, "third"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexLockboxResourceExists(versionResource, &thirdVersionID),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccLockboxSecretVersions(secretName, "third"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(secretResource, "versions_over_retention.#", "0"),
+					resource.TestCheckResourceAttr(dataSource, "versions.#", "3"),
+					testAccCheckLockboxSecretVersionsElem(dataSource, &firstVersionID, "status", lockbox.Version_SCHEDULED_FOR_DESTRUCTION.String()),
+					testAccCheckLockboxSecretVersionsElem(dataSource, &secondVersionID, "status", lockbox.Version_ACTIVE.String()),
+					testAccCheckLockboxSecretVersionsElem(dataSource, &thirdVersionID, "current", "true"),
+				),
+			},
+			{
+				// Roll back to the second version
+				Config: testAccLockboxSecretVersionsPinned(secretName, "third"),
+				ConfigVariables: config.Variables{
+					"current_version_id": testAccLockboxVersionIDVariable{&secondVersionID},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						return resource.TestCheckResourceAttr(secretResource, "current_version_id", secondVersionID)(s)
+					},
+					resource.TestCheckResourceAttrSet(secretResource, "rollback_version_id"),
+					resource.TestCheckResourceAttrPair(dataSource, "current_version_id", secretResource, "rollback_version_id"),
+					resource.TestCheckResourceAttr(dataSource, "versions.#", "4"),
+					testAccCheckLockboxSecretVersionsElem(dataSource, &secondVersionID, "status", lockbox.Version_ACTIVE.String()),
+				),
+			},
+		},
+	})
+}
+
+// testAccLockboxVersionIDVariable is resolved when the step runs, after the ID is stored by a previous step.
+type testAccLockboxVersionIDVariable struct {
+	id *string
+}
+
+func (v testAccLockboxVersionIDVariable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*v.id)
+}
+
+func testAccCheckLockboxSecretVersionsElem(dataSource string, versionID *string, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.TestCheckTypeSetElemNestedAttrs(dataSource, "versions.*", map[string]string{
+			"id": *versionID,
+			key:  value,
+		})(s)
+	}
+}
+
+func testAccLockboxSecretVersions(name, versionDesc string) string {
+	return testAccLockboxSecretVersionsConfig(name, versionDesc, "")
+}
+
+func testAccLockboxSecretVersionsPinned(name, versionDesc string) string {
+	return `
+variable "current_version_id" {
+  type = string
+}
+` + testAccLockboxSecretVersionsConfig(name, versionDesc, "current_version_id = var.current_version_id")
+}
+
+func testAccLockboxSecretVersionsConfig(name, versionDesc, extra string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "versioned_secret" {
+  name = "%v"
+  %s
+
+  version_retention {
+    keep_last = 2
+  }
+}
+
+resource "yandex_lockbox_secret_version" "versioned_version" {
+  secret_id   = yandex_lockbox_secret.versioned_secret.id
+  description = "%v"
+
+  entries {
+    key        = "key"
+    text_value = "%v"
+  }
+}
+
+data "yandex_lockbox_secret_versions" "versioned_secret" {
+  secret_id  = yandex_lockbox_secret.versioned_secret.id
+  depends_on = [yandex_lockbox_secret.versioned_secret, yandex_lockbox_secret_version.versioned_version]
+}
+`, name, extra, versionDesc, versionDesc)
+}
+
+func testLockboxSecretVersionsState(overRetention ...string) *sdkterraform.InstanceState {
+	attributes := map[string]string{
+		"id":                                 "e6q00000000000secret",
+		"folder_id":                          "b1g0000000000folder",
+		"version_retention.#":                "1",
+		"version_retention.0.keep_last":      "2",
+		"version_retention.0.pending_period": "168h",
+		"current_version_id":                 "e6q000000000version5",
+		"rollback_version_id":                "",
+		"versions_over_retention.#":          fmt.Sprint(len(overRetention)),
+	}
+	for i, id := range overRetention {
+		attributes[fmt.Sprintf("versions_over_retention.%d", i)] = id
+	}
+	return &sdkterraform.InstanceState{
+		ID:         "e6q00000000000secret",
+		Attributes: attributes,
+	}
+}
+
+func testLockboxSecretVersionsConfig(currentVersionID string) *sdkterraform.ResourceConfig {
+	raw := map[string]interface{}{
+		"folder_id": "b1g0000000000folder",
+		"version_retention": []interface{}{
+			map[string]interface{}{
+				"keep_last": 2,
+			},
+		},
+	}
+	if currentVersionID != "" {
+		raw["current_version_id"] = currentVersionID
+	}
+	return sdkterraform.NewResourceConfigRaw(raw)
+}
+
+func TestLockboxSecretVersionsDiff(t *testing.T) {
+	r := resourceYandexLockboxSecret()
+
+	diff, err := r.Diff(context.Background(), testLockboxSecretVersionsState(), testLockboxSecretVersionsConfig(""), &Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("expected no diff when all versions are retained, got: %#v", diff.Attributes)
+	}
+
+	diff, err = r.Diff(context.Background(), testLockboxSecretVersionsState("e6q000000000version1"), testLockboxSecretVersionsConfig(""), &Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diff.Empty() || diff.RequiresNew() {
+		t.Fatalf("expected in-place update to destroy the versions over retention, got: %#v", diff)
+	}
+	if attr := diff.Attributes["versions_over_retention.#"]; attr == nil || attr.New != "0" {
+		t.Errorf("expected versions_over_retention to be emptied, got: %#v", attr)
+	}
+
+	diff, err = r.Diff(context.Background(), testLockboxSecretVersionsState(), testLockboxSecretVersionsConfig("e6q000000000version3"), &Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attr := diff.Attributes["current_version_id"]; attr == nil || attr.New != "e6q000000000version3" {
+		t.Errorf("expected current_version_id to change, got: %#v", attr)
+	}
+	if attr := diff.Attributes["rollback_version_id"]; attr == nil || !attr.NewComputed {
+		t.Errorf("expected rollback_version_id to be computed, got: %#v", attr)
+	}
+}
+
 func testAccLockboxSecretBasic(name, desc string) string {
 	return fmt.Sprintf(`
 resource "yandex_lockbox_secret" "basic_secret" {
@@ -167,6 +417,34 @@ resource "yandex_lockbox_secret" "minimal_secret" {
 `
 }
 
+func testAccLockboxSecretWithPasswordPayloadSpecification(name string, length int, extra string) string {
+	return fmt.Sprintf(`
+resource "yandex_lockbox_secret" "generated_secret" {
+  name = "%v"
+
+  password_payload_specification {
+    password_key = "password"
+    length       = %d
+    %s
+  }
+}
+`, name, length, extra)
+}
+
+func testAccLockboxSecretGeneratedVersion() string {
+	return `
+resource "yandex_lockbox_secret_version" "generated_version" {
+  secret_id = yandex_lockbox_secret.generated_secret.id
+
+  password_payload_specification {
+    password_key        = yandex_lockbox_secret.generated_secret.password_payload_specification[0].password_key
+    length              = yandex_lockbox_secret.generated_secret.password_payload_specification[0].length
+    include_punctuation = yandex_lockbox_secret.generated_secret.password_payload_specification[0].include_punctuation
+  }
+}
+`
+}
+
 func testAccLockboxSecretWithKmsKey(name string) string {
 	return fmt.Sprintf(`
 resource "yandex_lockbox_secret" "kms_secret" {
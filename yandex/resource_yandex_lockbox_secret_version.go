@@ -82,10 +82,13 @@ func resourceYandexLockboxSecretVersion() *schema.Resource {
 						},
 					},
 				},
-				ForceNew: true,
-				Required: true,
+				ForceNew:     true,
+				Optional:     true,
+				ExactlyOneOf: []string{"entries", "password_payload_specification"},
 			},
 
+			"password_payload_specification": lockboxPasswordPayloadSpecificationSchema(true),
+
 			"secret_id": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -106,6 +109,20 @@ func resourceYandexLockboxSecretVersion() *schema.Resource {
 func resourceYandexLockboxSecretVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
+	if spec := expandLockboxPasswordPayloadSpecification(d.Get("password_payload_specification")); spec != nil {
+		id, err := addLockboxGeneratedSecretVersion(ctx, config, d.Get("secret_id").(string), d.Get("description").(string), spec)
+		if id != "" {
+			d.SetId(id)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[INFO] added generated Lockbox version with ID: %s", d.Id())
+
+		return resourceYandexLockboxSecretVersionRead(ctx, d, meta)
+	}
+
 	versionPayloadEntries, err := expandLockboxSecretVersionEntriesSlice(ctx, d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -179,6 +196,17 @@ func resourceYandexLockboxSecretVersionRead(ctx context.Context, d *schema.Resou
 
 	log.Printf("[INFO] reading Lockbox version: %s", protojson.Format(req))
 
+	if _, ok := d.GetOk("password_payload_specification"); ok {
+		// the generated payload is never requested
+		if _, err := getLockboxSecretVersion(ctx, config, req.SecretId, id); err != nil {
+			return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("secret version %q", id)))
+		}
+
+		log.Printf("[INFO] read Lockbox version with ID: %s", id)
+
+		return nil
+	}
+
 	_, err := config.sdk.LockboxPayload().Payload().Get(ctx, req)
 	if err != nil {
 		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("secret version payload %q", id)))
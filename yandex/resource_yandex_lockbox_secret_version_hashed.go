@@ -50,6 +50,8 @@ func resourceYandexLockboxSecretVersionHashed() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+
+			"password_payload_specification": lockboxSecretVersionHashedPasswordPayloadSpecificationSchema(),
 		}),
 	}
 }
@@ -57,6 +59,20 @@ func resourceYandexLockboxSecretVersionHashed() *schema.Resource {
 func resourceYandexLockboxSecretVersionHashedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 
+	if spec := expandLockboxPasswordPayloadSpecification(d.Get("password_payload_specification")); spec != nil {
+		id, err := addLockboxGeneratedSecretVersion(ctx, config, d.Get("secret_id").(string), d.Get("description").(string), spec)
+		if id != "" {
+			d.SetId(id)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		log.Printf("[INFO] added generated Lockbox version with ID: %s", d.Id())
+
+		return resourceYandexLockboxSecretVersionHashedRead(ctx, d, meta)
+	}
+
 	versionPayloadEntries, err := expandLockboxSecretVersionSafeEntries(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -130,6 +146,17 @@ func resourceYandexLockboxSecretVersionHashedRead(ctx context.Context, d *schema
 
 	log.Printf("[INFO] reading Lockbox version: %s", protojson.Format(req))
 
+	if _, ok := d.GetOk("password_payload_specification"); ok {
+		// the generated payload is never requested
+		if _, err := getLockboxSecretVersion(ctx, config, req.SecretId, id); err != nil {
+			return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("secret version %q", id)))
+		}
+
+		log.Printf("[INFO] read Lockbox version with ID: %s", id)
+
+		return nil
+	}
+
 	_, err := config.sdk.LockboxPayload().Payload().Get(ctx, req)
 	if err != nil {
 		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("secret version payload %q", id)))
@@ -170,6 +197,15 @@ func addSafeEntries(n int, schemaMap map[string]*schema.Schema) map[string]*sche
 	return schemaMap
 }
 
+// The generated version has no other entries.
+func lockboxSecretVersionHashedPasswordPayloadSpecificationSchema() *schema.Schema {
+	s := lockboxPasswordPayloadSpecificationSchema(true)
+	for i := 1; i <= maxSafeEntries; i++ {
+		s.ConflictsWith = append(s.ConflictsWith, keyName(i))
+	}
+	return s
+}
+
 func keyName(i int) string {
 	return fmt.Sprintf("key_%d", i)
 }
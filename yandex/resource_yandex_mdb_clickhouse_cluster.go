@@ -15,6 +15,7 @@ import (
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 )
 
 const (
@@ -101,27 +102,7 @@ var schemaConfig = map[string]*schema.Schema{
 		Optional: true,
 		Computed: true,
 		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"replicated_deduplication_window":                           {Type: schema.TypeInt, Optional: true, Computed: true},
-				"replicated_deduplication_window_seconds":                   {Type: schema.TypeInt, Optional: true, Computed: true},
-				"parts_to_delay_insert":                                     {Type: schema.TypeInt, Optional: true, Computed: true},
-				"parts_to_throw_insert":                                     {Type: schema.TypeInt, Optional: true, Computed: true},
-				"max_replicated_merges_in_queue":                            {Type: schema.TypeInt, Optional: true, Computed: true},
-				"number_of_free_entries_in_pool_to_lower_max_size_of_merge": {Type: schema.TypeInt, Optional: true, Computed: true},
-				"max_bytes_to_merge_at_min_space_in_pool":                   {Type: schema.TypeInt, Optional: true, Computed: true},
-				"min_bytes_for_wide_part":                                   {Type: schema.TypeInt, Optional: true, Computed: true},
-				"min_rows_for_wide_part":                                    {Type: schema.TypeInt, Optional: true, Computed: true},
-				"ttl_only_drop_parts":                                       {Type: schema.TypeBool, Optional: true, Computed: true},
-				"merge_with_ttl_timeout":                                    {Type: schema.TypeInt, Optional: true, Computed: true},
-				"merge_with_recompression_ttl_timeout":                      {Type: schema.TypeInt, Optional: true, Computed: true},
-				"max_parts_in_total":                                        {Type: schema.TypeInt, Optional: true, Computed: true},
-				"max_number_of_merges_with_ttl_in_pool":                     {Type: schema.TypeInt, Optional: true, Computed: true},
-				"cleanup_delay_period":                                      {Type: schema.TypeInt, Optional: true, Computed: true},
-				"max_avg_part_size_for_too_many_parts":                      {Type: schema.TypeInt, Optional: true, Computed: true},
-				"min_age_to_force_merge_seconds":                            {Type: schema.TypeInt, Optional: true, Computed: true},
-				"min_age_to_force_merge_on_partition_only":                  {Type: schema.TypeBool, Optional: true, Computed: true},
-				"merge_selecting_sleep_ms":                                  {Type: schema.TypeInt, Optional: true, Computed: true},
-			},
+			Schema: clickhouseMergeTreeConfigSchema(),
 		},
 	},
 	"kafka": {
@@ -235,7 +216,7 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 		Update: resourceYandexMDBClickHouseClusterUpdate,
 		Delete: resourceYandexMDBClickHouseClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importMDBClusterManaging("manage_databases", "manage_users"),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -297,9 +278,11 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 				},
 			},
 			"user": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Set:      clickHouseUserHash,
+				Type:             schema.TypeSet,
+				Optional:         true,
+				Set:              clickHouseUserHash,
+				DiffSuppressFunc: suppressMDBUnmanagedDiff("manage_users"),
+				Deprecated:       useResourceInstead("user", "yandex_mdb_clickhouse_user"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -506,10 +489,17 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 					},
 				},
 			},
-			"database": {
-				Type:     schema.TypeSet,
+			"manage_users": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Set:      clickHouseDatabaseHash,
+				Default:  true,
+			},
+			"database": {
+				Type:             schema.TypeSet,
+				Optional:         true,
+				Set:              clickHouseDatabaseHash,
+				DiffSuppressFunc: suppressMDBUnmanagedDiff("manage_databases"),
+				Deprecated:       useResourceInstead("database", "yandex_mdb_clickhouse_database"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -519,6 +509,11 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 					},
 				},
 			},
+			"manage_databases": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"copy_schema_on_new_hosts": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -766,6 +761,7 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"restore": mdbRestoreSchema(resourceYandexMDBClickHouseClusterRestoreBlock()),
 			"admin_password": {
 				Type:      schema.TypeString,
 				Optional:  true,
@@ -876,9 +872,24 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Create(ctx, req))
-	if err != nil {
-		return fmt.Errorf("error while requesting API to create ClickHouse Cluster: %s", err)
+	var op *sdkoperation.Operation
+	if backupID, ok := d.GetOk("restore.0.backup_id"); ok {
+		// all the shards are restored at once, so their hosts are passed with the restore request
+		for _, shardHosts := range shardsToAdd {
+			req.HostSpecs = append(req.HostSpecs, shardHosts...)
+		}
+		shardsToAdd = nil
+
+		request := prepareRestoreClickHouseRequest(d, req, backupID.(string))
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Restore(ctx, request))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to create ClickHouse Cluster from backup %v: %s", backupID, err)
+		}
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Create(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to create ClickHouse Cluster: %s", err)
+		}
 	}
 
 	protoMetadata, err := op.Metadata()
@@ -886,12 +897,12 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("error while getting ClickHouse create operation metadata: %s", err)
 	}
 
-	md, ok := protoMetadata.(*clickhouse.CreateClusterMetadata)
+	clusterID, ok := mdbClusterIDFromMetadata(protoMetadata)
 	if !ok {
 		return fmt.Errorf("could not get Cluster ID from create operation metadata")
 	}
 
-	d.SetId(md.ClusterId)
+	d.SetId(clusterID)
 
 	err = op.Wait(ctx)
 	if err != nil {
@@ -976,6 +987,37 @@ func resourceYandexMDBClickHouseClusterCreate(d *schema.ResourceData, meta inter
 	return resourceYandexMDBClickHouseClusterRead(d, meta)
 }
 
+func resourceYandexMDBClickHouseClusterRestoreBlock() *schema.Resource {
+	r := mdbRestoreResource(false)
+	r.Schema["additional_backup_ids"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Optional: true,
+		ForceNew: true,
+	}
+	return r
+}
+
+// prepareRestoreClickHouseRequest returns request for restoring the Cluster from the backup. Databases and users
+// of the Cluster are restored from the backup, so they are not passed.
+func prepareRestoreClickHouseRequest(d *schema.ResourceData, req *clickhouse.CreateClusterRequest, backupID string) *clickhouse.RestoreClusterRequest {
+	return &clickhouse.RestoreClusterRequest{
+		BackupId:            backupID,
+		AdditionalBackupIds: expandStringSlice(d.Get("restore.0.additional_backup_ids").([]interface{})),
+		Name:                req.Name,
+		Description:         req.Description,
+		Labels:              req.Labels,
+		Environment:         req.Environment,
+		ConfigSpec:          req.ConfigSpec,
+		HostSpecs:           req.HostSpecs,
+		NetworkId:           req.NetworkId,
+		FolderId:            req.FolderId,
+		ServiceAccountId:    req.ServiceAccountId,
+		SecurityGroupIds:    req.SecurityGroupIds,
+		DeletionProtection:  req.DeletionProtection,
+	}
+}
+
 // Returns request for creating the Cluster and the map of the remaining shards to add.
 func prepareCreateClickHouseCreateRequest(d *schema.ResourceData, meta *Config) (*clickhouse.CreateClusterRequest, map[string][]*clickhouse.HostSpec, map[string]*clickhouse.ShardConfigSpec, error) {
 	labels, err := expandLabels(d.Get("labels"))
@@ -1093,6 +1135,10 @@ func prepareCreateClickHouseCreateRequest(d *schema.ResourceData, meta *Config)
 }
 
 func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interface{}) error {
+	return yandexMDBClickHouseClusterRead(d, meta, false)
+}
+
+func yandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interface{}, fromDataSource bool) error {
 	log.Println("[DEBUG] cluster read started")
 	config := meta.(*Config)
 
@@ -1216,28 +1262,34 @@ func resourceYandexMDBClickHouseClusterRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	databases, err := listClickHouseDatabases(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
-	dbs := flattenClickHouseDatabases(databases)
-	if err := d.Set("database", dbs); err != nil {
-		return err
+	// databases and users are not read with manage_databases and manage_users set to false, they are
+	// managed by yandex_mdb_clickhouse_database and yandex_mdb_clickhouse_user then
+	if fromDataSource || mdbClusterManages(d, "manage_databases") {
+		databases, err := listClickHouseDatabases(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
+		dbs := flattenClickHouseDatabases(databases)
+		if err := d.Set("database", dbs); err != nil {
+			return err
+		}
 	}
 
-	dUsers, err := expandClickHouseUserSpecs(d)
-	if err != nil {
-		return err
-	}
-	passwords := clickHouseUsersPasswords(dUsers)
+	if fromDataSource || mdbClusterManages(d, "manage_users") {
+		dUsers, err := expandClickHouseUserSpecs(d)
+		if err != nil {
+			return err
+		}
+		passwords := clickHouseUsersPasswords(dUsers)
 
-	users, err := listClickHouseUsers(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
-	us := flattenClickHouseUsers(users, passwords)
-	if err := d.Set("user", us); err != nil {
-		return err
+		users, err := listClickHouseUsers(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
+		us := flattenClickHouseUsers(users, passwords)
+		if err := d.Set("user", us); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
@@ -1282,13 +1334,13 @@ func resourceYandexMDBClickHouseClusterUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
-	if d.HasChange("database") {
+	if d.HasChange("database") && mdbClusterManages(d, "manage_databases") {
 		if err := updateClickHouseClusterDatabases(d, meta); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("user") {
+	if d.HasChange("user") && mdbClusterManages(d, "manage_users") {
 		if err := updateClickHouseClusterUsers(d, meta); err != nil {
 			return err
 		}
@@ -1413,27 +1465,6 @@ var mdbClickHouseConfigUpdateFieldsMaps = []string{
 	"total_memory_profiler_step",
 	"total_memory_tracker_sample_probability",
 }
-var mdbClickhouseMergeTreeUpdateFields = []string{
-	"replicated_deduplication_window",
-	"replicated_deduplication_window_seconds",
-	"parts_to_delay_insert",
-	"parts_to_throw_insert",
-	"max_replicated_merges_in_queue",
-	"number_of_free_entries_in_pool_to_lower_max_size_of_merge",
-	"max_bytes_to_merge_at_min_space_in_pool",
-	"min_bytes_for_wide_part",
-	"min_rows_for_wide_part",
-	"ttl_only_drop_parts",
-	"merge_with_ttl_timeout",
-	"merge_with_recompression_ttl_timeout",
-	"max_parts_in_total",
-	"max_number_of_merges_with_ttl_in_pool",
-	"cleanup_delay_period",
-	"max_avg_part_size_for_too_many_parts",
-	"min_age_to_force_merge_seconds",
-	"min_age_to_force_merge_on_partition_only",
-	"merge_selecting_sleep_ms",
-}
 
 func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
@@ -1495,7 +1526,7 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 			}
 		}
 		if d.HasChange(rootClickhouseConfigTfPath + "merge_tree") {
-			for _, item := range mdbClickhouseMergeTreeUpdateFields {
+			for _, item := range clickhouseMergeTreeConfigFields {
 				if d.HasChange(rootClickhouseConfigTfPath + "merge_tree.0." + item) {
 					updatePath = append(updatePath, "config_spec.clickhouse.config.merge_tree."+item)
 				}
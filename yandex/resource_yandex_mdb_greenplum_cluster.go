@@ -11,6 +11,7 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 )
 
 const (
@@ -21,7 +22,7 @@ const (
 )
 
 func resourceYandexMDBGreenplumCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceYandexMDBGreenplumClusterCreate,
 		Read:   resourceYandexMDBGreenplumClusterRead,
 		Update: resourceYandexMDBGreenplumClusterUpdate,
@@ -30,13 +31,15 @@ func resourceYandexMDBGreenplumCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: mdbGreenplumVersionSettings.validateSettings,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexMDBGreenplumClusterDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexMDBGreenplumClusterUpdateTimeout),
 			Delete: schema.DefaultTimeout(yandexMDBGreenplumClusterDefaultTimeout),
 		},
 
-		SchemaVersion: 0,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -218,6 +221,7 @@ func resourceYandexMDBGreenplumCluster() *schema.Resource {
 				Set:      schema.HashString,
 				Optional: true,
 			},
+			"restore": mdbRestoreSchema(mdbRestoreResource(true)),
 			"maintenance_window": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -376,13 +380,12 @@ func resourceYandexMDBGreenplumCluster() *schema.Resource {
 				},
 			},
 			"greenplum_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbGreenplumSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbGreenplumSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbGreenplumSettingsFieldsInfo),
 				},
 			},
 			"cloud_storage": {
@@ -402,6 +405,14 @@ func resourceYandexMDBGreenplumCluster() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    mdbResourceWithSettingsMapsV0(r, "greenplum_config").CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceYandexMDBGreenplumClusterStateUpgradeV0,
+			Version: 0,
+		},
+	}
+	return r
 }
 
 func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -413,19 +424,31 @@ func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interf
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
 	defer cancel()
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Create(ctx, req))
-	if err != nil {
-		return fmt.Errorf("error while requesting API to create Greenplum Cluster: %s", err)
+	var op *sdkoperation.Operation
+	if backupID, ok := d.GetOk("restore.0.backup_id"); ok {
+		restoreReq, err := prepareRestoreGreenplumClusterRequest(d, req, backupID.(string))
+		if err != nil {
+			return err
+		}
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Restore(ctx, restoreReq))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to create Greenplum Cluster from backup %v: %s", backupID, err)
+		}
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Greenplum().Cluster().Create(ctx, req))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to create Greenplum Cluster: %s", err)
+		}
 	}
 	protoMetadata, err := op.Metadata()
 	if err != nil {
 		return fmt.Errorf("error while get Greenplum create operation metadata: %s", err)
 	}
-	md, ok := protoMetadata.(*greenplum.CreateClusterMetadata)
+	clusterID, ok := mdbClusterIDFromMetadata(protoMetadata)
 	if !ok {
 		return fmt.Errorf("could not get Greenplum Cluster ID from create operation metadata")
 	}
-	d.SetId(md.ClusterId)
+	d.SetId(clusterID)
 
 	err = op.Wait(ctx)
 	if err != nil {
@@ -437,6 +460,41 @@ func resourceYandexMDBGreenplumClusterCreate(d *schema.ResourceData, meta interf
 	return resourceYandexMDBGreenplumClusterRead(d, meta)
 }
 
+// prepareRestoreGreenplumClusterRequest returns request for restoring the Cluster from the backup,
+// the version, the users and the settings of the Cluster are restored from the backup.
+func prepareRestoreGreenplumClusterRequest(d *schema.ResourceData, req *greenplum.CreateClusterRequest, backupID string) (*greenplum.RestoreClusterRequest, error) {
+	restoreTime, err := expandMDBRestoreTimeOrNow(d)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating Greenplum Cluster from backup %v: %s", backupID, err)
+	}
+
+	return &greenplum.RestoreClusterRequest{
+		BackupId:    backupID,
+		Time:        restoreTime,
+		FolderId:    req.FolderId,
+		Name:        req.Name,
+		Description: req.Description,
+		Labels:      req.Labels,
+		Environment: req.Environment,
+		Config: &greenplum.GreenplumRestoreConfig{
+			BackupWindowStart: req.Config.BackupWindowStart,
+			Access:            req.Config.Access,
+			ZoneId:            req.Config.ZoneId,
+			SubnetId:          req.Config.SubnetId,
+			AssignPublicIp:    req.Config.AssignPublicIp,
+		},
+		MasterResources:    req.MasterConfig.Resources,
+		SegmentResources:   req.SegmentConfig.Resources,
+		NetworkId:          req.NetworkId,
+		SecurityGroupIds:   req.SecurityGroupIds,
+		DeletionProtection: req.DeletionProtection,
+		HostGroupIds:       req.HostGroupIds,
+		MaintenanceWindow:  req.MaintenanceWindow,
+		SegmentHostCount:   req.SegmentHostCount,
+		SegmentInHost:      req.SegmentInHost,
+	}, nil
+}
+
 func prepareCreateGreenplumClusterRequest(d *schema.ResourceData, meta *Config) (*greenplum.CreateClusterRequest, error) {
 	labels, err := expandLabels(d.Get("labels"))
 	if err != nil {
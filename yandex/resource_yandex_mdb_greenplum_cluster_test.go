@@ -126,13 +126,13 @@ func TestAccMDBGreenplumCluster_full(t *testing.T) {
 					resource.TestCheckResourceAttr(greenplumResource, "pxf_config.0.xmx", "2048"),
 					resource.TestCheckResourceAttr(greenplumResource, "pxf_config.0.xms", "1024"),
 
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.max_connections", "395"),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.max_slot_wal_keep_size", "1048576"),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.gp_workfile_limit_per_segment", "0"),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.gp_workfile_limit_per_query", "0"),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.gp_workfile_limit_files_per_query", "100000"),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.max_prepared_transactions", "500"),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.gp_workfile_compression", "false"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.max_connections", "395"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.max_slot_wal_keep_size", "1048576"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.gp_workfile_limit_per_segment", "0"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.gp_workfile_limit_per_query", "0"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.gp_workfile_limit_files_per_query", "100000"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.max_prepared_transactions", "500"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.gp_workfile_compression", "false"),
 
 					resource.TestCheckResourceAttr(greenplumResource, "master_subcluster.0.resources.0.resource_preset_id", "s2.micro"),
 					resource.TestCheckResourceAttr(greenplumResource, "master_subcluster.0.resources.0.disk_size", "24"),
@@ -167,8 +167,8 @@ func TestAccMDBGreenplumCluster_full(t *testing.T) {
 				Config: testAccMDBGreenplumClusterConfigStep4(clusterNameUpdated, clusterDescriptionUpdated),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckMDBGreenplumClusterExists(greenplumResource, 2, 5),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.max_connections", "400"),
-					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.gp_workfile_compression", "true"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.max_connections", "400"),
+					resource.TestCheckResourceAttr(greenplumResource, "greenplum_config.0.gp_workfile_compression", "true"),
 					resource.TestCheckResourceAttr(greenplumResource, "pooler_config.0.pooling_mode", "SESSION"),
 					resource.TestCheckResourceAttr(greenplumResource, "pooler_config.0.pool_size", "10"),
 					resource.TestCheckResourceAttr(greenplumResource, "pooler_config.0.pool_client_idle_timeout", "0"),
@@ -391,7 +391,7 @@ func testAccMDBGreenplumClusterConfigStep1(name string, description string) stri
 	xms                            = 1024
   }
 
-  greenplum_config = {
+  greenplum_config {
     max_connections                   = 395
     max_slot_wal_keep_size            = 1048576
     gp_workfile_limit_per_segment     = 0
@@ -424,7 +424,7 @@ func testAccMDBGreenplumClusterConfigStep2(name string, description string) stri
 	xms                            = 1024
   }
 
-  greenplum_config = {
+  greenplum_config {
     max_connections                   = 395
     max_slot_wal_keep_size            = 1048576
     gp_workfile_limit_per_segment     = 0
@@ -460,7 +460,7 @@ func testAccMDBGreenplumClusterConfigStep4(name string, description string) stri
 	xms                            = 1024
   }
 
-  greenplum_config = {
+  greenplum_config {
     max_connections                   = 400
     max_slot_wal_keep_size            = 1048576
     gp_workfile_limit_per_segment     = 0
@@ -492,7 +492,7 @@ func testAccMDBGreenplumClusterConfigStep5(name string, description string) stri
 	xms                            = 1024
   }
 
-  greenplum_config = {
+  greenplum_config {
     max_connections                   = 400
     max_slot_wal_keep_size            = 1048576
     gp_workfile_limit_per_segment     = 0
@@ -526,7 +526,7 @@ func testAccMDBGreenplumClusterConfigStep6(name string, description string) stri
 	xms                            = 1024
   }
 
-  greenplum_config = {
+  greenplum_config {
     max_connections                   = 400
     max_slot_wal_keep_size            = 1048576
     gp_workfile_limit_per_segment     = 0
@@ -1121,12 +1121,12 @@ func updateKafkaClusterTopics(d *schema.ResourceData, topicModifier KafkaTopicMo
 }
 
 func deleteKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceData, topicName string) error {
-	op, err := config.sdk.WrapOperation(
-		config.sdk.MDB().Kafka().Topic().Delete(ctx, &kafka.DeleteTopicRequest{
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		return config.sdk.MDB().Kafka().Topic().Delete(ctx, &kafka.DeleteTopicRequest{
 			ClusterId: d.Id(),
 			TopicName: topicName,
-		}),
-	)
+		})
+	})
 	if err != nil {
 		return fmt.Errorf("error while requesting API to delete topic from Kafka Cluster %q: %s", d.Id(), err)
 	}
@@ -1139,12 +1139,12 @@ func deleteKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceDat
 }
 
 func createKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceData, topicSpec *kafka.TopicSpec) error {
-	op, err := config.sdk.WrapOperation(
-		config.sdk.MDB().Kafka().Topic().Create(ctx, &kafka.CreateTopicRequest{
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		return config.sdk.MDB().Kafka().Topic().Create(ctx, &kafka.CreateTopicRequest{
 			ClusterId: d.Id(),
 			TopicSpec: topicSpec,
-		}),
-	)
+		})
+	})
 	if err != nil {
 		return fmt.Errorf("error while requesting API to create topic in Kafka Cluster %q: %s", d.Id(), err)
 	}
@@ -1166,9 +1166,9 @@ func updateKafkaTopic(ctx context.Context, config *Config, d *schema.ResourceDat
 
 	log.Printf("[DEBUG] Sending topic update request: %+v", request)
 
-	op, err := config.sdk.WrapOperation(
-		config.sdk.MDB().Kafka().Topic().Update(ctx, request),
-	)
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		return config.sdk.MDB().Kafka().Topic().Update(ctx, request)
+	})
 	if err != nil {
 		return fmt.Errorf("error while requesting API to update topic in Kafka Cluster %q: %s", d.Id(), err)
 	}
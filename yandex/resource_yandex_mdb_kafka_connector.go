@@ -1,20 +1,27 @@
 package yandex
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
+var kafkaConnectorStates = []string{
+	kafka.Connector_RUNNING.String(),
+	kafka.Connector_PAUSED.String(),
+}
+
 func resourceYandexMDBKafkaConnector() *schema.Resource {
-	return &schema.Resource{
+	resource := &schema.Resource{
 		Create: resourceYandexMDBKafkaConnectorCreate,
 		Read:   resourceYandexMDBKafkaConnectorRead,
 		Update: resourceYandexMDBKafkaConnectorUpdate,
@@ -46,64 +53,27 @@ func resourceYandexMDBKafkaConnector() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
-			"connector_config_mirrormaker": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"topics": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"source_cluster": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem:     resourceYandexMDBKafkaClusterConnectionSpec(),
-						},
-						"target_cluster": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem:     resourceYandexMDBKafkaClusterConnectionSpec(),
-						},
-						"replication_factor": {
-							Type:     schema.TypeInt,
-							Required: true,
-						},
-					},
-				},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      kafka.Connector_RUNNING.String(),
+				ValidateFunc: validation.StringInSlice(kafkaConnectorStates, false),
 			},
-			"connector_config_s3_sink": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"topics": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"file_compression_type": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-						"file_max_records": {
-							Type:     schema.TypeInt,
-							Optional: true,
-						},
-						"s3_connection": {
-							Type:     schema.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem:     resourceYandexMDBKafkaS3ConnectionSpec(),
-						},
-					},
-				},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"health": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 	}
 
+	for _, connectorConfig := range kafkaConnectorConfigs {
+		resource.Schema[connectorConfig.key()] = connectorConfig.schema()
+	}
+	return resource
 }
 
 func resourceYandexMDBKafkaClusterConnectionSpec() *schema.Resource {
@@ -223,6 +193,12 @@ func resourceYandexMDBKafkaConnectorCreate(d *schema.ResourceData, meta interfac
 	}
 	log.Printf("[DEBUG] Finished creating Kafka conector %q", conectorName)
 
+	if d.Get("state").(string) == kafka.Connector_PAUSED.String() {
+		if err := updateKafkaConnectorState(ctx, config, req.ClusterId, req.ConnectorSpec.Name, kafka.Connector_PAUSED.String()); err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexMDBKafkaConnectorRead(d, meta)
 }
 
@@ -260,27 +236,29 @@ func resourceYandexMDBKafkaConnectorRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	switch conn.GetConnectorConfig().(type) {
-	case *kafka.Connector_ConnectorConfigMirrormaker:
-		cfg, err := flattenKafkaConnectorMirrormaker(conn.GetConnectorConfigMirrormaker())
-		if err != nil {
-			return err
-		}
-		if err = d.Set("connector_config_mirrormaker", cfg); err != nil {
+	if err = d.Set("status", conn.GetStatus().String()); err != nil {
+		return err
+	}
+	if err = d.Set("health", conn.GetHealth().String()); err != nil {
+		return err
+	}
+	// A failed connector shows up as a change of the state in the plan.
+	if state := flattenKafkaConnectorState(conn); state != "" {
+		if err = d.Set("state", state); err != nil {
 			return err
 		}
-	case *kafka.Connector_ConnectorConfigS3Sink:
-		cfg, err := flattenKafkaConnectorS3Sink(conn.GetConnectorConfigS3Sink())
+	}
+
+	for _, connectorConfig := range kafkaConnectorConfigs {
+		cfg, ok, err := connectorConfig.flatten(conn)
 		if err != nil {
 			return err
 		}
-		if err = d.Set("connector_config_s3_sink", cfg); err != nil {
-			return err
+		if ok {
+			return d.Set(connectorConfig.key(), cfg)
 		}
-	default:
-		return fmt.Errorf("this type of connector is not supported by current version of terraform provider")
 	}
-	return nil
+	return fmt.Errorf("this type of connector is not supported by current version of terraform provider")
 }
 
 func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -295,10 +273,22 @@ func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interfac
 			updatePath = append(updatePath, path)
 		}
 	}
-	if len(updatePath) == 0 {
-		return nil
+	if len(updatePath) > 0 {
+		if err := updateKafkaConnector(ctx, d, config, updatePath); err != nil {
+			return err
+		}
 	}
 
+	if d.HasChange("state") {
+		if err := updateKafkaConnectorState(ctx, config, d.Get("cluster_id").(string), d.Get("name").(string), d.Get("state").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceYandexMDBKafkaConnectorRead(d, meta)
+}
+
+func updateKafkaConnector(ctx context.Context, d *schema.ResourceData, config *Config, updatePath []string) error {
 	connSpec, err := buildKafkaConnectorUpdateSpec(d)
 	if err != nil {
 		return err
@@ -328,8 +318,37 @@ func resourceYandexMDBKafkaConnectorUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	log.Printf("[DEBUG] Finished updating Kafka connector %q", connName)
+	return nil
+}
 
-	return resourceYandexMDBKafkaConnectorRead(d, meta)
+// updateKafkaConnectorState pauses or resumes the connector. Resuming a failed connector restarts it.
+func updateKafkaConnectorState(ctx context.Context, config *Config, clusterID, connName, state string) error {
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		if state == kafka.Connector_PAUSED.String() {
+			log.Printf("[DEBUG] Pausing Kafka connector %q", connName)
+			return config.sdk.MDB().Kafka().Connector().Pause(ctx, &kafka.PauseConnectorRequest{
+				ClusterId:     clusterID,
+				ConnectorName: connName,
+			})
+		}
+		log.Printf("[DEBUG] Resuming Kafka connector %q", connName)
+		return config.sdk.MDB().Kafka().Connector().Resume(ctx, &kafka.ResumeConnectorRequest{
+			ClusterId:     clusterID,
+			ConnectorName: connName,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to change state of connector %q in Kafka Cluster %q to %s: %s",
+			connName, clusterID, state, err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("error while changing state of connector %q in Kafka Cluster %q to %s: %s", connName, clusterID, state, err)
+	}
+
+	log.Printf("[DEBUG] Finished changing state of Kafka connector %q to %s", connName, state)
+	return nil
 }
 
 func resourceYandexMDBKafkaConnectorDelete(d *schema.ResourceData, meta interface{}) error {
@@ -383,13 +402,11 @@ func buildKafkaConnectorSpec(d *schema.ResourceData) (*kafka.ConnectorSpec, erro
 	}
 	connSpec.Properties = props
 	var countOfSpecificConnectorConfigs int64
-	if _, ok := d.GetOk("connector_config_mirrormaker"); ok {
-		connSpec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
-		countOfSpecificConnectorConfigs++
-	}
-	if _, ok := d.GetOk("connector_config_s3_sink"); ok {
-		connSpec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpec(d))
-		countOfSpecificConnectorConfigs++
+	for _, connectorConfig := range kafkaConnectorConfigs {
+		if _, ok := d.GetOk(connectorConfig.key()); ok {
+			connectorConfig.expand(d, connSpec)
+			countOfSpecificConnectorConfigs++
+		}
 	}
 	if countOfSpecificConnectorConfigs == 0 {
 		return nil, fmt.Errorf("connector-specific config must be specified")
@@ -411,13 +428,11 @@ func buildKafkaConnectorUpdateSpec(d *schema.ResourceData) (*kafka.UpdateConnect
 	connSpec.Properties = props
 
 	var countOfSpecificConnectorConfigs int64
-	if _, ok := d.GetOk("connector_config_mirrormaker"); ok {
-		connSpec.SetConnectorConfigMirrormaker(buildKafkaMirrorMakerSpec(d))
-		countOfSpecificConnectorConfigs++
-	}
-	if _, ok := d.GetOk("connector_config_s3_sink"); ok {
-		connSpec.SetConnectorConfigS3Sink(buildKafkaS3SinkConnectorSpecUpdate(d))
-		countOfSpecificConnectorConfigs++
+	for _, connectorConfig := range kafkaConnectorConfigs {
+		if _, ok := d.GetOk(connectorConfig.key()); ok {
+			connectorConfig.expandUpdate(d, connSpec)
+			countOfSpecificConnectorConfigs++
+		}
 	}
 	if countOfSpecificConnectorConfigs > 1 {
 		return nil, fmt.Errorf("must be specified only one connector-specific config")
@@ -502,45 +517,10 @@ func init() {
 	valPrefix := "connector_spec."
 	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"tasks_max"] = valPrefix + "tasks_max"
 	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"properties"] = valPrefix + "properties"
-	addMirrormakerUpdatePathsToFieldsMap(keyPrefix, valPrefix)
-	addS3SinkUpdatePathsToFieldsMap(keyPrefix, valPrefix)
-}
-
-func addMirrormakerUpdatePathsToFieldsMap(commonKeyPrefix string, commonValPrefix string) {
-	keyPrefix := commonKeyPrefix + "connector_config_mirrormaker.0."
-	valPrefix := commonValPrefix + "connector_config_mirrormaker."
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"topics"] = valPrefix + "topics"
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"replication_factor"] = valPrefix + "replication_factor"
-
-	for _, source := range []string{"source_cluster", "target_cluster"} {
-		keyPrefix := keyPrefix + source + ".0."
-		valPrefix := valPrefix + source + "."
-		mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"alias"] = valPrefix + "alias"
-
-		keyPrefix = keyPrefix + "external_cluster.0."
-		valPrefix = valPrefix + "external_cluster."
-		mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"bootstrap_servers"] = valPrefix + "bootstrap_servers"
-		mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"sasl_username"] = valPrefix + "sasl_username"
-		mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"sasl_password"] = valPrefix + "sasl_password"
-		mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"sasl_mechanism"] = valPrefix + "sasl_mechanism"
-		mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"security_protocol"] = valPrefix + "security_protocol"
+	for _, connectorConfig := range kafkaConnectorConfigs {
+		paths := connectorConfig.updatePaths(keyPrefix+connectorConfig.key()+".0.", valPrefix+connectorConfig.key()+".")
+		for key, path := range paths {
+			mdbKafkaConnectorUpdateFieldsMap[key] = path
+		}
 	}
 }
-
-func addS3SinkUpdatePathsToFieldsMap(commonKeyPrefix string, commonValPrefix string) {
-	keyPrefix := commonKeyPrefix + "connector_config_s3_sink.0."
-	valPrefix := commonValPrefix + "connector_config_s3_sink."
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"topics"] = valPrefix + "topics"
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"file_max_records"] = valPrefix + "file_max_records"
-
-	keyPrefix = keyPrefix + "s3_connection" + ".0."
-	valPrefix = valPrefix + "s3_connection" + "."
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"bucket_name"] = valPrefix + "bucket_name"
-
-	keyPrefix = keyPrefix + "external_s3.0."
-	valPrefix = valPrefix + "external_s3."
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"access_key_id"] = valPrefix + "access_key_id"
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"secret_access_key"] = valPrefix + "secret_access_key"
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"endpoint"] = valPrefix + "endpoint"
-	mdbKafkaConnectorUpdateFieldsMap[keyPrefix+"region"] = valPrefix + "region"
-}
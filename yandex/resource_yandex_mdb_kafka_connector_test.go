@@ -1,10 +1,12 @@
 package yandex
 
 import (
+	"context"
 	"testing"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
@@ -406,3 +408,100 @@ func TestBuildKafkaConnectorSpecWhenNoConfigsThenError(t *testing.T) {
 	require.Error(t, err)
 	require.Equal(t, "connector-specific config must be specified", err.Error())
 }
+
+func TestKafkaConnectorUpdateFieldsMap(t *testing.T) {
+	expected := map[string]string{
+		"tasks_max":                             "connector_spec.tasks_max",
+		"properties":                            "connector_spec.properties",
+		"connector_config_mirrormaker.0.topics": "connector_spec.connector_config_mirrormaker.topics",
+		"connector_config_mirrormaker.0.replication_factor":                                "connector_spec.connector_config_mirrormaker.replication_factor",
+		"connector_config_mirrormaker.0.source_cluster.0.alias":                            "connector_spec.connector_config_mirrormaker.source_cluster.alias",
+		"connector_config_mirrormaker.0.target_cluster.0.external_cluster.0.sasl_password": "connector_spec.connector_config_mirrormaker.target_cluster.external_cluster.sasl_password",
+		"connector_config_s3_sink.0.topics":                                                "connector_spec.connector_config_s3_sink.topics",
+		"connector_config_s3_sink.0.s3_connection.0.bucket_name":                           "connector_spec.connector_config_s3_sink.s3_connection.bucket_name",
+		"connector_config_s3_sink.0.s3_connection.0.external_s3.0.region":                  "connector_spec.connector_config_s3_sink.s3_connection.external_s3.region",
+	}
+	for key, path := range expected {
+		require.Equal(t, path, mdbKafkaConnectorUpdateFieldsMap[key], key)
+	}
+	require.Len(t, mdbKafkaConnectorUpdateFieldsMap, 2+2+2*6+2+1+4)
+}
+
+func TestKafkaConnectorConfigsSchema(t *testing.T) {
+	resourceSchema := resourceYandexMDBKafkaConnector().Schema
+	for _, connectorConfig := range kafkaConnectorConfigs {
+		require.Contains(t, resourceSchema, connectorConfig.key())
+	}
+	require.NoError(t, resourceYandexMDBKafkaConnector().InternalValidate(nil, true))
+}
+
+func TestFlattenKafkaConnectorConfigs(t *testing.T) {
+	conn := &kafka.Connector{
+		Name: "connector1",
+		ConnectorConfig: &kafka.Connector_ConnectorConfigS3Sink{
+			ConnectorConfigS3Sink: &kafka.ConnectorConfigS3Sink{
+				Topics:              "topic1",
+				FileCompressionType: "gzip",
+				S3Connection: &kafka.S3Connection{
+					BucketName: "bucket1",
+					Storage: &kafka.S3Connection_ExternalS3{
+						ExternalS3: &kafka.ExternalS3Storage{Endpoint: "storage.yandexcloud.net"},
+					},
+				},
+			},
+		},
+	}
+
+	var flattened []string
+	for _, connectorConfig := range kafkaConnectorConfigs {
+		block, ok, err := connectorConfig.flatten(conn)
+		require.NoError(t, err)
+		if ok {
+			require.Len(t, block, 1)
+			flattened = append(flattened, connectorConfig.key())
+		}
+	}
+	require.Equal(t, []string{"connector_config_s3_sink"}, flattened)
+}
+
+func TestFlattenKafkaConnectorState(t *testing.T) {
+	cases := map[kafka.Connector_Status]string{
+		kafka.Connector_STATUS_UNKNOWN: "",
+		kafka.Connector_RUNNING:        "RUNNING",
+		kafka.Connector_PAUSED:         "PAUSED",
+		kafka.Connector_ERROR:          "ERROR",
+	}
+	for status, expected := range cases {
+		require.Equal(t, expected, flattenKafkaConnectorState(&kafka.Connector{Status: status}), status.String())
+	}
+}
+
+func TestKafkaConnectorFailedStateIsPlanned(t *testing.T) {
+	res := resourceYandexMDBKafkaConnector()
+	raw := map[string]interface{}{
+		"cluster_id": "cid1",
+		"name":       "connector1",
+	}
+
+	state := &terraform.InstanceState{
+		ID: "cid1:connector1",
+		Attributes: map[string]string{
+			"id":           "cid1:connector1",
+			"cluster_id":   "cid1",
+			"name":         "connector1",
+			"properties.%": "0",
+			"state":        kafka.Connector_ERROR.String(),
+			"status":       kafka.Connector_ERROR.String(),
+		},
+	}
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.Contains(t, diff.Attributes, "state")
+	assert.Equal(t, kafka.Connector_RUNNING.String(), diff.Attributes["state"].New)
+
+	state.Attributes["state"] = kafka.Connector_RUNNING.String()
+	diff, err = res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	require.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+}
@@ -0,0 +1,390 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	homedir "github.com/mitchellh/go-homedir"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout = 5 * time.Minute
+)
+
+func resourceYandexMDBKafkaSchemaRegistrySubject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceYandexMDBKafkaSchemaRegistrySubjectCreate,
+		Read:          resourceYandexMDBKafkaSchemaRegistrySubjectRead,
+		UpdateContext: resourceYandexMDBKafkaSchemaRegistrySubjectUpdate,
+		Delete:        resourceYandexMDBKafkaSchemaRegistrySubjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexMDBKafkaSchemaRegistrySubjectImport,
+		},
+
+		CustomizeDiff: kafkaSchemaRegistrySubjectFingerprintChanged,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaSchemaRegistrySubjectDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"ca_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"schema_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "AVRO",
+				ValidateFunc: validation.StringInSlice(kafkaSchemaTypes, false),
+			},
+			"schema_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"schema_file", "schema"},
+			},
+			"schema": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"schema_file", "schema"},
+				DiffSuppressFunc: kafkaSchemaDiffSuppress,
+			},
+			"compatibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(kafkaSchemaCompatibilities, false),
+			},
+			"hard_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	client, err := kafkaSchemaRegistryClientFromResourceData(ctx, d, config)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subject := d.Get("subject").(string)
+	diags, err := registerKafkaSubjectSchema(ctx, d, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(constructResourceId(d.Get("cluster_id").(string), subject))
+
+	if err := resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, subject, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+	if err := d.Set("cluster_id", clusterID); err != nil {
+		return err
+	}
+	if err := d.Set("subject", subject); err != nil {
+		return err
+	}
+
+	client, err := kafkaSchemaRegistryClientFromResourceData(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	latest, err := client.latestSchema(ctx, subject)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Schema registry subject %q", subject))
+	}
+
+	// The registry may return the schema formatted its own way, so the fingerprint of the schema
+	// registered by the resource is kept as long as no other version is registered after it.
+	if latest.ID != d.Get("schema_id").(int) || d.Get("fingerprint").(string) == "" {
+		fingerprint, err := kafkaSchemaFingerprint(latest.SchemaType, latest.Schema)
+		if err != nil {
+			return fmt.Errorf("error reading schema of subject %q: %s", subject, err)
+		}
+		if err := d.Set("fingerprint", fingerprint); err != nil {
+			return err
+		}
+	}
+
+	compatibility, err := client.getCompatibility(ctx, subject)
+	if err != nil {
+		return fmt.Errorf("error reading compatibility of subject %q: %w", subject, err)
+	}
+
+	if err := d.Set("schema_type", latest.SchemaType); err != nil {
+		return err
+	}
+	if err := d.Set("schema_id", latest.ID); err != nil {
+		return err
+	}
+	if err := d.Set("version", latest.Version); err != nil {
+		return err
+	}
+	return d.Set("compatibility", compatibility)
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	var diags diag.Diagnostics
+	if d.HasChanges("compatibility", "fingerprint") {
+		client, err := kafkaSchemaRegistryClientFromResourceData(ctx, d, config)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags, err = registerKafkaSubjectSchema(ctx, d, client)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	client, err := kafkaSchemaRegistryClientFromResourceData(ctx, d, config)
+	if err != nil {
+		return err
+	}
+
+	subject := d.Get("subject").(string)
+	if d.Get("compatibility").(string) != "" {
+		if err := client.setCompatibility(ctx, subject, ""); err != nil {
+			return fmt.Errorf("error resetting compatibility of subject %q: %w", subject, err)
+		}
+	}
+
+	err = client.deleteSubject(ctx, subject, d.Get("hard_delete").(bool))
+	if err != nil && !isStatusWithCode(err, codes.NotFound) {
+		return fmt.Errorf("error deleting subject %q: %w", subject, err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting schema registry subject %q", subject)
+	return nil
+}
+
+func resourceYandexMDBKafkaSchemaRegistrySubjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := deconstructResourceId(d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("hard_delete", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// registerKafkaSubjectSchema sets the compatibility of the subject and registers the declared schema under it.
+// The compatibility goes first, so that it applies to the schema being registered.
+//
+// The registry doesn't register a schema again if it's an earlier version of the subject already, e.g. when the
+// schema is reverted. The declared schema is then not the latest one, which is reported as a warning, and the ID
+// of the latest schema is kept along with the declared fingerprint, so that the next read doesn't plan it again.
+func registerKafkaSubjectSchema(ctx context.Context, d *schema.ResourceData, client *kafkaSchemaRegistryClient) (diag.Diagnostics, error) {
+	subject := d.Get("subject").(string)
+
+	if d.HasChange("compatibility") {
+		if err := client.setCompatibility(ctx, subject, d.Get("compatibility").(string)); err != nil {
+			return nil, fmt.Errorf("error setting compatibility of subject %q: %w", subject, err)
+		}
+	}
+
+	if !d.IsNewResource() && !d.HasChange("fingerprint") {
+		return nil, nil
+	}
+
+	schemaType := d.Get("schema_type").(string)
+	content, err := kafkaSubjectSchema(d)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint, err := kafkaSchemaFingerprint(schemaType, content)
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := client.registerSchema(ctx, subject, schemaType, content)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Registered schema %d as version %d of subject %q", registered.ID, registered.Version, subject)
+
+	latest, err := client.latestSchema(ctx, subject)
+	if err != nil {
+		return nil, fmt.Errorf("error reading latest schema of subject %q: %w", subject, err)
+	}
+
+	var diags diag.Diagnostics
+	if latest.ID != registered.ID {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Schema is not the latest version of the subject",
+			Detail: fmt.Sprintf("The declared schema is already registered as version %d of subject %q, the registry doesn't "+
+				"register it again. Version %d remains the latest one, delete the newer versions to make the declared schema the latest.",
+				registered.Version, subject, latest.Version),
+		})
+	}
+
+	if err := d.Set("fingerprint", fingerprint); err != nil {
+		return nil, err
+	}
+	return diags, d.Set("schema_id", latest.ID)
+}
+
+func kafkaSchemaRegistryClientFromResourceData(ctx context.Context, d *schema.ResourceData, config *Config) (*kafkaSchemaRegistryClient, error) {
+	endpoint := d.Get("endpoint").(string)
+	if endpoint == "" {
+		var err error
+		endpoint, err = kafkaSchemaRegistryEndpoint(ctx, config, d.Get("cluster_id").(string))
+		if err != nil {
+			return nil, err
+		}
+		if err := d.Set("endpoint", endpoint); err != nil {
+			return nil, err
+		}
+	}
+
+	return newKafkaSchemaRegistryClient(config, endpoint, d.Get("username").(string), d.Get("password").(string), d.Get("ca_certificate").(string))
+}
+
+// kafkaSubjectSchema returns the declared schema, either inline or from the local file.
+func kafkaSubjectSchema(d kafkaSchemaGetter) (string, error) {
+	if v, ok := d.GetOk("schema"); ok {
+		return v.(string), nil
+	}
+
+	source := d.Get("schema_file").(string)
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return "", fmt.Errorf("error expanding homedir in schema_file (%s): %s", source, err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading schema file (%s): %s", path, err)
+	}
+	return string(content), nil
+}
+
+type kafkaSchemaGetter interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+// kafkaSchemaRegistrySubjectFingerprintChanged plans a new schema version when the fingerprint
+// of the declared schema differs from the registered one.
+func kafkaSchemaRegistrySubjectFingerprintChanged(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("schema") || !d.NewValueKnown("schema_file") || !d.NewValueKnown("schema_type") {
+		return d.SetNewComputed("fingerprint")
+	}
+
+	content, err := kafkaSubjectSchema(d)
+	if err != nil {
+		return err
+	}
+	fingerprint, err := kafkaSchemaFingerprint(d.Get("schema_type").(string), content)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("fingerprint").(string) == fingerprint {
+		return nil
+	}
+	if err := d.SetNew("fingerprint", fingerprint); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	if err := d.SetNewComputed("schema_id"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("version")
+}
+
+// kafkaSchemaDiffSuppress ignores formatting changes of inline schemas.
+func kafkaSchemaDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	schemaType := d.Get("schema_type").(string)
+	oldCanonical, err := canonicalKafkaSchema(schemaType, old)
+	if err != nil {
+		return false
+	}
+	newCanonical, err := canonicalKafkaSchema(schemaType, new)
+	if err != nil {
+		return false
+	}
+	return oldCanonical == newCanonical
+}
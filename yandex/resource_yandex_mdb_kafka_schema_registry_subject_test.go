@@ -0,0 +1,403 @@
+package yandex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const kafkaSchemaRegistrySubjectResourceName = "yandex_mdb_kafka_schema_registry_subject.events"
+
+const testKafkaAvroSchema = `{
+  "type": "record",
+  "name": "Event",
+  "fields": [
+    {"name": "id", "type": "long"}
+  ]
+}`
+
+// fakeKafkaSchemaRegistry is a local stand-in for the schema registry of a Kafka cluster.
+type fakeKafkaSchemaRegistry struct {
+	mu            sync.Mutex
+	nextID        int
+	subjects      map[string][]*kafkaSchemaRegistrySchema
+	compatibility map[string]string
+}
+
+func newFakeKafkaSchemaRegistry(t *testing.T) (*fakeKafkaSchemaRegistry, *httptest.Server) {
+	registry := &fakeKafkaSchemaRegistry{
+		subjects:      map[string][]*kafkaSchemaRegistrySchema{},
+		compatibility: map[string]string{},
+	}
+	server := httptest.NewServer(registry)
+	t.Cleanup(server.Close)
+	return registry, server
+}
+
+func (r *fakeKafkaSchemaRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if user, password, ok := req.BasicAuth(); !ok || user != "registry-user" || password != "registry-password" {
+		r.fail(w, http.StatusUnauthorized, 401, "Unauthorized")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 2 && parts[0] == "config":
+		r.serveConfig(w, req, parts[1])
+	case len(parts) >= 2 && parts[0] == "subjects":
+		r.serveSubject(w, req, parts[1], parts[2:])
+	default:
+		r.fail(w, http.StatusNotFound, 404, "Not found")
+	}
+}
+
+func (r *fakeKafkaSchemaRegistry) serveConfig(w http.ResponseWriter, req *http.Request, subject string) {
+	switch req.Method {
+	case http.MethodGet:
+		level, ok := r.compatibility[subject]
+		if !ok {
+			r.fail(w, http.StatusNotFound, 40408, "Subject does not have subject-level compatibility configured")
+			return
+		}
+		r.reply(w, map[string]string{"compatibilityLevel": level})
+	case http.MethodPut:
+		body := map[string]string{}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		r.compatibility[subject] = body["compatibility"]
+		r.reply(w, body)
+	case http.MethodDelete:
+		if _, ok := r.compatibility[subject]; !ok {
+			r.fail(w, http.StatusNotFound, 40401, "Subject not found")
+			return
+		}
+		delete(r.compatibility, subject)
+		r.reply(w, map[string]string{})
+	}
+}
+
+func (r *fakeKafkaSchemaRegistry) serveSubject(w http.ResponseWriter, req *http.Request, subject string, rest []string) {
+	versions := r.subjects[subject]
+	switch {
+	case req.Method == http.MethodPost && len(rest) <= 1:
+		body := &kafkaSchemaRegistrySchema{}
+		_ = json.NewDecoder(req.Body).Decode(body)
+		for _, v := range versions {
+			if v.Schema == body.Schema && v.SchemaType == body.SchemaType {
+				r.reply(w, v)
+				return
+			}
+		}
+		if len(rest) == 0 {
+			r.fail(w, http.StatusNotFound, 40403, "Schema not found")
+			return
+		}
+		r.nextID++
+		v := &kafkaSchemaRegistrySchema{
+			Subject:    subject,
+			ID:         r.nextID,
+			Version:    len(versions) + 1,
+			Schema:     body.Schema,
+			SchemaType: body.SchemaType,
+		}
+		r.subjects[subject] = append(versions, v)
+		r.reply(w, map[string]int{"id": v.ID})
+	case req.Method == http.MethodGet && len(rest) == 2 && rest[1] == "latest":
+		if len(versions) == 0 {
+			r.fail(w, http.StatusNotFound, 40401, "Subject not found")
+			return
+		}
+		latest := *versions[len(versions)-1]
+		if latest.SchemaType == "AVRO" {
+			// the registry omits the type of Avro schemas
+			latest.SchemaType = ""
+		}
+		r.reply(w, latest)
+	case req.Method == http.MethodDelete && len(rest) == 0:
+		if len(versions) == 0 {
+			r.fail(w, http.StatusNotFound, 40401, "Subject not found")
+			return
+		}
+		delete(r.subjects, subject)
+		r.reply(w, []int{})
+	default:
+		r.fail(w, http.StatusNotFound, 404, "Not found")
+	}
+}
+
+func (r *fakeKafkaSchemaRegistry) reply(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", kafkaSchemaRegistryContentType)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (r *fakeKafkaSchemaRegistry) fail(w http.ResponseWriter, status, code int, message string) {
+	w.WriteHeader(status)
+	r.reply(w, &kafkaSchemaRegistryError{ErrorCode: code, Message: message})
+}
+
+func TestKafkaSchemaFingerprint(t *testing.T) {
+	reformatted := `{"fields":[{"type":"long","name":"id"}],"name":"Event","type":"record"}`
+	changed := `{"type":"record","name":"Event","fields":[{"name":"id","type":"int"}]}`
+
+	fingerprint, err := kafkaSchemaFingerprint("AVRO", testKafkaAvroSchema)
+	require.NoError(t, err)
+
+	reformattedFingerprint, err := kafkaSchemaFingerprint("AVRO", reformatted)
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, reformattedFingerprint)
+
+	changedFingerprint, err := kafkaSchemaFingerprint("AVRO", changed)
+	require.NoError(t, err)
+	assert.NotEqual(t, fingerprint, changedFingerprint)
+
+	jsonFingerprint, err := kafkaSchemaFingerprint("JSON", testKafkaAvroSchema)
+	require.NoError(t, err)
+	assert.NotEqual(t, fingerprint, jsonFingerprint, "the schema type is a part of the fingerprint")
+
+	_, err = kafkaSchemaFingerprint("AVRO", `{"type": "record",`)
+	assert.Error(t, err)
+}
+
+func TestCanonicalProtobufSchema(t *testing.T) {
+	schema := `
+syntax = "proto3";
+
+// An event.
+message Event {
+  /* The id
+     of the event. */
+  int64 id = 1;
+  string name  =  2; // "the name"
+  map<string, string> labels = 3;
+}
+`
+	assert.Equal(t,
+		`syntax="proto3";message Event{int64 id=1;string name=2;map<string,string>labels=3;}`,
+		canonicalProtobufSchema(schema))
+	assert.Equal(t,
+		`option go_package="a // b";`,
+		canonicalProtobufSchema(`option go_package = "a // b";`))
+}
+
+func TestMDBKafkaSchemaRegistrySubjectLifecycle(t *testing.T) {
+	registry, server := newFakeKafkaSchemaRegistry(t)
+	config := &Config{contextWithClientTraceID: context.Background()}
+
+	schemaFile := filepath.Join(t.TempDir(), "event.avsc")
+	require.NoError(t, os.WriteFile(schemaFile, []byte(testKafkaAvroSchema), 0644))
+
+	raw := map[string]interface{}{
+		"cluster_id":    "cid",
+		"subject":       "events-value",
+		"endpoint":      server.URL,
+		"username":      "registry-user",
+		"password":      "registry-password",
+		"schema_file":   schemaFile,
+		"compatibility": "BACKWARD",
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaSchemaRegistrySubject().Schema, raw)
+	d.MarkNewResource()
+
+	require.False(t, resourceYandexMDBKafkaSchemaRegistrySubjectCreate(context.Background(), d, config).HasError())
+
+	fingerprint, err := kafkaSchemaFingerprint("AVRO", testKafkaAvroSchema)
+	require.NoError(t, err)
+	assert.Equal(t, "cid:events-value", d.Id())
+	assert.Equal(t, fingerprint, d.Get("fingerprint"))
+	assert.Equal(t, 1, d.Get("schema_id"))
+	assert.Equal(t, 1, d.Get("version"))
+	assert.Equal(t, "AVRO", d.Get("schema_type"))
+	assert.Equal(t, "BACKWARD", d.Get("compatibility"))
+	assert.Equal(t, "BACKWARD", registry.compatibility["events-value"])
+
+	// a version registered outside of Terraform shows up as a fingerprint change
+	changed := `{"type":"record","name":"Event","fields":[{"name":"id","type":"long"},{"name":"name","type":"string","default":""}]}`
+	registry.subjects["events-value"] = append(registry.subjects["events-value"], &kafkaSchemaRegistrySchema{
+		Subject: "events-value", ID: 7, Version: 2, Schema: changed, SchemaType: "AVRO",
+	})
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, config))
+	changedFingerprint, err := kafkaSchemaFingerprint("AVRO", changed)
+	require.NoError(t, err)
+	assert.Equal(t, changedFingerprint, d.Get("fingerprint"))
+	assert.Equal(t, 7, d.Get("schema_id"))
+	assert.Equal(t, 2, d.Get("version"))
+
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectDelete(d, config))
+	assert.Empty(t, registry.subjects)
+	assert.Empty(t, registry.compatibility)
+
+	// the subject is gone
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, config))
+	assert.Equal(t, "", d.Id())
+}
+
+func TestMDBKafkaSchemaRegistrySubjectRevert(t *testing.T) {
+	registry, server := newFakeKafkaSchemaRegistry(t)
+	config := &Config{contextWithClientTraceID: context.Background()}
+	res := resourceYandexMDBKafkaSchemaRegistrySubject()
+
+	changed := `{"type":"record","name":"Event","fields":[{"name":"id","type":"long"},{"name":"name","type":"string","default":""}]}`
+	raw := map[string]interface{}{
+		"cluster_id": "cid",
+		"subject":    "events-value",
+		"endpoint":   server.URL,
+		"username":   "registry-user",
+		"password":   "registry-password",
+		"schema":     testKafkaAvroSchema,
+	}
+	d := schema.TestResourceDataRaw(t, res.Schema, raw)
+	d.MarkNewResource()
+	require.False(t, resourceYandexMDBKafkaSchemaRegistrySubjectCreate(context.Background(), d, config).HasError())
+
+	// version 2 is registered, then the schema is reverted to version 1
+	for _, declared := range []string{changed, testKafkaAvroSchema} {
+		raw["schema"] = declared
+		d = applyKafkaSchemaRegistrySubjectChange(t, res, d.State(), raw, config)
+	}
+	assert.Len(t, registry.subjects["events-value"], 2)
+	assert.Equal(t, 2, d.Get("version"))
+	assert.Equal(t, 2, d.Get("schema_id"))
+
+	fingerprint, err := kafkaSchemaFingerprint("AVRO", testKafkaAvroSchema)
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint, d.Get("fingerprint"))
+
+	// the next plan is empty
+	require.NoError(t, resourceYandexMDBKafkaSchemaRegistrySubjectRead(d, config))
+	diff, err := res.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), config)
+	require.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "unexpected diff: %v", diff)
+}
+
+// applyKafkaSchemaRegistrySubjectChange plans and applies the change of the subject to the raw config.
+func applyKafkaSchemaRegistrySubjectChange(t *testing.T, res *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, config *Config) *schema.ResourceData {
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+	require.NoError(t, err)
+	d, err := schema.InternalMap(res.Schema).Data(state, diff)
+	require.NoError(t, err)
+	require.False(t, resourceYandexMDBKafkaSchemaRegistrySubjectUpdate(context.Background(), d, config).HasError())
+	return d
+}
+
+func TestMDBKafkaSchemaRegistrySubjectUnauthorized(t *testing.T) {
+	_, server := newFakeKafkaSchemaRegistry(t)
+	config := &Config{contextWithClientTraceID: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaSchemaRegistrySubject().Schema, map[string]interface{}{
+		"cluster_id": "cid",
+		"subject":    "events-value",
+		"endpoint":   server.URL,
+		"username":   "registry-user",
+		"password":   "wrong-password",
+		"schema":     testKafkaAvroSchema,
+	})
+	d.MarkNewResource()
+
+	diags := resourceYandexMDBKafkaSchemaRegistrySubjectCreate(context.Background(), d, config)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "Unauthorized")
+	assert.Equal(t, "", d.Id())
+}
+
+func TestAccMDBKafkaSchemaRegistrySubject_basic(t *testing.T) {
+	// The brokers serve the schema registry with certificates issued by the Yandex Cloud CA.
+	caFile := os.Getenv("KAFKA_SCHEMA_REGISTRY_CA_FILE")
+	if caFile == "" {
+		t.Skip("KAFKA_SCHEMA_REGISTRY_CA_FILE must be set for the schema registry acceptance test")
+	}
+
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-kafka")
+	schemaFile := filepath.Join(t.TempDir(), "event.avsc")
+	require.NoError(t, os.WriteFile(schemaFile, []byte(testKafkaAvroSchema), 0644))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaSchemaRegistrySubjectConfig(clusterName, caFile, schemaFile, "BACKWARD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(kafkaSchemaRegistrySubjectResourceName, "version", "1"),
+					resource.TestCheckResourceAttr(kafkaSchemaRegistrySubjectResourceName, "schema_type", "AVRO"),
+					resource.TestCheckResourceAttr(kafkaSchemaRegistrySubjectResourceName, "compatibility", "BACKWARD"),
+					resource.TestCheckResourceAttrSet(kafkaSchemaRegistrySubjectResourceName, "fingerprint"),
+				),
+			},
+			{
+				PreConfig: func() {
+					changed := `{"type":"record","name":"Event","fields":[{"name":"id","type":"long"},{"name":"name","type":"string","default":""}]}`
+					require.NoError(t, os.WriteFile(schemaFile, []byte(changed), 0644))
+				},
+				Config: testAccMDBKafkaSchemaRegistrySubjectConfig(clusterName, caFile, schemaFile, "FULL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(kafkaSchemaRegistrySubjectResourceName, "version", "2"),
+					resource.TestCheckResourceAttr(kafkaSchemaRegistrySubjectResourceName, "compatibility", "FULL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBKafkaSchemaRegistrySubjectConfig(name, caFile, schemaFile, compatibility string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+resource "yandex_mdb_kafka_cluster" "foo" {
+  name        = "%s"
+  description = "Kafka Schema Registry Terraform Test"
+  environment = "PRODUCTION"
+  network_id  = yandex_vpc_network.mdb-kafka-test-net.id
+  subnet_ids  = [yandex_vpc_subnet.mdb-kafka-test-subnet-a.id]
+
+  config {
+    version          = "%s"
+    brokers_count    = 1
+    zones            = ["ru-central1-a"]
+    assign_public_ip = true
+    schema_registry  = true
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_type_id       = "network-hdd"
+        disk_size          = 16
+      }
+    }
+  }
+}
+
+resource "yandex_mdb_kafka_user" "registry" {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "registry-user"
+  password   = "registry-password"
+  permission {
+    topic_name = "*"
+    role       = "ACCESS_ROLE_ADMIN"
+  }
+}
+
+resource "yandex_mdb_kafka_schema_registry_subject" "events" {
+  cluster_id     = yandex_mdb_kafka_cluster.foo.id
+  subject        = "events-value"
+  username       = yandex_mdb_kafka_user.registry.name
+  password       = yandex_mdb_kafka_user.registry.password
+  ca_certificate = file("%s")
+  schema_file    = "%s"
+  compatibility  = "%s"
+}
+`, name, currentDefaultKafkaVersion, caFile, schemaFile, compatibility)
+}
@@ -0,0 +1,189 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+)
+
+const (
+	yandexMDBKafkaTopicsCreateTimeout = 30 * time.Minute
+	yandexMDBKafkaTopicsReadTimeout   = 1 * time.Minute
+	yandexMDBKafkaTopicsUpdateTimeout = 30 * time.Minute
+	yandexMDBKafkaTopicsDeleteTimeout = 30 * time.Minute
+
+	defaultMDBKafkaTopicsConcurrency = 4
+)
+
+func resourceYandexMDBKafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBKafkaTopicsCreate,
+		Read:   resourceYandexMDBKafkaTopicsRead,
+		Update: resourceYandexMDBKafkaTopicsUpdate,
+		Delete: resourceYandexMDBKafkaTopicsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaTopicsCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaTopicsReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaTopicsUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaTopicsDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     resourceYandexMDBKafkaClusterTopicBlock(),
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMDBKafkaTopicsConcurrency,
+				ValidateFunc: validation.IntBetween(1, 16),
+			},
+		},
+	}
+}
+
+func resourceYandexMDBKafkaTopicsCreate(d *schema.ResourceData, meta interface{}) error {
+	// The topics of the cluster are managed by the resource as a whole, so the cluster id is the id of the resource.
+	// KafkaTopicManager relies on it too.
+	d.SetId(d.Get("cluster_id").(string))
+
+	if err := applyKafkaTopics(d, meta, schema.TimeoutCreate); err != nil {
+		// Applying the topics again adopts the ones created so far, so don't keep the failed resource in the state.
+		d.SetId("")
+		return err
+	}
+
+	return resourceYandexMDBKafkaTopicsRead(d, meta)
+}
+
+func resourceYandexMDBKafkaTopicsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	topics, err := listKafkaTopics(ctx, config, d.Id())
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Kafka Cluster %q", d.Id()))
+	}
+
+	var declared []string
+	for _, t := range d.Get("topic").([]interface{}) {
+		declared = append(declared, t.(map[string]interface{})["name"].(string))
+	}
+	sortKafkaTopicsByNames(topics, declared)
+
+	if err := d.Set("cluster_id", d.Id()); err != nil {
+		return err
+	}
+	if _, ok := d.GetOk("concurrency"); !ok {
+		if err := d.Set("concurrency", defaultMDBKafkaTopicsConcurrency); err != nil {
+			return err
+		}
+	}
+	return d.Set("topic", flattenKafkaTopics(topics))
+}
+
+func resourceYandexMDBKafkaTopicsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("topic") {
+		if err := applyKafkaTopics(d, meta, schema.TimeoutUpdate); err != nil {
+			// Record the topics as they are after the failed batch, so that the next plan retries the rest.
+			if readErr := resourceYandexMDBKafkaTopicsRead(d, meta); readErr != nil {
+				log.Printf("[WARN] Failed to read topics of Kafka Cluster %q: %s", d.Id(), readErr)
+			}
+			return err
+		}
+	}
+
+	return resourceYandexMDBKafkaTopicsRead(d, meta)
+}
+
+func resourceYandexMDBKafkaTopicsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	batch := &kafkaTopicsBatch{}
+	for _, t := range d.Get("topic").([]interface{}) {
+		batch.deletes = append(batch.deletes, t.(map[string]interface{})["name"].(string))
+	}
+
+	if err := batch.apply(ctx, d, NewKafkaTopicManager(config), d.Get("concurrency").(int)); err != nil {
+		return fmt.Errorf("error while deleting topics of Kafka Cluster %q: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Finished deleting topics of Kafka Cluster %q", d.Id())
+	return nil
+}
+
+// applyKafkaTopics brings the topics of the cluster to the declared ones.
+func applyKafkaTopics(d *schema.ResourceData, meta interface{}, timeout string) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(timeout))
+	defer cancel()
+
+	version, err := getKafkaVersion(ctx, d, config)
+	if err != nil {
+		return fmt.Errorf("error while getting Kafka Cluster %q: %s", d.Id(), err)
+	}
+
+	topics, err := listKafkaTopics(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	batch, err := buildKafkaTopicsBatch(d, topics, version)
+	if err != nil {
+		return err
+	}
+	if batch.empty() {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Applying topics of Kafka Cluster %q: %d to delete, %d to create, %d to update",
+		d.Id(), len(batch.deletes), len(batch.creates), len(batch.updates))
+	if err := batch.apply(ctx, d, NewKafkaTopicManager(config), d.Get("concurrency").(int)); err != nil {
+		return fmt.Errorf("error while applying topics of Kafka Cluster %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// sortKafkaTopicsByNames orders the topics as they are declared, the undeclared ones go last by name.
+func sortKafkaTopicsByNames(topics []*kafka.Topic, names []string) {
+	order := map[string]int{}
+	for i, name := range names {
+		order[name] = i
+	}
+	sort.SliceStable(topics, func(i, j int) bool {
+		oi, iok := order[topics[i].Name]
+		oj, jok := order[topics[j].Name]
+		if iok && jok {
+			return oi < oj
+		}
+		if iok != jok {
+			return iok
+		}
+		return topics[i].Name < topics[j].Name
+	})
+}
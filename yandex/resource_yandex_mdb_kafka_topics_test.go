@@ -0,0 +1,264 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/mocks"
+)
+
+const kafkaTopicsResourceName = "yandex_mdb_kafka_topics.foo"
+
+func TestBuildKafkaTopicsBatch(t *testing.T) {
+	raw := map[string]interface{}{
+		"cluster_id": "cid",
+		"topic": []interface{}{
+			map[string]interface{}{
+				"name":               "sameTopic",
+				"partitions":         1,
+				"replication_factor": 1,
+			},
+			map[string]interface{}{
+				"name":               "updatedTopic",
+				"partitions":         2,
+				"replication_factor": 1,
+				"topic_config": []interface{}{
+					map[string]interface{}{
+						"cleanup_policy": "CLEANUP_POLICY_COMPACT",
+					},
+				},
+			},
+			map[string]interface{}{
+				"name":               "newTopic",
+				"partitions":         3,
+				"replication_factor": 1,
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaTopics().Schema, raw)
+
+	current := []*kafka.Topic{
+		{
+			Name:              "sameTopic",
+			Partitions:        wrapperspb.Int64(1),
+			ReplicationFactor: wrapperspb.Int64(1),
+		},
+		{
+			Name:              "updatedTopic",
+			Partitions:        wrapperspb.Int64(1),
+			ReplicationFactor: wrapperspb.Int64(1),
+			TopicConfig: &kafka.Topic_TopicConfig_3{
+				TopicConfig_3: &kafka.TopicConfig3{
+					CleanupPolicy: kafka.TopicConfig3_CLEANUP_POLICY_DELETE,
+				},
+			},
+		},
+		{
+			Name:              "undeclaredTopic2",
+			Partitions:        wrapperspb.Int64(1),
+			ReplicationFactor: wrapperspb.Int64(1),
+		},
+		{
+			Name:              "undeclaredTopic1",
+			Partitions:        wrapperspb.Int64(1),
+			ReplicationFactor: wrapperspb.Int64(1),
+		},
+	}
+
+	batch, err := buildKafkaTopicsBatch(d, current, "3.5")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"undeclaredTopic1", "undeclaredTopic2"}, batch.deletes)
+
+	require.Len(t, batch.creates, 1)
+	assert.Equal(t, (&kafka.TopicSpec{
+		Name:              "newTopic",
+		Partitions:        wrapperspb.Int64(3),
+		ReplicationFactor: wrapperspb.Int64(1),
+	}).String(), batch.creates[0].String())
+
+	require.Len(t, batch.updates, 1)
+	assert.Equal(t, []string{"topic_spec.partitions", "topic_spec.topic_config_3.cleanup_policy"}, batch.updates[0].paths)
+	assert.Equal(t, (&kafka.TopicSpec{
+		Name:              "updatedTopic",
+		Partitions:        wrapperspb.Int64(2),
+		ReplicationFactor: wrapperspb.Int64(1),
+		TopicConfig: &kafka.TopicSpec_TopicConfig_3{
+			TopicConfig_3: &kafka.TopicConfig3{
+				CleanupPolicy: kafka.TopicConfig3_CLEANUP_POLICY_COMPACT,
+			},
+		},
+	}).String(), batch.updates[0].topicSpec.String())
+}
+
+func TestBuildKafkaTopicsBatchDuplicateTopic(t *testing.T) {
+	raw := map[string]interface{}{
+		"cluster_id": "cid",
+		"topic": []interface{}{
+			map[string]interface{}{"name": "topic", "partitions": 1, "replication_factor": 1},
+			map[string]interface{}{"name": "topic", "partitions": 2, "replication_factor": 1},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaTopics().Schema, raw)
+
+	_, err := buildKafkaTopicsBatch(d, nil, "3.5")
+	require.EqualError(t, err, `topic "topic" is declared more than once`)
+}
+
+func TestKafkaTopicsBatchApply(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceYandexMDBKafkaTopics().Schema, map[string]interface{}{"cluster_id": "cid"})
+	batch := &kafkaTopicsBatch{
+		deletes: []string{"deleted1", "deleted2", "deleted3"},
+		creates: []*kafka.TopicSpec{{Name: "created1"}, {Name: "created2"}},
+		updates: []kafkaTopicUpdate{{topicSpec: &kafka.TopicSpec{Name: "updated"}, paths: []string{"topic_spec.partitions"}}},
+	}
+
+	var (
+		mu    sync.Mutex
+		calls []string
+	)
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call)
+	}
+
+	ctrl := gomock.NewController(t)
+	topicModifier := mocks.NewMockKafkaTopicModifier(ctrl)
+	topicModifier.EXPECT().DeleteKafkaTopic(gomock.Any(), d, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, d *schema.ResourceData, topicName string) error {
+			record("delete " + topicName)
+			return nil
+		}).Times(3)
+	topicModifier.EXPECT().CreateKafkaTopic(gomock.Any(), d, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec) error {
+			record("create " + topicSpec.Name)
+			if topicSpec.Name == "created2" {
+				return fmt.Errorf("create failed")
+			}
+			return nil
+		}).Times(2)
+	topicModifier.EXPECT().UpdateKafkaTopic(gomock.Any(), d, gomock.Any(), []string{"topic_spec.partitions"}).DoAndReturn(
+		func(ctx context.Context, d *schema.ResourceData, topicSpec *kafka.TopicSpec, paths []string) error {
+			record("update " + topicSpec.Name)
+			return nil
+		}).Times(1)
+
+	err := batch.apply(context.Background(), d, topicModifier, 2)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "create failed")
+
+	require.Len(t, calls, 6)
+	assert.ElementsMatch(t, []string{"delete deleted1", "delete deleted2", "delete deleted3"}, calls[:3])
+	assert.ElementsMatch(t, []string{"create created1", "create created2", "update updated"}, calls[3:])
+}
+
+func TestSortKafkaTopicsByNames(t *testing.T) {
+	topics := []*kafka.Topic{{Name: "d"}, {Name: "c"}, {Name: "b"}, {Name: "a"}}
+	sortKafkaTopicsByNames(topics, []string{"c", "a"})
+
+	var names []string
+	for _, topic := range topics {
+		names = append(names, topic.Name)
+	}
+	assert.Equal(t, []string{"c", "a", "b", "d"}, names)
+}
+
+func TestAccMDBKafkaTopics_basic(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-kafka")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaTopicsConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaTopicHasPartitions("events", 2),
+					testAccCheckMDBKafkaTopicHasPartitions("transactions", 1),
+					testAccCheckMDBKafkaClusterHasTopic("logs"),
+					resource.TestCheckResourceAttr(kafkaTopicsResourceName, "topic.#", "3"),
+					resource.TestCheckResourceAttr(kafkaTopicsResourceName, "topic.0.name", "events"),
+				),
+			},
+			mdbKafkaTopicImportStep(kafkaTopicsResourceName),
+			{
+				Config: testAccMDBKafkaTopicsConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaTopicHasPartitions("events", 4),
+					testAccCheckMDBKafkaTopicHasPartitions("transactions", 1),
+					testAccCheckMDBKafkaClusterDoesNotHaveTopic("logs"),
+					testAccCheckMDBKafkaClusterHasTopic("metrics"),
+					resource.TestCheckResourceAttr(kafkaTopicsResourceName, "topic.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBKafkaTopicsConfigStep1(name string) string {
+	return testAccMDBKafkaTopicConfigStep0(name) + `
+resource "yandex_mdb_kafka_topics" "foo" {
+  cluster_id  = yandex_mdb_kafka_cluster.foo.id
+  concurrency = 2
+
+  topic {
+    name               = "events"
+    partitions         = 2
+    replication_factor = 1
+    topic_config {
+      flush_ms = 2000
+    }
+  }
+  topic {
+    name               = "transactions"
+    partitions         = 1
+    replication_factor = 1
+  }
+  topic {
+    name               = "logs"
+    partitions         = 1
+    replication_factor = 1
+  }
+}
+`
+}
+
+func testAccMDBKafkaTopicsConfigStep2(name string) string {
+	return testAccMDBKafkaTopicConfigStep0(name) + `
+resource "yandex_mdb_kafka_topics" "foo" {
+  cluster_id  = yandex_mdb_kafka_cluster.foo.id
+  concurrency = 2
+
+  topic {
+    name               = "events"
+    partitions         = 4
+    replication_factor = 1
+    topic_config {
+      flush_ms = 4000
+    }
+  }
+  topic {
+    name               = "transactions"
+    partitions         = 1
+    replication_factor = 1
+  }
+  topic {
+    name               = "metrics"
+    partitions         = 1
+    replication_factor = 1
+  }
+}
+`
+}
@@ -0,0 +1,182 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	yandexMDBKafkaUserPermissionsCreateTimeout = 10 * time.Minute
+	yandexMDBKafkaUserPermissionsReadTimeout   = 1 * time.Minute
+	yandexMDBKafkaUserPermissionsUpdateTimeout = 10 * time.Minute
+	yandexMDBKafkaUserPermissionsDeleteTimeout = 10 * time.Minute
+)
+
+func resourceYandexMDBKafkaUserPermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBKafkaUserPermissionsCreate,
+		Read:   resourceYandexMDBKafkaUserPermissionsRead,
+		Update: resourceYandexMDBKafkaUserPermissionsUpdate,
+		Delete: resourceYandexMDBKafkaUserPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBKafkaUserPermissionsCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBKafkaUserPermissionsReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBKafkaUserPermissionsUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBKafkaUserPermissionsDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      kafkaUserPermissionHash,
+				Elem:     resourceYandexMDBKafkaUserPermission(),
+			},
+		},
+	}
+}
+
+// resourceYandexMDBKafkaUserPermission is resourceYandexMDBKafkaPermission validated at plan time.
+func resourceYandexMDBKafkaUserPermission() *schema.Resource {
+	permission := resourceYandexMDBKafkaPermission()
+	permission.Schema["topic_name"].ValidateFunc = validateKafkaPermissionTopicName
+	permission.Schema["role"].ValidateFunc = validation.StringInSlice(kafkaPermissionRoles(), false)
+	return permission
+}
+
+// validateKafkaPermissionTopicName accepts a topic name, or a prefix ending with the * wildcard, or * for all topics.
+func validateKafkaPermissionTopicName(v interface{}, k string) ([]string, []error) {
+	name := v.(string)
+	if name == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+	if i := strings.Index(name, "*"); i >= 0 && i != len(name)-1 {
+		return nil, []error{fmt.Errorf("%q may only have the * wildcard at the end, got %q", k, name)}
+	}
+	return nil, nil
+}
+
+func kafkaPermissionRoles() []string {
+	var roles []string
+	for name, value := range kafka.Permission_AccessRole_value {
+		if value != int32(kafka.Permission_ACCESS_ROLE_UNSPECIFIED) {
+			roles = append(roles, name)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+func resourceYandexMDBKafkaUserPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateKafkaUserPermissions(d, meta, schema.TimeoutCreate); err != nil {
+		return err
+	}
+
+	d.SetId(constructResourceId(d.Get("cluster_id").(string), d.Get("user_name").(string)))
+	return resourceYandexMDBKafkaUserPermissionsRead(d, meta)
+}
+
+func resourceYandexMDBKafkaUserPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, userName, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+	user, err := config.sdk.MDB().Kafka().User().Get(ctx, &kafka.GetUserRequest{
+		ClusterId: clusterID,
+		UserName:  userName,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("User %q", userName))
+	}
+
+	if err = d.Set("cluster_id", clusterID); err != nil {
+		return err
+	}
+	if err = d.Set("user_name", user.Name); err != nil {
+		return err
+	}
+	return d.Set("permission", flattenKafkaUserPermissions(user))
+}
+
+func resourceYandexMDBKafkaUserPermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("permission") {
+		if err := updateKafkaUserPermissions(d, meta, schema.TimeoutUpdate); err != nil {
+			return err
+		}
+	}
+	return resourceYandexMDBKafkaUserPermissionsRead(d, meta)
+}
+
+func resourceYandexMDBKafkaUserPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	userName := d.Get("user_name").(string)
+	_, err := config.sdk.MDB().Kafka().User().Get(ctx, &kafka.GetUserRequest{
+		ClusterId: d.Get("cluster_id").(string),
+		UserName:  userName,
+	})
+	if isStatusWithCode(err, codes.NotFound) {
+		log.Printf("[DEBUG] Kafka user %q doesn't exist anymore, nothing to revoke", userName)
+		return nil
+	}
+
+	if err := d.Set("permission", nil); err != nil {
+		return err
+	}
+	if err := updateKafkaUserPermissions(d, meta, schema.TimeoutDelete); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished revoking permissions of Kafka user %q", userName)
+	return nil
+}
+
+// updateKafkaUserPermissions replaces all the permissions of the user with the declared ones.
+func updateKafkaUserPermissions(d *schema.ResourceData, meta interface{}, timeout string) error {
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(timeout))
+	defer cancel()
+
+	permissions, err := expandKafkaPermissions(d.Get("permission").(*schema.Set))
+	if err != nil {
+		return err
+	}
+
+	return updateKafkaUser(ctx, config, &kafka.UpdateUserRequest{
+		ClusterId:   d.Get("cluster_id").(string),
+		UserName:    d.Get("user_name").(string),
+		Permissions: permissions,
+		UpdateMask:  &field_mask.FieldMask{Paths: []string{"permissions"}},
+	})
+}
@@ -0,0 +1,128 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+)
+
+func TestValidateKafkaPermissionTopicName(t *testing.T) {
+	for name, valid := range map[string]bool{
+		"raw_events":  true,
+		"raw_*":       true,
+		"*":           true,
+		"":            false,
+		"raw_*_event": false,
+		"**":          false,
+	} {
+		_, errs := validateKafkaPermissionTopicName(name, "topic_name")
+		assert.Equal(t, valid, len(errs) == 0, "topic name %q", name)
+	}
+}
+
+func TestKafkaPermissionRoles(t *testing.T) {
+	roles := kafkaPermissionRoles()
+	assert.Contains(t, roles, "ACCESS_ROLE_PRODUCER")
+	assert.Contains(t, roles, "ACCESS_ROLE_CONSUMER")
+	assert.NotContains(t, roles, "ACCESS_ROLE_UNSPECIFIED")
+}
+
+func TestAccMDBKafkaUserPermissions_basic(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-kafka")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaUserPermissionsConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaUserHasPermissions("events-user", []*kafka.Permission{
+						{
+							TopicName:  "raw_events",
+							Role:       kafka.Permission_ACCESS_ROLE_PRODUCER,
+							AllowHosts: []string{"host1.db.yandex.net"},
+						},
+						{
+							TopicName: "raw_*",
+							Role:      kafka.Permission_ACCESS_ROLE_CONSUMER,
+						},
+					}),
+				),
+			},
+			{
+				ResourceName:      "yandex_mdb_kafka_user_permissions.events_user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMDBKafkaUserPermissionsConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBKafkaUserHasPermissions("events-user", []*kafka.Permission{
+						{
+							TopicName: "*",
+							Role:      kafka.Permission_ACCESS_ROLE_ADMIN,
+						},
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccMDBKafkaUserPermissionsConfigStep1(name string) string {
+	return testAccMDBKafkaUserConfigStep0(name) + `
+resource "yandex_mdb_kafka_user" events_user {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "events-user"
+  password   = "test-password-123"
+
+  lifecycle {
+    ignore_changes = [permission]
+  }
+}
+
+resource "yandex_mdb_kafka_user_permissions" events_user {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  user_name  = yandex_mdb_kafka_user.events_user.name
+
+  permission {
+    topic_name  = "raw_events"
+    role        = "ACCESS_ROLE_PRODUCER"
+    allow_hosts = ["host1.db.yandex.net"]
+  }
+  permission {
+    topic_name = "raw_*"
+    role       = "ACCESS_ROLE_CONSUMER"
+  }
+}
+`
+}
+
+func testAccMDBKafkaUserPermissionsConfigStep2(name string) string {
+	return testAccMDBKafkaUserConfigStep0(name) + `
+resource "yandex_mdb_kafka_user" events_user {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  name       = "events-user"
+  password   = "test-password-123"
+
+  lifecycle {
+    ignore_changes = [permission]
+  }
+}
+
+resource "yandex_mdb_kafka_user_permissions" events_user {
+  cluster_id = yandex_mdb_kafka_cluster.foo.id
+  user_name  = yandex_mdb_kafka_user.events_user.name
+
+  permission {
+    topic_name = "*"
+    role       = "ACCESS_ROLE_ADMIN"
+  }
+}
+`
+}
@@ -710,27 +710,7 @@ func resourceYandexMDBMongodbCluster() *schema.Resource {
 				Set:      schema.HashString,
 				Optional: true,
 			},
-			"restore": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
-				ForceNew: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"backup_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-						"time": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: stringToTimeValidateFunc,
-						},
-					},
-				},
-			},
+			"restore": mdbRestoreSchema(mdbRestoreResource(true)),
 			"maintenance_window": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -930,13 +910,13 @@ func resourceYandexMDBMongodbClusterRestore(d *schema.ResourceData, meta interfa
 	config := meta.(*Config)
 
 	var timeBackup *mongodb.RestoreClusterRequest_RecoveryTargetSpec = nil
-	if backupTime, ok := d.GetOk("restore.0.time"); ok {
-		time, err := parseStringToTime(backupTime.(string))
-		if err != nil {
-			return diag.Errorf("Error while parsing restore.0.time to create MongoDB Clsuter from backup %v, value: %v error: %s", backupID, backupTime, err)
-		}
+	restoreTime, err := expandMDBRestoreTime(d)
+	if err != nil {
+		return diag.Errorf("Error while creating MongoDB Cluster from backup %v: %s", backupID, err)
+	}
+	if restoreTime != nil {
 		timeBackup = &mongodb.RestoreClusterRequest_RecoveryTargetSpec{
-			Timestamp: time.Unix(),
+			Timestamp: restoreTime.Seconds,
 		}
 	}
 
@@ -6,7 +6,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -21,22 +21,28 @@ const (
 )
 
 func resourceYandexMDBMySQLCluster() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceYandexMDBMySQLClusterCreate,
-		Read:   resourceYandexMDBMySQLClusterRead,
-		Update: resourceYandexMDBMySQLClusterUpdate,
-		Delete: resourceYandexMDBMySQLClusterDelete,
+	r := &schema.Resource{
+		Create:        resourceYandexMDBMySQLClusterCreate,
+		Read:          resourceYandexMDBMySQLClusterRead,
+		UpdateContext: mdbMySQLVersionUpgrade.withWarnings(resourceYandexMDBMySQLClusterUpdate),
+		Delete:        resourceYandexMDBMySQLClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importMDBClusterWithManagedHosts,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			validateMDBClusterHosts,
+			mdbMySQLVersionUpgrade.customizeDiff,
+			mdbMySQLVersionSettings.validateSettings,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexMDBMySQLClusterDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexMDBMySQLClusterUpdateTimeout),
 			Delete: schema.DefaultTimeout(yandexMDBMySQLClusterDefaultTimeout),
 		},
 
-		SchemaVersion: 0,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -176,9 +182,9 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 				},
 			},
 			"host": {
-				Type:     schema.TypeList,
-				MinItems: 1,
-				Required: true,
+				Type:             schema.TypeList,
+				DiffSuppressFunc: suppressMDBUnmanagedHostsDiff,
+				Optional:         true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"zone": {
@@ -222,6 +228,11 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 					},
 				},
 			},
+			"manage_hosts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -282,35 +293,14 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 				Set:      schema.HashString,
 				Optional: true,
 			},
-			"restore": {
+			"restore": mdbRestoreSchema(mdbRestoreResource(true)),
+			"mysql_config": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
 				Optional: true,
-				ForceNew: true,
+				Computed: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"backup_id": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
-						},
-						"time": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: stringToTimeValidateFunc,
-						},
-					},
-				},
-			},
-			"mysql_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbMySQLSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbMySQLSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Schema: generateBlockSchema(mdbMySQLSettingsFieldsInfo),
 				},
 			},
 			"access": {
@@ -374,6 +364,11 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"allow_dropping_settings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allows the version upgrade to drop the settings of the cluster the target version doesn't support.",
+			},
 			"performance_diagnostics": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -406,6 +401,14 @@ func resourceYandexMDBMySQLCluster() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    mdbResourceWithSettingsMapsV0(r, "mysql_config").CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceYandexMDBMySQLClusterStateUpgradeV0,
+			Version: 0,
+		},
+	}
+	return r
 }
 
 func resourceYandexMDBMySQLClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -470,24 +473,16 @@ func resourceYandexMDBMySQLClusterRestore(d *schema.ResourceData, meta interface
 		return err
 	}
 
-	timeBackup := time.Now()
-
-	if backupTime, ok := d.GetOk("restore.0.time"); ok {
-		var err error
-		timeBackup, err = parseStringToTime(backupTime.(string))
-		if err != nil {
-			return fmt.Errorf("Error while parsing restore.0.time to create MySQL Cluster from backup %v, value: %v error: %s", backupID, backupTime, err)
-		}
-
+	timeBackup, err := expandMDBRestoreTimeOrNow(d)
+	if err != nil {
+		return fmt.Errorf("Error while creating MySQL Cluster from backup %v: %s", backupID, err)
 	}
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 	op, err := config.sdk.WrapOperation(config.sdk.MDB().MySQL().Cluster().Restore(ctx, &mysql.RestoreClusterRequest{
-		BackupId: backupID,
-		Time: &timestamp.Timestamp{
-			Seconds: timeBackup.Unix(),
-		},
+		BackupId:         backupID,
+		Time:             timeBackup,
 		Name:             req.Name,
 		Description:      req.Description,
 		Labels:           req.Labels,
@@ -763,6 +758,12 @@ func resourceYandexMDBMySQLClusterUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
+	if d.HasChange("version") {
+		if err := upgradeMySQLClusterVersion(d, meta); err != nil {
+			return err
+		}
+	}
+
 	if err := updateMysqlClusterParams(d, meta); err != nil {
 		return err
 	}
@@ -781,7 +782,7 @@ func resourceYandexMDBMySQLClusterUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("host") {
+	if d.HasChange("host") && d.Get("manage_hosts").(bool) {
 		if err := updateMysqlClusterHosts(d, config); err != nil {
 			return err
 		}
@@ -799,13 +800,37 @@ var mdbMysqlUpdateFieldsMap = map[string]string{
 	"backup_window_start":       "config_spec.backup_window_start",
 	"resources":                 "config_spec.resources",
 	"backup_retain_period_days": "config_spec.backup_retain_period_days",
-	"version":                   "config_spec.version",
 	"performance_diagnostics":   "config_spec.performance_diagnostics",
 	"security_group_ids":        "security_group_ids",
 	"maintenance_window":        "maintenance_window",
 	"deletion_protection":       "deletion_protection",
 }
 
+// upgradeMySQLClusterVersion upgrades the cluster to the target major version before the other parameters are updated.
+func upgradeMySQLClusterVersion(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	return mdbMySQLVersionUpgrade.run(d, func(version string) error {
+		ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		request := &mysql.UpdateClusterRequest{
+			ClusterId:  d.Id(),
+			ConfigSpec: &mysql.ConfigSpec{Version: version},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"config_spec.version"}},
+		}
+		op, err := config.sdk.WrapOperation(config.sdk.MDB().MySQL().Cluster().Update(ctx, request))
+		if err != nil {
+			return fmt.Errorf("error while requesting API to update MySQL Cluster %q: %s", d.Id(), err)
+		}
+
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("error while waiting for operation to update MySQL Cluster %q: %s", d.Id(), err)
+		}
+		return nil
+	})
+}
+
 func updateMysqlClusterParams(d *schema.ResourceData, meta interface{}) error {
 	request, err := prepareMySQLClusterUpdateRequest(d)
 	if err != nil {
@@ -895,8 +920,11 @@ func prepareMySQLClusterUpdateRequest(d *schema.ResourceData) (*mysql.UpdateClus
 	}
 
 	if d.HasChange("mysql_config") {
-		version := d.Get("version").(string)
-		updatePaths = append(updatePaths, "config_spec."+getMySQLConfigFieldName(version))
+		configPath := "config_spec." + getMySQLConfigFieldName(d.Get("version").(string)) + "."
+		updatePaths = append(updatePaths, changedSettingsPaths(mdbMySQLSettingsFieldsInfo, d, "mysql_config", configPath)...)
+		if d.HasChange("mysql_config.0.sql_mode") {
+			updatePaths = append(updatePaths, configPath+"sql_mode")
+		}
 	}
 
 	return &mysql.UpdateClusterRequest{
@@ -87,6 +87,7 @@ func mdbMysqlClusterImportStep(name string) resource.TestStep {
 			"health",                         // volatile value
 			"host",                           // the order of hosts differs
 			"allow_regeneration_host",        // Only state flag
+			"allow_dropping_settings",        // Only state flag
 			"host.0.name",                    // not returned
 			"host.1.name",                    // not returned
 			"host.2.name",                    // not returned
@@ -142,8 +143,8 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 					resource.TestCheckResourceAttr(mysqlResource, "maintenance_window.0.day", "SAT"),
 					resource.TestCheckResourceAttr(mysqlResource, "maintenance_window.0.hour", "12"),
 
-					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.sql_mode", "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION"),
-					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.innodb_print_all_deadlocks", "true"),
+					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.0.sql_mode", "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION"),
+					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.0.innodb_print_all_deadlocks", "true"),
 
 					resource.TestCheckResourceAttr(mysqlResource, "backup_retain_period_days", "12"),
 
@@ -223,10 +224,10 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 					resource.TestCheckResourceAttr(mysqlResource, "access.0.web_sql", "true"),
 					resource.TestCheckResourceAttr(mysqlResource, "access.0.data_lens", "true"),
 					resource.TestCheckResourceAttr(mysqlResource, "access.0.data_transfer", "true"),
-					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.sql_mode", "IGNORE_SPACE,NO_ENGINE_SUBSTITUTION,NO_ZERO_DATE,HIGH_NOT_PRECEDENCE"),
-					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.max_connections", "10"),
-					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.default_authentication_plugin", "MYSQL_NATIVE_PASSWORD"),
-					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.innodb_print_all_deadlocks", "true"),
+					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.0.sql_mode", "IGNORE_SPACE,NO_ENGINE_SUBSTITUTION,NO_ZERO_DATE,HIGH_NOT_PRECEDENCE"),
+					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.0.max_connections", "10"),
+					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.0.default_authentication_plugin", "MYSQL_NATIVE_PASSWORD"),
+					resource.TestCheckResourceAttr(mysqlResource, "mysql_config.0.innodb_print_all_deadlocks", "true"),
 
 					resource.TestCheckResourceAttr(mysqlResource, "maintenance_window.0.day", "WED"),
 					resource.TestCheckResourceAttr(mysqlResource, "maintenance_window.0.hour", "22"),
@@ -767,7 +768,7 @@ resource "yandex_mdb_mysql_cluster" "foo" {
     test_key = "test_value"
   }
 
-  mysql_config = {
+  mysql_config {
     sql_mode                      = "ONLY_FULL_GROUP_BY,STRICT_TRANS_TABLES,NO_ZERO_IN_DATE,NO_ZERO_DATE,ERROR_FOR_DIVISION_BY_ZERO,NO_ENGINE_SUBSTITUTION"
     innodb_print_all_deadlocks    = true
   }
@@ -892,7 +893,7 @@ resource "yandex_mdb_mysql_cluster" "foo" {
     disk_size          = 24
   }
 
-  mysql_config = {
+  mysql_config {
     sql_mode                      = "IGNORE_SPACE,NO_ENGINE_SUBSTITUTION,NO_ZERO_DATE,HIGH_NOT_PRECEDENCE"
     max_connections               = 10
     default_authentication_plugin = "MYSQL_NATIVE_PASSWORD"
@@ -0,0 +1,258 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mysql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	yandexMDBMySQLHostCreateTimeout = 30 * time.Minute
+	yandexMDBMySQLHostReadTimeout   = 1 * time.Minute
+	yandexMDBMySQLHostUpdateTimeout = 30 * time.Minute
+	yandexMDBMySQLHostDeleteTimeout = 30 * time.Minute
+)
+
+func resourceYandexMDBMySQLHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBMySQLHostCreate,
+		Read:   resourceYandexMDBMySQLHostRead,
+		Update: resourceYandexMDBMySQLHostUpdate,
+		Delete: resourceYandexMDBMySQLHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBMySQLHostCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBMySQLHostReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBMySQLHostUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBMySQLHostDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"assign_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"backup_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"replication_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBMySQLHostCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	request := &mysql.AddClusterHostsRequest{
+		ClusterId: clusterID,
+		HostSpecs: []*mysql.HostSpec{
+			{
+				ZoneId:            d.Get("zone").(string),
+				SubnetId:          d.Get("subnet_id").(string),
+				AssignPublicIp:    d.Get("assign_public_ip").(bool),
+				ReplicationSource: d.Get("replication_source").(string),
+				Priority:          int64(d.Get("priority").(int)),
+				BackupPriority:    int64(d.Get("backup_priority").(int)),
+			},
+		},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending MySQL cluster add hosts request: %+v", request)
+		return config.sdk.MDB().MySQL().Cluster().AddHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create host for MySQL Cluster %q: %s", clusterID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while getting MySQL add hosts operation metadata: %s", err)
+	}
+
+	fqdn, ok := mdbAddedHostName(protoMetadata)
+	if !ok {
+		return fmt.Errorf("could not get host name from add hosts operation metadata")
+	}
+	d.SetId(constructResourceId(clusterID, fqdn))
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while creating host for MySQL Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("creating host for MySQL Cluster %q failed: %s", clusterID, err)
+	}
+
+	return resourceYandexMDBMySQLHostRead(d, meta)
+}
+
+func resourceYandexMDBMySQLHostRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, fqdn, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hosts, err := listMysqlHosts(ctx, config, clusterID)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MySQL Cluster %q", clusterID))
+	}
+
+	var host *mysql.Host
+	for _, h := range hosts {
+		if h.Name == fqdn {
+			host = h
+			break
+		}
+	}
+	if host == nil {
+		log.Printf("[WARN] Removing host %q because it doesn't exist in MySQL Cluster %q anymore", fqdn, clusterID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("zone", host.ZoneId)
+	d.Set("subnet_id", host.SubnetId)
+	d.Set("assign_public_ip", host.AssignPublicIp)
+	d.Set("priority", host.Priority)
+	d.Set("backup_priority", host.BackupPriority)
+	d.Set("replication_source", host.ReplicationSource)
+	d.Set("fqdn", host.Name)
+	d.Set("role", host.Role.String())
+
+	return nil
+}
+
+func resourceYandexMDBMySQLHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	spec := &mysql.UpdateHostSpec{
+		HostName:          d.Get("fqdn").(string),
+		AssignPublicIp:    d.Get("assign_public_ip").(bool),
+		ReplicationSource: d.Get("replication_source").(string),
+		Priority:          int64(d.Get("priority").(int)),
+		BackupPriority:    int64(d.Get("backup_priority").(int)),
+		UpdateMask:        &fieldmaskpb.FieldMask{},
+	}
+	for _, field := range []string{"assign_public_ip", "replication_source", "priority", "backup_priority"} {
+		if d.HasChange(field) {
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, field)
+		}
+	}
+	if len(spec.UpdateMask.Paths) == 0 {
+		return resourceYandexMDBMySQLHostRead(d, meta)
+	}
+
+	request := &mysql.UpdateClusterHostsRequest{
+		ClusterId:       clusterID,
+		UpdateHostSpecs: []*mysql.UpdateHostSpec{spec},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending MySQL cluster update hosts request: %+v", request)
+		return config.sdk.MDB().MySQL().Cluster().UpdateHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update host for MySQL Cluster %q - host %v: %s", clusterID, spec.HostName, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while updating host for MySQL Cluster %q - host %v: %s", clusterID, spec.HostName, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("updating host for MySQL Cluster %q - host %v failed: %s", clusterID, spec.HostName, err)
+	}
+
+	return resourceYandexMDBMySQLHostRead(d, meta)
+}
+
+func resourceYandexMDBMySQLHostDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	request := &mysql.DeleteClusterHostsRequest{
+		ClusterId: clusterID,
+		HostNames: []string{fqdn},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending MySQL cluster delete hosts request: %+v", request)
+		return config.sdk.MDB().MySQL().Cluster().DeleteHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to delete host from MySQL Cluster %q: %s", clusterID, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while deleting host from MySQL Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("deleting host from MySQL Cluster %q failed: %s", clusterID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	mysqlHostResourceNameB = "yandex_mdb_mysql_host.b"
+	mysqlHostResourceNameC = "yandex_mdb_mysql_host.c"
+)
+
+// Test that MySQL hosts of a cluster with unmanaged hosts can be added, updated and removed
+func TestAccMDBMySQLHost_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-mysql-host")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBMysqlClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBMySQLHostConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(mysqlHostResourceNameB, "name", "b"),
+					resource.TestCheckResourceAttr(mysqlHostResourceNameB, "zone", "ru-central1-b"),
+					resource.TestCheckResourceAttr(mysqlHostResourceNameB, "role", "REPLICA"),
+					resource.TestCheckResourceAttrSet(mysqlHostResourceNameB, "fqdn"),
+					testAccCheckMDBMySQLClusterHostsCount(2),
+				),
+			},
+			mdbMySQLHostImportStep(mysqlHostResourceNameB),
+			{
+				Config: testAccMDBMySQLHostConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(mysqlHostResourceNameB, "name", "backup"),
+					resource.TestCheckResourceAttr(mysqlHostResourceNameB, "priority", "5"),
+					resource.TestCheckResourceAttr(mysqlHostResourceNameB, "backup_priority", "10"),
+					resource.TestCheckResourceAttrPair(mysqlHostResourceNameC, "replication_source", mysqlHostResourceNameB, "fqdn"),
+					testAccCheckMDBMySQLClusterHostsCount(3),
+				),
+			},
+			mdbMySQLHostImportStep(mysqlHostResourceNameC),
+			{
+				Config: testAccMDBMySQLHostConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBMySQLClusterHostsCount(2),
+				),
+			},
+		},
+	})
+}
+
+func mdbMySQLHostImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"name", // not returned
+		},
+	}
+}
+
+func testAccCheckMDBMySQLClusterHostsCount(count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[mysqlResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found", mysqlResource)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		hosts, err := listMysqlHosts(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(hosts) != count {
+			return fmt.Errorf("expected %d hosts in MySQL Cluster %q, got %d", count, rs.Primary.ID, len(hosts))
+		}
+		return nil
+	}
+}
+
+func testAccMDBMySQLHostConfigStep0(name string) string {
+	return fmt.Sprintf(mysqlVPCDependencies+`
+resource "yandex_mdb_mysql_cluster" "foo" {
+  name         = "%s"
+  description  = "MySQL Host Terraform Test"
+  environment  = "PRESTABLE"
+  network_id   = yandex_vpc_network.foo.id
+  version      = "8.0"
+  manage_hosts = false
+
+  resources {
+    resource_preset_id = "s2.micro"
+    disk_type_id       = "network-ssd"
+    disk_size          = 16
+  }
+
+  host {
+    zone      = "ru-central1-d"
+    subnet_id = yandex_vpc_subnet.foo_c.id
+  }
+}
+`, name)
+}
+
+// Add a host to the cluster
+func testAccMDBMySQLHostConfigStep1(name string) string {
+	return testAccMDBMySQLHostConfigStep0(name) + `
+resource "yandex_mdb_mysql_host" "b" {
+  name       = "b"
+  cluster_id = yandex_mdb_mysql_cluster.foo.id
+  zone       = "ru-central1-b"
+  subnet_id  = yandex_vpc_subnet.foo_b.id
+}
+`
+}
+
+// Update the host and add a cascade replica of it
+func testAccMDBMySQLHostConfigStep2(name string) string {
+	return testAccMDBMySQLHostConfigStep0(name) + `
+resource "yandex_mdb_mysql_host" "b" {
+  name            = "backup"
+  cluster_id      = yandex_mdb_mysql_cluster.foo.id
+  zone            = "ru-central1-b"
+  subnet_id       = yandex_vpc_subnet.foo_b.id
+  priority        = 5
+  backup_priority = 10
+}
+
+resource "yandex_mdb_mysql_host" "c" {
+  cluster_id         = yandex_mdb_mysql_cluster.foo.id
+  zone               = "ru-central1-a"
+  subnet_id          = yandex_vpc_subnet.foo_a.id
+  replication_source = yandex_mdb_mysql_host.b.fqdn
+}
+`
+}
@@ -7,8 +7,8 @@ import (
 	"math"
 	"time"
 
-	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -24,22 +24,28 @@ const (
 )
 
 func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceYandexMDBPostgreSQLClusterCreate,
-		Read:   resourceYandexMDBPostgreSQLClusterRead,
-		Update: resourceYandexMDBPostgreSQLClusterUpdate,
-		Delete: resourceYandexMDBPostgreSQLClusterDelete,
+	r := &schema.Resource{
+		Create:        resourceYandexMDBPostgreSQLClusterCreate,
+		Read:          resourceYandexMDBPostgreSQLClusterRead,
+		UpdateContext: mdbPGVersionUpgrade.withWarnings(resourceYandexMDBPostgreSQLClusterUpdate),
+		Delete:        resourceYandexMDBPostgreSQLClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importMDBClusterWithManagedHosts,
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			validateMDBClusterHosts,
+			mdbPGVersionUpgrade.customizeDiff,
+			mdbPGVersionSettings.validateSettings,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexMDBPostgreSQLClusterCreateTimeout),
 			Update: schema.DefaultTimeout(yandexMDBPostgreSQLClusterUpdateTimeout),
 			Delete: schema.DefaultTimeout(yandexMDBPostgreSQLClusterDeleteTimeout),
 		},
 
-		SchemaVersion: 0,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -74,10 +80,15 @@ func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
 				Deprecated: useResourceInstead("user", "yandex_mdb_postgresql_user"),
 			},
 			"host": {
-				Type:     schema.TypeList,
-				MinItems: 1,
-				Required: true,
-				Elem:     resourceYandexMDBPostgreSQLClusterHost(),
+				Type:             schema.TypeList,
+				DiffSuppressFunc: suppressMDBUnmanagedHostsDiff,
+				Optional:         true,
+				Elem:             resourceYandexMDBPostgreSQLClusterHost(),
+			},
+			"manage_hosts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 			"folder_id": {
 				Type:     schema.TypeString,
@@ -119,13 +130,7 @@ func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"restore": {
-				Type:     schema.TypeList,
-				MaxItems: 1,
-				Optional: true,
-				ForceNew: true,
-				Elem:     resourceYandexMDBPostgreSQLClusterRestoreBlock(),
-			},
+			"restore": mdbRestoreSchema(resourceYandexMDBPostgreSQLClusterRestoreBlock()),
 			"maintenance_window": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -138,6 +143,11 @@ func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"allow_dropping_settings": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allows the version upgrade to drop the settings of the cluster the target version doesn't support.",
+			},
 			"host_group_ids": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -147,6 +157,14 @@ func resourceYandexMDBPostgreSQLCluster() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    mdbResourceWithSettingsMapsV0(r, "config.postgresql_config", "user.settings").CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceYandexMDBPostgreSQLClusterStateUpgradeV0,
+			Version: 0,
+		},
+	}
+	return r
 }
 
 func resourceYandexMDBPostgreSQLClusterConfig() *schema.Resource {
@@ -302,13 +320,12 @@ func resourceYandexMDBPostgreSQLClusterConfig() *schema.Resource {
 				},
 			},
 			"postgresql_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbPGSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbPGSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbPGSettingsFieldsInfo),
 				},
 			},
 		},
@@ -404,13 +421,12 @@ func resourceYandexMDBPostgreSQLClusterUserBlock() *schema.Resource {
 				Computed: true,
 			},
 			"settings": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbPGUserSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbPGUserSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbPGUserSettingsFieldsInfo),
 				},
 			},
 		},
@@ -461,26 +477,13 @@ func resourceYandexMDBPostgreSQLClusterHost() *schema.Resource {
 }
 
 func resourceYandexMDBPostgreSQLClusterRestoreBlock() *schema.Resource {
-	return &schema.Resource{
-		Schema: map[string]*schema.Schema{
-			"backup_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-			},
-			"time_inclusive": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				ForceNew: true,
-			},
-			"time": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: stringToTimeValidateFunc,
-			},
-		},
+	r := mdbRestoreResource(true)
+	r.Schema["time_inclusive"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		ForceNew: true,
 	}
+	return r
 }
 
 func resourceYandexMDBPostgreSQLClusterMaintenanceWindow() *schema.Resource {
@@ -716,17 +719,11 @@ func resourceYandexMDBPostgreSQLClusterCreate(d *schema.ResourceData, meta inter
 func resourceYandexMDBPostgreSQLClusterRestore(d *schema.ResourceData, meta interface{}, createClusterRequest *postgresql.CreateClusterRequest, backupID string) error {
 	config := meta.(*Config)
 
-	var timeBackup *timestamp.Timestamp = nil
 	timeInclusive := false
 
-	if backupTime, ok := d.GetOk("restore.0.time"); ok {
-		time, err := parseStringToTime(backupTime.(string))
-		if err != nil {
-			return fmt.Errorf("Error while parsing restore.0.time to create PostgreSQL Cluster from backup %v, value: %v error: %s", backupID, backupTime, err)
-		}
-		timeBackup = &timestamp.Timestamp{
-			Seconds: time.Unix(),
-		}
+	timeBackup, err := expandMDBRestoreTime(d)
+	if err != nil {
+		return fmt.Errorf("Error while creating PostgreSQL Cluster from backup %v: %s", backupID, err)
 	}
 
 	if timeInclusiveData, ok := d.GetOk("restore.0.time_inclusive"); ok {
@@ -924,6 +921,12 @@ func resourceYandexMDBPostgreSQLClusterUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	if d.HasChange("config.0.version") {
+		if err := upgradePGClusterVersion(d, meta); err != nil {
+			return err
+		}
+	}
+
 	if err := updatePGClusterParams(d, meta); err != nil {
 		return err
 	}
@@ -948,7 +951,7 @@ func resourceYandexMDBPostgreSQLClusterUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("host") {
+	if d.HasChange("host") && d.Get("manage_hosts").(bool) {
 		if err := updatePGClusterHosts(d, meta); err != nil {
 			return err
 		}
@@ -963,6 +966,35 @@ func resourceYandexMDBPostgreSQLClusterUpdate(d *schema.ResourceData, meta inter
 	return resourceYandexMDBPostgreSQLClusterRead(d, meta)
 }
 
+// upgradePGClusterVersion upgrades the cluster to the next major version until the target one is reached,
+// the settings of the target version are updated afterwards.
+func upgradePGClusterVersion(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	return mdbPGVersionUpgrade.run(d, func(version string) error {
+		ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+		defer cancel()
+
+		request := &postgresql.UpdateClusterRequest{
+			ClusterId:  d.Id(),
+			ConfigSpec: &postgresql.ConfigSpec{Version: version},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"config_spec.version"}},
+		}
+		op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+			log.Printf("[DEBUG] Sending PostgreSQL cluster update request: %+v", request)
+			return config.sdk.MDB().PostgreSQL().Cluster().Update(ctx, request)
+		})
+		if err != nil {
+			return fmt.Errorf("error while requesting API to update PostgreSQL Cluster %q: %s", d.Id(), err)
+		}
+
+		if err := op.Wait(ctx); err != nil {
+			return fmt.Errorf("error while waiting for operation to update PostgreSQL Cluster %q: %s", d.Id(), err)
+		}
+		return nil
+	})
+}
+
 func updatePGClusterParams(d *schema.ResourceData, meta interface{}) error {
 	log.Println("[DEBUG] updatePGClusterParams")
 	request, err := prepareUpdatePostgreSQLClusterParamsRequest(d)
@@ -1360,7 +1392,6 @@ func updatePGUser(ctx context.Context, config *Config, d *schema.ResourceData, u
 		"login":      "login",
 		"grants":     "grants",
 		"conn_limit": "conn_limit",
-		"settings":   "settings",
 	}
 
 	updatePath := []string{}
@@ -1374,6 +1405,7 @@ func updatePGUser(ctx context.Context, config *Config, d *schema.ResourceData, u
 			})
 		}
 	}
+	updatePath = append(updatePath, changedSettingsPaths(mdbPGUserSettingsFieldsInfo, d, path+"settings", "settings.")...)
 
 	if len(updatePath) == 0 {
 		return nil
@@ -105,6 +105,7 @@ func mdbPGClusterImportStep(name string) resource.TestStep {
 			"host.2.role",                    // not returned
 			"host.3.role",                    // not returned
 			"host_master_name",               // not returned
+			"allow_dropping_settings",        // Only state flag
 		},
 	}
 }
@@ -449,6 +450,53 @@ func TestAccMDBPostgreSQLCluster_HAWithNames_update(t *testing.T) {
 }
 
 // Test that PostgreSQL cluster can be restored
+func TestAccMDBPostgreSQLCluster_versionUpgrade(t *testing.T) {
+	t.Parallel()
+
+	var cluster postgresql.Cluster
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-cluster-upgrade")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPGClusterConfigVersionUpgrade(clusterName, "13", `operator_precedence_warning = true`, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPGClusterExists(pgResource, &cluster, 1),
+					resource.TestCheckResourceAttr(pgResource, "config.0.version", "13"),
+					resource.TestCheckResourceAttr(pgResource, "config.0.postgresql_config.0.operator_precedence_warning", "true"),
+				),
+			},
+			// the setting is removed in PostgreSQL 14
+			{
+				Config:      testAccMDBPGClusterConfigVersionUpgrade(clusterName, "15", `operator_precedence_warning = true`, false),
+				ExpectError: regexp.MustCompile("operator_precedence_warning is not supported by version 15"),
+			},
+			{
+				Config:      testAccMDBPGClusterConfigVersionUpgrade(clusterName, "12", "", false),
+				ExpectError: regexp.MustCompile("PostgreSQL version can't be downgraded from 13 to 12"),
+			},
+			// the setting is kept by the cluster
+			{
+				Config:      testAccMDBPGClusterConfigVersionUpgrade(clusterName, "15", `max_connections = 200`, false),
+				ExpectError: regexp.MustCompile("settings operator_precedence_warning of PostgreSQL Cluster .* would be dropped by the upgrade"),
+			},
+			// upgraded through 14
+			{
+				Config: testAccMDBPGClusterConfigVersionUpgrade(clusterName, "15", `max_connections = 200`, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPGClusterExists(pgResource, &cluster, 1),
+					resource.TestCheckResourceAttr(pgResource, "config.0.version", "15"),
+					resource.TestCheckResourceAttr(pgResource, "config.0.postgresql_config.0.max_connections", "200"),
+				),
+			},
+			mdbPGClusterImportStep(pgResource),
+		},
+	})
+}
+
 func TestAccMDBPostgreSQLCluster_restore(t *testing.T) {
 	t.Parallel()
 
@@ -1199,7 +1247,7 @@ resource "yandex_mdb_postgresql_cluster" "foo" {
       pool_discard = false
     }
 
-    postgresql_config = {
+    postgresql_config {
       max_connections                   = 395
       enable_parallel_hash              = true
       autovacuum_vacuum_scale_factor    = 0.34
@@ -1221,7 +1269,7 @@ resource "yandex_mdb_postgresql_cluster" "foo" {
       database_name = "newdb"
     }
 
-    settings = {
+    settings {
       default_transaction_isolation = "read uncommitted"
       log_min_duration_statement    = 5000
     }
@@ -1239,7 +1287,7 @@ resource "yandex_mdb_postgresql_cluster" "foo" {
       database_name = "fornewuserdb"
     }
 
-    settings = {
+    settings {
       default_transaction_isolation = "read committed"
       log_min_duration_statement    = 5000
     }
@@ -1323,7 +1371,7 @@ func testAccMDBPGClusterConfigCheckUsersAndDBsDropping(name, desc, version strin
 			pool_discard = false
 		  }
 	  
-		  postgresql_config = {
+		  postgresql_config {
 			max_connections                   = 395
 			enable_parallel_hash              = true
 			autovacuum_vacuum_scale_factor    = 0.34
@@ -1340,7 +1388,7 @@ func testAccMDBPGClusterConfigCheckUsersAndDBsDropping(name, desc, version strin
 			database_name = "testdb"
 		  }
 	  
-		  settings = {
+		  settings {
 			default_transaction_isolation = "read uncommitted"
 			log_min_duration_statement    = 5000
 		  }
@@ -1668,3 +1716,34 @@ func testAccMDBPGClusterConfigRestore(clusterName string, deletionProtection boo
 	  }
 `, clusterName, getExampleFolderID(), pgRestoreBackupId, deletionProtection)
 }
+
+func testAccMDBPGClusterConfigVersionUpgrade(name, version, settings string, allowDroppingSettings bool) string {
+	return fmt.Sprintf(pgVPCDependencies+`
+resource "yandex_mdb_postgresql_cluster" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.mdb-pg-test-net.id
+
+  allow_dropping_settings = %t
+
+  config {
+    version = "%s"
+
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 10
+      disk_type_id       = "network-ssd"
+    }
+
+    postgresql_config {
+      %s
+    }
+  }
+
+  host {
+    zone      = "ru-central1-a"
+    subnet_id = yandex_vpc_subnet.mdb-pg-test-subnet-a.id
+  }
+}
+`, name, allowDroppingSettings, version, settings)
+}
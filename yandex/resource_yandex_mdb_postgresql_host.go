@@ -0,0 +1,261 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	yandexMDBPostgreSQLHostCreateTimeout = 30 * time.Minute
+	yandexMDBPostgreSQLHostReadTimeout   = 1 * time.Minute
+	yandexMDBPostgreSQLHostUpdateTimeout = 30 * time.Minute
+	yandexMDBPostgreSQLHostDeleteTimeout = 30 * time.Minute
+)
+
+func resourceYandexMDBPostgreSQLHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBPostgreSQLHostCreate,
+		Read:   resourceYandexMDBPostgreSQLHostRead,
+		Update: resourceYandexMDBPostgreSQLHostUpdate,
+		Delete: resourceYandexMDBPostgreSQLHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBPostgreSQLHostCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBPostgreSQLHostReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBPostgreSQLHostUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBPostgreSQLHostDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"assign_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"replication_source": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBPostgreSQLHostCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	request := &postgresql.AddClusterHostsRequest{
+		ClusterId: clusterID,
+		HostSpecs: []*postgresql.HostSpec{
+			{
+				ZoneId:            d.Get("zone").(string),
+				SubnetId:          d.Get("subnet_id").(string),
+				AssignPublicIp:    d.Get("assign_public_ip").(bool),
+				ReplicationSource: d.Get("replication_source").(string),
+				Priority:          expandPGHostResourcePriority(d),
+			},
+		},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending PostgreSQL cluster add hosts request: %+v", request)
+		return config.sdk.MDB().PostgreSQL().Cluster().AddHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create host for PostgreSQL Cluster %q: %s", clusterID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while getting PostgreSQL add hosts operation metadata: %s", err)
+	}
+
+	fqdn, ok := mdbAddedHostName(protoMetadata)
+	if !ok {
+		return fmt.Errorf("could not get host name from add hosts operation metadata")
+	}
+	d.SetId(constructResourceId(clusterID, fqdn))
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while creating host for PostgreSQL Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("creating host for PostgreSQL Cluster %q failed: %s", clusterID, err)
+	}
+
+	return resourceYandexMDBPostgreSQLHostRead(d, meta)
+}
+
+func resourceYandexMDBPostgreSQLHostRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, fqdn, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hosts, err := retryListPGHostsWrapper(ctx, config, clusterID)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PostgreSQL Cluster %q", clusterID))
+	}
+
+	var host *postgresql.Host
+	for _, h := range hosts {
+		if h.Name == fqdn {
+			host = h
+			break
+		}
+	}
+	if host == nil {
+		log.Printf("[WARN] Removing host %q because it doesn't exist in PostgreSQL Cluster %q anymore", fqdn, clusterID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("zone", host.ZoneId)
+	d.Set("subnet_id", host.SubnetId)
+	d.Set("assign_public_ip", host.AssignPublicIp)
+	d.Set("priority", host.GetPriority().GetValue())
+	d.Set("replication_source", host.ReplicationSource)
+	d.Set("fqdn", host.Name)
+	d.Set("role", host.Role.String())
+
+	return nil
+}
+
+func resourceYandexMDBPostgreSQLHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	spec := &postgresql.UpdateHostSpec{
+		HostName:          d.Get("fqdn").(string),
+		AssignPublicIp:    d.Get("assign_public_ip").(bool),
+		ReplicationSource: d.Get("replication_source").(string),
+		Priority:          expandPGHostResourcePriority(d),
+		UpdateMask:        &fieldmaskpb.FieldMask{},
+	}
+	for _, field := range []string{"assign_public_ip", "replication_source", "priority"} {
+		if d.HasChange(field) {
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, field)
+		}
+	}
+	if len(spec.UpdateMask.Paths) == 0 {
+		return resourceYandexMDBPostgreSQLHostRead(d, meta)
+	}
+
+	request := &postgresql.UpdateClusterHostsRequest{
+		ClusterId:       clusterID,
+		UpdateHostSpecs: []*postgresql.UpdateHostSpec{spec},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending PostgreSQL cluster update hosts request: %+v", request)
+		return config.sdk.MDB().PostgreSQL().Cluster().UpdateHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update host for PostgreSQL Cluster %q - host %v: %s", clusterID, spec.HostName, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while updating host for PostgreSQL Cluster %q - host %v: %s", clusterID, spec.HostName, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("updating host for PostgreSQL Cluster %q - host %v failed: %s", clusterID, spec.HostName, err)
+	}
+
+	return resourceYandexMDBPostgreSQLHostRead(d, meta)
+}
+
+// expandPGHostResourcePriority returns the priority of the host, nil when it is not set so the default one is kept.
+func expandPGHostResourcePriority(d *schema.ResourceData) *wrappers.Int64Value {
+	// TODO: SA1019: d.GetOkExists is deprecated: usage is discouraged due to undefined behaviors and may be removed in a future version of the SDK (staticcheck)
+	if v, ok := d.GetOkExists("priority"); ok {
+		return &wrappers.Int64Value{Value: int64(v.(int))}
+	}
+	return nil
+}
+
+func resourceYandexMDBPostgreSQLHostDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	request := &postgresql.DeleteClusterHostsRequest{
+		ClusterId: clusterID,
+		HostNames: []string{fqdn},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending PostgreSQL cluster delete hosts request: %+v", request)
+		return config.sdk.MDB().PostgreSQL().Cluster().DeleteHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to delete host from PostgreSQL Cluster %q: %s", clusterID, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while deleting host from PostgreSQL Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("deleting host from PostgreSQL Cluster %q failed: %s", clusterID, err)
+	}
+
+	return nil
+}
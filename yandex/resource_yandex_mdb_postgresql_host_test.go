@@ -0,0 +1,144 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	pgHostResourceNameB = "yandex_mdb_postgresql_host.b"
+	pgHostResourceNameC = "yandex_mdb_postgresql_host.c"
+)
+
+// Test that PostgreSQL hosts of a cluster with unmanaged hosts can be added, updated and removed
+func TestAccMDBPostgreSQLHost_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-postgresql-host")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBPostgreSQLHostConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(pgHostResourceNameB, "name", "b"),
+					resource.TestCheckResourceAttr(pgHostResourceNameB, "zone", "ru-central1-b"),
+					resource.TestCheckResourceAttr(pgHostResourceNameB, "role", "REPLICA"),
+					resource.TestCheckResourceAttrSet(pgHostResourceNameB, "fqdn"),
+					testAccCheckMDBPostgreSQLClusterHostsCount(2),
+				),
+			},
+			mdbPostgreSQLHostImportStep(pgHostResourceNameB),
+			{
+				Config: testAccMDBPostgreSQLHostConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(pgHostResourceNameB, "name", "sync"),
+					resource.TestCheckResourceAttr(pgHostResourceNameB, "priority", "5"),
+					resource.TestCheckResourceAttrPair(pgHostResourceNameC, "replication_source", pgHostResourceNameB, "fqdn"),
+					testAccCheckMDBPostgreSQLClusterHostsCount(3),
+				),
+			},
+			mdbPostgreSQLHostImportStep(pgHostResourceNameC),
+			{
+				Config: testAccMDBPostgreSQLHostConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBPostgreSQLClusterHostsCount(2),
+				),
+			},
+		},
+	})
+}
+
+func mdbPostgreSQLHostImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"name", // not returned
+		},
+	}
+}
+
+func testAccCheckMDBPostgreSQLClusterHostsCount(count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[pgResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found", pgResource)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		hosts, err := retryListPGHostsWrapper(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(hosts) != count {
+			return fmt.Errorf("expected %d hosts in PostgreSQL Cluster %q, got %d", count, rs.Primary.ID, len(hosts))
+		}
+		return nil
+	}
+}
+
+func testAccMDBPostgreSQLHostConfigStep0(name string) string {
+	return fmt.Sprintf(pgVPCDependencies+`
+resource "yandex_mdb_postgresql_cluster" "foo" {
+	name         = "%s"
+	description  = "PostgreSQL Host Terraform Test"
+	environment  = "PRESTABLE"
+	network_id   = "${yandex_vpc_network.mdb-pg-test-net.id}"
+	manage_hosts = false
+
+	config {
+	    version = 15
+	    resources {
+		  resource_preset_id = "s2.micro"
+		  disk_size          = 10
+		  disk_type_id       = "network-ssd"
+	    }
+	}
+
+	host {
+		zone      = "ru-central1-a"
+		subnet_id = yandex_vpc_subnet.mdb-pg-test-subnet-a.id
+	}
+}
+`, name)
+}
+
+// Add a host to the cluster
+func testAccMDBPostgreSQLHostConfigStep1(name string) string {
+	return testAccMDBPostgreSQLHostConfigStep0(name) + `
+resource "yandex_mdb_postgresql_host" "b" {
+	name       = "b"
+	cluster_id = yandex_mdb_postgresql_cluster.foo.id
+	zone       = "ru-central1-b"
+	subnet_id  = yandex_vpc_subnet.mdb-pg-test-subnet-b.id
+}
+`
+}
+
+// Update the host and add a cascade replica of it
+func testAccMDBPostgreSQLHostConfigStep2(name string) string {
+	return testAccMDBPostgreSQLHostConfigStep0(name) + `
+resource "yandex_mdb_postgresql_host" "b" {
+	name       = "sync"
+	cluster_id = yandex_mdb_postgresql_cluster.foo.id
+	zone       = "ru-central1-b"
+	subnet_id  = yandex_vpc_subnet.mdb-pg-test-subnet-b.id
+	priority   = 5
+}
+
+resource "yandex_mdb_postgresql_host" "c" {
+	cluster_id         = yandex_mdb_postgresql_cluster.foo.id
+	zone               = "ru-central1-b"
+	subnet_id          = yandex_vpc_subnet.mdb-pg-test-subnet-b.id
+	replication_source = yandex_mdb_postgresql_host.b.fqdn
+}
+`
+}
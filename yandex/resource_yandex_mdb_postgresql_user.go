@@ -24,7 +24,7 @@ const (
 )
 
 func resourceYandexMDBPostgreSQLUser() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceYandexMDBPostgreSQLUserCreate,
 		Read:   resourceYandexMDBPostgreSQLUserRead,
 		Update: resourceYandexMDBPostgreSQLUserUpdate,
@@ -40,7 +40,7 @@ func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexMDBPostgreSQLUserDeleteTimeout),
 		},
 
-		SchemaVersion: 0,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -92,13 +92,12 @@ func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 				Computed: true,
 			},
 			"settings": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbPGUserSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbPGUserSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbPGUserSettingsFieldsInfo),
 				},
 			},
 			"deletion_protection": {
@@ -109,6 +108,14 @@ func resourceYandexMDBPostgreSQLUser() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    mdbResourceWithSettingsMapsV0(r, "settings").CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceYandexMDBPostgreSQLUserStateUpgradeV0,
+			Version: 0,
+		},
+	}
+	return r
 }
 
 func resourceYandexMDBPostgreSQLUserCreate(d *schema.ResourceData, meta interface{}) error {
@@ -188,7 +195,7 @@ func expandPgUserSpec(d *schema.ResourceData) (*postgresql.UserSpec, error) {
 			user.Settings = &postgresql.UserSettings{}
 		}
 
-		err := expandResourceGenerate(mdbPGUserSettingsFieldsInfo, d, user.Settings, "settings.", true)
+		err := expandResourceGenerate(mdbPGUserSettingsFieldsInfo, d, user.Settings, "settings.0.", true)
 		if err != nil {
 			return nil, err
 		}
@@ -234,7 +241,7 @@ func resourceYandexMDBPostgreSQLUserRead(d *schema.ResourceData, meta interface{
 	knownDefault := map[string]struct{}{
 		"log_min_duration_statement": {},
 	}
-	settings, err := flattenResourceGenerateMapS(user.Settings, false, mdbPGUserSettingsFieldsInfo, false, true, knownDefault)
+	settings, err := flattenResourceGenerateBlock(user.Settings, mdbPGUserSettingsFieldsInfo, knownDefault)
 	if err != nil {
 		return err
 	}
@@ -263,7 +270,6 @@ func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interfac
 		"login":      "login",
 		"grants":     "grants",
 		"conn_limit": "conn_limit",
-		"settings":   "settings",
 	}
 
 	for field, mask := range changeMask {
@@ -271,6 +277,7 @@ func resourceYandexMDBPostgreSQLUserUpdate(d *schema.ResourceData, meta interfac
 			updatePath = append(updatePath, mask)
 		}
 	}
+	updatePath = append(updatePath, changedSettingsPaths(mdbPGUserSettingsFieldsInfo, d, "settings", "settings.")...)
 
 	if user.DeletionProtection != nil {
 		updatePath = append(updatePath, "deletion_protection")
@@ -99,7 +99,7 @@ resource "yandex_mdb_postgresql_user" "alice" {
 	login      = true
 	grants     = [""]
 	conn_limit = 50
-	settings = {
+	settings {
 		default_transaction_isolation = "read committed"
 		log_min_duration_statement    = 5000
 	}
@@ -239,7 +239,7 @@ resource "yandex_mdb_postgresql_user" "alice" {
 	login      = true
 	grants     = ["mdb_admin", "mdb_replication"]
 	conn_limit = 50
-	settings = {
+	settings {
 		default_transaction_isolation = "read committed"
 		log_min_duration_statement    = 5000
 		pool_mode                     = "transaction"
@@ -270,7 +270,7 @@ resource "yandex_mdb_postgresql_user" "alice" {
 	password   = "mysecureP@ssw0rd"
     
 	conn_limit = 42
-	settings = {
+	settings {
 		default_transaction_isolation = "read uncommitted"
 		log_min_duration_statement    = 1234
 		pool_mode                     = "session"
@@ -16,6 +16,7 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 )
 
 const (
@@ -32,9 +33,11 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 		Update: resourceYandexMDBRedisClusterUpdate,
 		Delete: resourceYandexMDBRedisClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importMDBClusterWithManagedHosts,
 		},
 
+		CustomizeDiff: validateMDBClusterHosts,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexMDBRedisClusterCreateTimeout),
 			Update: schema.DefaultTimeout(yandexMDBRedisClusterUpdateTimeout),
@@ -144,8 +147,9 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 				},
 			},
 			"host": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:             schema.TypeList,
+				DiffSuppressFunc: suppressMDBUnmanagedHostsDiff,
+				Optional:         true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"zone": {
@@ -179,6 +183,11 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 					},
 				},
 			},
+			"manage_hosts": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -234,6 +243,7 @@ func resourceYandexMDBRedisCluster() *schema.Resource {
 				Set:      schema.HashString,
 				Optional: true,
 			},
+			"restore": mdbRestoreSchema(mdbRestoreResource(false)),
 			"maintenance_window": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -280,9 +290,17 @@ func resourceYandexMDBRedisClusterCreate(d *schema.ResourceData, meta interface{
 	ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
 
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Create(ctx, req))
-	if err != nil {
-		return fmt.Errorf("Error while requesting API to create Redis Cluster: %s", err)
+	var op *sdkoperation.Operation
+	if backupID, ok := d.GetOk("restore.0.backup_id"); ok {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Restore(ctx, prepareRestoreRedisRequest(req, backupID.(string))))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to create Redis Cluster from backup %v: %s", backupID, err)
+		}
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().Redis().Cluster().Create(ctx, req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to create Redis Cluster: %s", err)
+		}
 	}
 
 	protoMetadata, err := op.Metadata()
@@ -290,13 +308,13 @@ func resourceYandexMDBRedisClusterCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error while get redis create operation metadata: %s", err)
 	}
 
-	md, ok := protoMetadata.(*redis.CreateClusterMetadata)
+	clusterID, ok := mdbClusterIDFromMetadata(protoMetadata)
 	if !ok {
 		return fmt.Errorf("Could not get Cluster ID from create operation metadata")
 	}
 
-	d.SetId(md.ClusterId)
-	log.Printf("[DEBUG] Creating Redis Cluster %q", md.ClusterId)
+	d.SetId(clusterID)
+	log.Printf("[DEBUG] Creating Redis Cluster %q", clusterID)
 
 	err = op.Wait(ctx)
 	if err != nil {
@@ -392,6 +410,27 @@ func prepareCreateRedisRequest(d *schema.ResourceData, meta *Config) (*redis.Cre
 	return &req, nil
 }
 
+// prepareRestoreRedisRequest returns request for restoring the Cluster from the backup,
+// the sharding of the Cluster is restored from the backup.
+func prepareRestoreRedisRequest(req *redis.CreateClusterRequest, backupID string) *redis.RestoreClusterRequest {
+	return &redis.RestoreClusterRequest{
+		BackupId:           backupID,
+		Name:               req.Name,
+		Description:        req.Description,
+		Labels:             req.Labels,
+		Environment:        req.Environment,
+		ConfigSpec:         req.ConfigSpec,
+		HostSpecs:          req.HostSpecs,
+		NetworkId:          req.NetworkId,
+		FolderId:           req.FolderId,
+		SecurityGroupIds:   req.SecurityGroupIds,
+		TlsEnabled:         req.TlsEnabled,
+		PersistenceMode:    req.PersistenceMode,
+		DeletionProtection: req.DeletionProtection,
+		AnnounceHostnames:  req.AnnounceHostnames,
+	}
+}
+
 func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -405,7 +444,7 @@ func resourceYandexMDBRedisClusterRead(d *schema.ResourceData, meta interface{})
 		return handleNotFoundError(err, d, fmt.Sprintf("Cluster %q", d.Get("name").(string)))
 	}
 
-	hosts, err := listRedisHosts(ctx, config, d)
+	hosts, err := listRedisHosts(ctx, config, d.Id())
 	if err != nil {
 		return err
 	}
@@ -722,7 +761,7 @@ func deleteHosts(ctx context.Context, d *schema.ResourceData, config *Config, sh
 }
 
 func updateRedisClusterHosts(d *schema.ResourceData, meta interface{}) error {
-	if !d.HasChange("host") {
+	if !d.HasChange("host") || !d.Get("manage_hosts").(bool) {
 		return nil
 	}
 
@@ -732,7 +771,7 @@ func updateRedisClusterHosts(d *schema.ResourceData, meta interface{}) error {
 
 	sharded := d.Get("sharded").(bool)
 
-	currHosts, err := listRedisHosts(ctx, config, d)
+	currHosts, err := listRedisHosts(ctx, config, d.Id())
 	if err != nil {
 		return err
 	}
@@ -791,17 +830,17 @@ func updateRedisMaintenanceWindow(ctx context.Context, config *Config, d *schema
 	return nil
 }
 
-func listRedisHosts(ctx context.Context, config *Config, d *schema.ResourceData) ([]*redis.Host, error) {
+func listRedisHosts(ctx context.Context, config *Config, id string) ([]*redis.Host, error) {
 	hosts := []*redis.Host{}
 	pageToken := ""
 	for {
 		resp, err := config.sdk.MDB().Redis().Cluster().ListHosts(ctx, &redis.ListClusterHostsRequest{
-			ClusterId: d.Id(),
+			ClusterId: id,
 			PageSize:  defaultMDBPageSize,
 			PageToken: pageToken,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("Error while getting list of hosts for '%s': %s", d.Id(), err)
+			return nil, fmt.Errorf("Error while getting list of hosts for '%s': %s", id, err)
 		}
 		hosts = append(hosts, resp.Hosts...)
 		if resp.NextPageToken == "" {
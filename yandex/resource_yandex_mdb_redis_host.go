@@ -0,0 +1,254 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	yandexMDBRedisHostCreateTimeout = 30 * time.Minute
+	yandexMDBRedisHostReadTimeout   = 1 * time.Minute
+	yandexMDBRedisHostUpdateTimeout = 30 * time.Minute
+	yandexMDBRedisHostDeleteTimeout = 30 * time.Minute
+)
+
+func resourceYandexMDBRedisHost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexMDBRedisHostCreate,
+		Read:   resourceYandexMDBRedisHostRead,
+		Update: resourceYandexMDBRedisHostUpdate,
+		Delete: resourceYandexMDBRedisHostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexMDBRedisHostCreateTimeout),
+			Read:   schema.DefaultTimeout(yandexMDBRedisHostReadTimeout),
+			Update: schema.DefaultTimeout(yandexMDBRedisHostUpdateTimeout),
+			Delete: schema.DefaultTimeout(yandexMDBRedisHostDeleteTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"shard_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"assign_public_ip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"replica_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  defaultReplicaPriority,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexMDBRedisHostCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	request := &redis.AddClusterHostsRequest{
+		ClusterId: clusterID,
+		HostSpecs: []*redis.HostSpec{
+			{
+				ZoneId:          d.Get("zone").(string),
+				SubnetId:        d.Get("subnet_id").(string),
+				ShardName:       d.Get("shard_name").(string),
+				AssignPublicIp:  d.Get("assign_public_ip").(bool),
+				ReplicaPriority: &wrappers.Int64Value{Value: int64(d.Get("replica_priority").(int))},
+			},
+		},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending Redis cluster add hosts request: %+v", request)
+		return config.sdk.MDB().Redis().Cluster().AddHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to create host for Redis Cluster %q: %s", clusterID, err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("error while getting Redis add hosts operation metadata: %s", err)
+	}
+
+	fqdn, ok := mdbAddedHostName(protoMetadata)
+	if !ok {
+		return fmt.Errorf("could not get host name from add hosts operation metadata")
+	}
+	d.SetId(constructResourceId(clusterID, fqdn))
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while creating host for Redis Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("creating host for Redis Cluster %q failed: %s", clusterID, err)
+	}
+
+	return resourceYandexMDBRedisHostRead(d, meta)
+}
+
+func resourceYandexMDBRedisHostRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	clusterID, fqdn, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hosts, err := listRedisHosts(ctx, config, clusterID)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Redis Cluster %q", clusterID))
+	}
+
+	var host *redis.Host
+	for _, h := range hosts {
+		if h.Name == fqdn {
+			host = h
+			break
+		}
+	}
+	if host == nil {
+		log.Printf("[WARN] Removing host %q because it doesn't exist in Redis Cluster %q anymore", fqdn, clusterID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_id", clusterID)
+	d.Set("zone", host.ZoneId)
+	d.Set("subnet_id", host.SubnetId)
+	d.Set("shard_name", host.ShardName)
+	d.Set("assign_public_ip", host.AssignPublicIp)
+	d.Set("replica_priority", host.GetReplicaPriority().GetValue())
+	d.Set("fqdn", host.Name)
+	d.Set("role", host.Role.String())
+
+	return nil
+}
+
+func resourceYandexMDBRedisHostUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	spec := &redis.UpdateHostSpec{
+		HostName:        d.Get("fqdn").(string),
+		AssignPublicIp:  d.Get("assign_public_ip").(bool),
+		ReplicaPriority: &wrappers.Int64Value{Value: int64(d.Get("replica_priority").(int))},
+		UpdateMask:      &fieldmaskpb.FieldMask{},
+	}
+	for _, field := range []string{"assign_public_ip", "replica_priority"} {
+		if d.HasChange(field) {
+			spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, field)
+		}
+	}
+	if len(spec.UpdateMask.Paths) == 0 {
+		return resourceYandexMDBRedisHostRead(d, meta)
+	}
+
+	request := &redis.UpdateClusterHostsRequest{
+		ClusterId:       clusterID,
+		UpdateHostSpecs: []*redis.UpdateHostSpec{spec},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending Redis cluster update hosts request: %+v", request)
+		return config.sdk.MDB().Redis().Cluster().UpdateHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to update host for Redis Cluster %q - host %v: %s", clusterID, spec.HostName, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while updating host for Redis Cluster %q - host %v: %s", clusterID, spec.HostName, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("updating host for Redis Cluster %q - host %v failed: %s", clusterID, spec.HostName, err)
+	}
+
+	return resourceYandexMDBRedisHostRead(d, meta)
+}
+
+func resourceYandexMDBRedisHostDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	clusterID := d.Get("cluster_id").(string)
+	fqdn := d.Get("fqdn").(string)
+
+	request := &redis.DeleteClusterHostsRequest{
+		ClusterId: clusterID,
+		HostNames: []string{fqdn},
+	}
+	op, err := retryConflictingOperation(ctx, config, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending Redis cluster delete hosts request: %+v", request)
+		return config.sdk.MDB().Redis().Cluster().DeleteHosts(ctx, request)
+	})
+	if err != nil {
+		return fmt.Errorf("error while requesting API to delete host from Redis Cluster %q: %s", clusterID, err)
+	}
+
+	if err := op.Wait(ctx); err != nil {
+		return fmt.Errorf("error while deleting host from Redis Cluster %q: %s", clusterID, err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("deleting host from Redis Cluster %q failed: %s", clusterID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const redisHostResourceNameB = "yandex_mdb_redis_host.b"
+
+// Test that Redis hosts of a cluster with unmanaged hosts can be added, updated and removed
+func TestAccMDBRedisHost_full(t *testing.T) {
+	t.Parallel()
+	clusterName := acctest.RandomWithPrefix("tf-redis-host")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMDBRedisClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBRedisHostConfigStep1(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(redisHostResourceNameB, "name", "b"),
+					resource.TestCheckResourceAttr(redisHostResourceNameB, "zone", "ru-central1-d"),
+					resource.TestCheckResourceAttr(redisHostResourceNameB, "role", "REPLICA"),
+					resource.TestCheckResourceAttr(redisHostResourceNameB, "replica_priority", fmt.Sprint(defaultReplicaPriority)),
+					resource.TestCheckResourceAttrSet(redisHostResourceNameB, "fqdn"),
+					testAccCheckMDBRedisClusterHostsCount(2),
+				),
+			},
+			mdbRedisHostImportStep(redisHostResourceNameB),
+			{
+				Config: testAccMDBRedisHostConfigStep2(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(redisHostResourceNameB, "name", "low-priority"),
+					resource.TestCheckResourceAttr(redisHostResourceNameB, "replica_priority", "50"),
+					resource.TestCheckResourceAttr(redisHostResourceNameB, "assign_public_ip", "true"),
+					testAccCheckMDBRedisClusterHostsCount(2),
+				),
+			},
+			mdbRedisHostImportStep(redisHostResourceNameB),
+			{
+				Config: testAccMDBRedisHostConfigStep0(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMDBRedisClusterHostsCount(1),
+				),
+			},
+		},
+	})
+}
+
+func mdbRedisHostImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"name", // not returned
+		},
+	}
+}
+
+func testAccCheckMDBRedisClusterHostsCount(count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[redisResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found", redisResource)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		hosts, err := listRedisHosts(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(hosts) != count {
+			return fmt.Errorf("expected %d hosts in Redis Cluster %q, got %d", count, rs.Primary.ID, len(hosts))
+		}
+		return nil
+	}
+}
+
+func testAccMDBRedisHostConfigStep0(name string) string {
+	return fmt.Sprintf(redisVPCDependencies+`
+resource "yandex_mdb_redis_cluster" "foo" {
+  name         = "%s"
+  description  = "Redis Host Terraform Test"
+  environment  = "PRESTABLE"
+  network_id   = "${yandex_vpc_network.foo.id}"
+  manage_hosts = false
+
+  config {
+    password = "passw0rd"
+    version  = "7.2"
+  }
+
+  resources {
+    resource_preset_id = "hm3-c2-m8"
+    disk_size          = 16
+  }
+
+  host {
+    zone      = "ru-central1-d"
+    subnet_id = "${yandex_vpc_subnet.foo.id}"
+  }
+}
+`, name)
+}
+
+// Add a host to the cluster
+func testAccMDBRedisHostConfigStep1(name string) string {
+	return testAccMDBRedisHostConfigStep0(name) + `
+resource "yandex_mdb_redis_host" "b" {
+  name       = "b"
+  cluster_id = yandex_mdb_redis_cluster.foo.id
+  zone       = "ru-central1-d"
+  subnet_id  = yandex_vpc_subnet.foo.id
+}
+`
+}
+
+// Update the host
+func testAccMDBRedisHostConfigStep2(name string) string {
+	return testAccMDBRedisHostConfigStep0(name) + `
+resource "yandex_mdb_redis_host" "b" {
+  name             = "low-priority"
+  cluster_id       = yandex_mdb_redis_cluster.foo.id
+  zone             = "ru-central1-d"
+  subnet_id        = yandex_vpc_subnet.foo.id
+  assign_public_ip = true
+  replica_priority = 50
+}
+`
+}
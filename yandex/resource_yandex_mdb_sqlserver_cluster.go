@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/sqlserver/v1"
+	sdkoperation "github.com/yandex-cloud/go-sdk/operation"
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
@@ -18,22 +19,24 @@ const (
 )
 
 func resourceYandexMDBSQLServerCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceYandexMDBSQLServerClusterCreate,
 		Read:   resourceYandexMDBSQLServerClusterRead,
 		Update: resourceYandexMDBSQLServerClusterUpdate,
 		Delete: resourceYandexMDBSQLServerClusterDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importMDBClusterManaging("manage_databases", "manage_users"),
 		},
 
+		CustomizeDiff: mdbSQLServerVersionSettings.validateSettings,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexMDBSQLServerClusterDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexMDBSQLServerClusterUpdateTimeout),
 			Delete: schema.DefaultTimeout(yandexMDBSQLServerClusterDefaultTimeout),
 		},
 
-		SchemaVersion: 0,
+		SchemaVersion: 1,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -82,9 +85,16 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 					},
 				},
 			},
+			"manage_databases": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"database": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressMDBUnmanagedDiff("manage_databases"),
+				Deprecated:       useResourceInstead("database", "yandex_mdb_sqlserver_database"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -94,9 +104,16 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 					},
 				},
 			},
+			"manage_users": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"user": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: suppressMDBUnmanagedDiff("manage_users"),
+				Deprecated:       useResourceInstead("user", "yandex_mdb_sqlserver_user"),
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -216,14 +233,14 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 				Set:      schema.HashString,
 				Optional: true,
 			},
+			"restore": mdbRestoreSchema(mdbRestoreResource(true)),
 			"sqlserver_config": {
-				Type:             schema.TypeMap,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: generateMapSchemaDiffSuppressFunc(mdbSQLServerSettingsFieldsInfo),
-				ValidateFunc:     generateMapSchemaValidateFunc(mdbSQLServerSettingsFieldsInfo),
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: generateBlockSchema(mdbSQLServerSettingsFieldsInfo),
 				},
 			},
 			"deletion_protection": {
@@ -238,6 +255,14 @@ func resourceYandexMDBSQLServerCluster() *schema.Resource {
 			},
 		},
 	}
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Type:    mdbResourceWithSettingsMapsV0(r, "sqlserver_config").CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceYandexMDBSQLServerClusterStateUpgradeV0,
+			Version: 0,
+		},
+	}
+	return r
 }
 
 func resourceYandexMDBSQLServerClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -249,19 +274,31 @@ func resourceYandexMDBSQLServerClusterCreate(d *schema.ResourceData, meta interf
 
 	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutCreate))
 	defer cancel()
-	op, err := config.sdk.WrapOperation(config.sdk.MDB().SQLServer().Cluster().Create(ctx, req))
-	if err != nil {
-		return fmt.Errorf("Error while requesting API to create SQLServer Cluster: %s", err)
+	var op *sdkoperation.Operation
+	if backupID, ok := d.GetOk("restore.0.backup_id"); ok {
+		restoreReq, err := prepareRestoreSQLServerRequest(d, req, backupID.(string))
+		if err != nil {
+			return err
+		}
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().SQLServer().Cluster().Restore(ctx, restoreReq))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to create SQLServer Cluster from backup %v: %s", backupID, err)
+		}
+	} else {
+		op, err = config.sdk.WrapOperation(config.sdk.MDB().SQLServer().Cluster().Create(ctx, req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to create SQLServer Cluster: %s", err)
+		}
 	}
 	protoMetadata, err := op.Metadata()
 	if err != nil {
 		return fmt.Errorf("Error while get SQLServer create operation metadata: %s", err)
 	}
-	md, ok := protoMetadata.(*sqlserver.CreateClusterMetadata)
+	clusterID, ok := mdbClusterIDFromMetadata(protoMetadata)
 	if !ok {
 		return fmt.Errorf("Could not get SQLServer Cluster ID from create operation metadata")
 	}
-	d.SetId(md.ClusterId)
+	d.SetId(clusterID)
 
 	err = op.Wait(ctx)
 	if err != nil {
@@ -273,6 +310,32 @@ func resourceYandexMDBSQLServerClusterCreate(d *schema.ResourceData, meta interf
 	return resourceYandexMDBSQLServerClusterRead(d, meta)
 }
 
+// prepareRestoreSQLServerRequest returns request for restoring the Cluster from the backup,
+// the databases and the users of the Cluster are restored from the backup.
+func prepareRestoreSQLServerRequest(d *schema.ResourceData, req *sqlserver.CreateClusterRequest, backupID string) (*sqlserver.RestoreClusterRequest, error) {
+	restoreTime, err := expandMDBRestoreTimeOrNow(d)
+	if err != nil {
+		return nil, fmt.Errorf("Error while creating SQLServer Cluster from backup %v: %s", backupID, err)
+	}
+
+	return &sqlserver.RestoreClusterRequest{
+		BackupId:           backupID,
+		Time:               restoreTime,
+		Name:               req.Name,
+		Description:        req.Description,
+		Labels:             req.Labels,
+		Environment:        req.Environment,
+		ConfigSpec:         req.ConfigSpec,
+		HostSpecs:          req.HostSpecs,
+		NetworkId:          req.NetworkId,
+		FolderId:           req.FolderId,
+		SecurityGroupIds:   req.SecurityGroupIds,
+		DeletionProtection: req.DeletionProtection,
+		HostGroupIds:       req.HostGroupIds,
+		ServiceAccountId:   req.ServiceAccountId,
+	}, nil
+}
+
 func prepareCreateSQLServerRequest(d *schema.ResourceData, meta *Config) (*sqlserver.CreateClusterRequest, error) {
 	labels, err := expandLabels(d.Get("labels"))
 
@@ -375,23 +438,27 @@ func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	usersSpec, err := listSQLServerUsers(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
+	// databases and users are not read with manage_databases and manage_users set to false, they are
+	// managed by yandex_mdb_sqlserver_database and yandex_mdb_sqlserver_user then
+	if mdbClusterManages(d, "manage_users") {
+		usersSpec, err := listSQLServerUsers(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
 
-	passwords := expandSQLServerUserPasswords(d)
+		passwords := expandSQLServerUserPasswords(d)
 
-	users, err := flattenSQLServerUsers(usersSpec, passwords)
+		users, err := flattenSQLServerUsers(usersSpec, passwords)
 
-	if err != nil {
-		return err
-	}
+		if err != nil {
+			return err
+		}
 
-	sortInterfaceListByResourceData(users, d, "user", "name")
+		sortInterfaceListByResourceData(users, d, "user", "name")
 
-	if err = d.Set("user", users); err != nil {
-		return err
+		if err = d.Set("user", users); err != nil {
+			return err
+		}
 	}
 	if err = d.Set("security_group_ids", cluster.SecurityGroupIds); err != nil {
 		return err
@@ -412,17 +479,19 @@ func resourceYandexMDBSQLServerClusterRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
-	databasesSpec, err := listSQLServerDatabases(ctx, config, d.Id())
-	if err != nil {
-		return err
-	}
+	if mdbClusterManages(d, "manage_databases") {
+		databasesSpec, err := listSQLServerDatabases(ctx, config, d.Id())
+		if err != nil {
+			return err
+		}
 
-	databases := flattenSQLServerDatabases(databasesSpec)
+		databases := flattenSQLServerDatabases(databasesSpec)
 
-	sortInterfaceListByResourceData(databases, d, "database", "name")
+		sortInterfaceListByResourceData(databases, d, "database", "name")
 
-	if err = d.Set("database", databases); err != nil {
-		return err
+		if err = d.Set("database", databases); err != nil {
+			return err
+		}
 	}
 
 	backupWindowStart := flattenMDBBackupWindowStart(cluster.GetConfig().GetBackupWindowStart())
@@ -538,13 +607,13 @@ func resourceYandexMDBSQLServerClusterUpdate(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	if d.HasChange("database") {
+	if d.HasChange("database") && mdbClusterManages(d, "manage_databases") {
 		if err := sqlserverDatabaseUpdate(ctx, config, d); err != nil {
 			return err
 		}
 	}
 
-	if d.HasChange("user") {
+	if d.HasChange("user") && mdbClusterManages(d, "manage_users") {
 		if err := sqlserverUserUpdate(ctx, config, d); err != nil {
 			return err
 		}
@@ -604,8 +673,8 @@ func sqlserverClusterUpdate(ctx context.Context, config *Config, d *schema.Resou
 		}
 	}
 
-	if d.HasChange("sqlserver_config") && len(fields) > 0 {
-		for _, field := range fields {
+	for _, field := range fields {
+		if d.HasChange("sqlserver_config.0." + field) {
 			updatePath = append(updatePath, "config_spec."+updateFieldConfigName+"."+field)
 		}
 	}
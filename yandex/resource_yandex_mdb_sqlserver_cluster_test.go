@@ -168,8 +168,8 @@ func TestAccMDBSQLServerCluster_full(t *testing.T) {
 					resource.TestCheckResourceAttr(sqlserverResource, "resources.0.disk_size", "20"),
 					resource.TestCheckResourceAttr(sqlserverResource, "backup_window_start.0.hours", "20"),
 					resource.TestCheckResourceAttr(sqlserverResource, "backup_window_start.0.minutes", "30"),
-					resource.TestCheckResourceAttr(sqlserverResource, "sqlserver_config.fill_factor_percent", "49"),
-					resource.TestCheckResourceAttr(sqlserverResource, "sqlserver_config.optimize_for_ad_hoc_workloads", "true"),
+					resource.TestCheckResourceAttr(sqlserverResource, "sqlserver_config.0.fill_factor_percent", "49"),
+					resource.TestCheckResourceAttr(sqlserverResource, "sqlserver_config.0.optimize_for_ad_hoc_workloads", "true"),
 					resource.TestCheckResourceAttr(sqlserverResource, "user.0.name", "bob"),
 					resource.TestCheckResourceAttr(sqlserverResource, "user.1.name", "alice"),
 					resource.TestCheckResourceAttr(sqlserverResource, "user.2.name", "chuck"),
@@ -444,7 +444,7 @@ resource "yandex_mdb_sqlserver_cluster" "foo" {
   }
 
 
-  sqlserver_config = {
+  sqlserver_config {
     fill_factor_percent           = 49
     optimize_for_ad_hoc_workloads = true
   }
@@ -14,5 +14,6 @@ func resourceYandexOrganizationManagerOrganizationIAMBinding() *schema.Resource
 			&schema.ResourceImporter{
 				StateContext: iamBindingImport(organizationIDParseFunc),
 			}),
+		WithIamBindingModes(),
 	)
 }
@@ -14,5 +14,6 @@ func resourceYandexResourceManagerCloudIAMBinding() *schema.Resource {
 			&schema.ResourceImporter{
 				StateContext: iamBindingImport(cloudIDParseFunc),
 			}),
+		WithIamBindingModes(),
 	)
 }
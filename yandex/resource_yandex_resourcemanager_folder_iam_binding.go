@@ -14,5 +14,6 @@ func resourceYandexResourceManagerFolderIAMBinding() *schema.Resource {
 			&schema.ResourceImporter{
 				StateContext: iamBindingImport(folderIDParseFunc),
 			}),
+		WithIamBindingModes(),
 	)
 }
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/access"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/iam/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/resourcemanager/v1"
 )
 
@@ -266,6 +268,101 @@ func TestAccFolderIamBinding_remove(t *testing.T) {
 	})
 }
 
+// Test that a binding in detect-only mode does not change the folder policy
+func TestAccFolderIamBinding_detectOnly(t *testing.T) {
+	var folder resourcemanager.Folder
+	cloudID := getExampleCloudID()
+	folderID := getExampleFolderID()
+	userID := getExampleUserID2()
+	binding := &access.AccessBinding{
+		RoleId: "viewer",
+		Subject: &access.Subject{
+			Type: "userAccount",
+			Id:   userID,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Use an example folder
+			{
+				Config: testAccFolderIamBasic(folderID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexResourceManagerFolderExists("data.yandex_resourcemanager_folder.acceptance", &folder),
+					testAccFolderExistingPolicy(&folder),
+				),
+			},
+			// Apply a binding in detect-only mode
+			{
+				Config: testAccFolderAssociateBindingWithMode(cloudID, folderID, userID, "detect-only"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_resourcemanager_folder_iam_binding.mode", "mode", "detect-only"),
+					testAccCheckYandexResourceManagerFolderIamBindingNotExists(&folder, binding),
+				),
+			},
+			// Enforce the binding
+			{
+				Config: testAccFolderAssociateBindingWithMode(cloudID, folderID, userID, "additive"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_resourcemanager_folder_iam_binding.mode", "mode", "additive"),
+					testAccCheckYandexResourceManagerFolderIamBindingExists(&folder, binding),
+				),
+			},
+		},
+	})
+}
+
+// Test that a member given by the service account name is kept in state as configured
+func TestAccFolderIamBinding_serviceAccountByName(t *testing.T) {
+	var folder resourcemanager.Folder
+	var sa iam.ServiceAccount
+	folderID := getExampleFolderID()
+	saName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFolderAssociateBindingServiceAccountByName(folderID, saName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckYandexResourceManagerFolderExists("data.yandex_resourcemanager_folder.acceptance", &folder),
+					testAccCheckYandexIAMServiceAccountExistsWithID("yandex_iam_service_account.acceptance", &sa),
+					resource.TestCheckTypeSetElemAttr("yandex_resourcemanager_folder_iam_binding.by_name", "members.*",
+						fmt.Sprintf("serviceAccount:%s/%s", folderID, saName)),
+					func(s *terraform.State) error {
+						return testAccCheckYandexResourceManagerFolderIamBindingExists(&folder, &access.AccessBinding{
+							RoleId: "viewer",
+							Subject: &access.Subject{
+								Type: "serviceAccount",
+								Id:   sa.Id,
+							},
+						})(s)
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckYandexResourceManagerFolderIamBindingNotExists(folder *resourcemanager.Folder, unexpected *access.AccessBinding) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		folderPolicy, err := getFolderIamPolicyByFolderID(folder.Id, config)
+		if err != nil {
+			return fmt.Errorf("Failed to retrieve IAM policy for folder %q: %s", folder.Id, err)
+		}
+
+		if checkBindingInPolicy(folderPolicy, unexpected) {
+			return fmt.Errorf("Unexpected access binding %v in policy of folder %q", unexpected, folder.Id)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckYandexResourceManagerFolderIamBindingExists(folder *resourcemanager.Folder, expected *access.AccessBinding) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -423,3 +520,41 @@ resource "yandex_resourcemanager_folder_iam_binding" "dropped" {
 }
 `, folderID, userID, deps)
 }
+
+func testAccFolderAssociateBindingServiceAccountByName(folderID, saName string) string {
+	return fmt.Sprintf(`
+data "yandex_resourcemanager_folder" "acceptance" {
+  folder_id = "%s"
+}
+
+resource "yandex_iam_service_account" "acceptance" {
+  folder_id = "${data.yandex_resourcemanager_folder.acceptance.id}"
+  name      = "%s"
+}
+
+resource "yandex_resourcemanager_folder_iam_binding" "by_name" {
+  folder_id = "${data.yandex_resourcemanager_folder.acceptance.id}"
+  members   = ["serviceAccount:${yandex_iam_service_account.acceptance.folder_id}/${yandex_iam_service_account.acceptance.name}"]
+  role      = "viewer"
+  mode      = "additive"
+}
+`, folderID, saName)
+}
+
+func testAccFolderAssociateBindingWithMode(cloudID, folderID, userID, mode string) string {
+	prerequisiteMembership, deps := testAccCloudAssignCloudMemberRole(cloudID, userID)
+	return prerequisiteMembership + fmt.Sprintf(`
+data "yandex_resourcemanager_folder" "acceptance" {
+  folder_id = "%s"
+}
+
+resource "yandex_resourcemanager_folder_iam_binding" "mode" {
+  folder_id = "${data.yandex_resourcemanager_folder.acceptance.id}"
+  members   = ["userAccount:%s"]
+  role      = "viewer"
+  mode      = "%s"
+
+  depends_on = [%s]
+}
+`, folderID, userID, mode, deps)
+}
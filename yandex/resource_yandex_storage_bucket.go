@@ -605,6 +605,25 @@ func resourceYandexStorageBucket() *schema.Resource {
 					},
 				},
 			},
+			"allowed_private_endpoints": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"private_endpoints": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -808,6 +827,11 @@ func resourceYandexStorageBucketUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
+	err = resourceYandexStorageBucketAllowedPrivateEndpointsUpdate(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceYandexStorageBucketRead(ctx, d, meta)
 }
 
@@ -1018,6 +1042,11 @@ func resourceYandexStorageBucketRead(ctx context.Context, d *schema.ResourceData
 		log.Printf("[WARN] Got an error reading Storage Bucket's extended properties: %s", err)
 	}
 
+	err = resourceYandexStorageBucketAllowedPrivateEndpointsRead(d, meta)
+	if err != nil {
+		log.Printf("[WARN] Got an error reading Storage Bucket's allowed private endpoints: %s", err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,118 @@
+package yandex
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"google.golang.org/grpc/codes"
+)
+
+// Bucket access through VPC private endpoints is not available in the pinned go-genproto version yet,
+// so it is managed through the REST API of Storage, see rest_api.go.
+const yandexStorageBucketsPath = "/storage/v1/buckets"
+
+type storageBucketAllowedPrivateEndpoints struct {
+	Enabled          bool     `json:"enabled"`
+	PrivateEndpoints []string `json:"privateEndpoints"`
+}
+
+type storageBucketWithAllowedPrivateEndpoints struct {
+	AllowedPrivateEndpoints *storageBucketAllowedPrivateEndpoints `json:"allowedPrivateEndpoints,omitempty"`
+}
+
+type storageBucketUpdateAllowedPrivateEndpointsRequest struct {
+	UpdateMask              string                                `json:"updateMask"`
+	AllowedPrivateEndpoints *storageBucketAllowedPrivateEndpoints `json:"allowedPrivateEndpoints,omitempty"`
+}
+
+func resourceYandexStorageBucketAllowedPrivateEndpointsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("allowed_private_endpoints") {
+		return nil
+	}
+
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	bucket := d.Get("bucket").(string)
+	req := &storageBucketUpdateAllowedPrivateEndpointsRequest{
+		UpdateMask:              "allowedPrivateEndpoints",
+		AllowedPrivateEndpoints: expandStorageBucketAllowedPrivateEndpoints(d.Get("allowed_private_endpoints")),
+	}
+
+	log.Printf("[INFO] updating S3 bucket allowed private endpoints: %+v", req.AllowedPrivateEndpoints)
+
+	_, err := doRestAPIOperation(ctx, config, ycsdk.StorageAPIServiceID, http.MethodPatch, yandexStorageBucketsPath+"/"+url.PathEscape(bucket), req)
+	if err != nil {
+		if handleS3BucketNotFoundError(d, err) {
+			return nil
+		}
+		return fmt.Errorf("error updating S3 bucket allowed private endpoints: %w", err)
+	}
+
+	return nil
+}
+
+func resourceYandexStorageBucketAllowedPrivateEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	config := meta.(*Config)
+	ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	bucket := &storageBucketWithAllowedPrivateEndpoints{}
+	err := doRestAPIRequest(ctx, config, ycsdk.StorageAPIServiceID, http.MethodGet,
+		yandexStorageBucketsPath+"/"+url.PathEscape(d.Get("bucket").(string)), url.Values{"view": []string{"VIEW_FULL"}}, nil, bucket)
+	switch {
+	case err == nil:
+		// continue
+	case isStatusWithCode(err, codes.NotFound),
+		isStatusWithCode(err, codes.PermissionDenied):
+		log.Printf("[INFO] Storage api got minor error getting S3 bucket allowed private endpoints %v", err)
+		return nil
+	default:
+		return err
+	}
+
+	return d.Set("allowed_private_endpoints", flattenStorageBucketAllowedPrivateEndpoints(bucket.AllowedPrivateEndpoints))
+}
+
+func expandStorageBucketAllowedPrivateEndpoints(v interface{}) *storageBucketAllowedPrivateEndpoints {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return &storageBucketAllowedPrivateEndpoints{}
+	}
+	settings := list[0].(map[string]interface{})
+
+	res := &storageBucketAllowedPrivateEndpoints{
+		Enabled:          settings["enabled"].(bool),
+		PrivateEndpoints: []string{},
+	}
+	for _, id := range settings["private_endpoints"].([]interface{}) {
+		res.PrivateEndpoints = append(res.PrivateEndpoints, id.(string))
+	}
+	return res
+}
+
+func flattenStorageBucketAllowedPrivateEndpoints(settings *storageBucketAllowedPrivateEndpoints) []interface{} {
+	if settings == nil {
+		return []interface{}{
+			map[string]interface{}{
+				"enabled":           false,
+				"private_endpoints": []interface{}{},
+			},
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":           settings.Enabled,
+			"private_endpoints": convertStringArrToInterface(settings.PrivateEndpoints),
+		},
+	}
+}
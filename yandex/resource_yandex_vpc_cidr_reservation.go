@@ -0,0 +1,175 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func resourceYandexVPCCidrReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexVPCCidrReservationCreate,
+		Read:   resourceYandexVPCCidrReservationRead,
+		Delete: resourceYandexVPCCidrReservationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexVPCNetworkDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexVPCNetworkDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexVPCNetworkDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reservation_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.All(validation.StringMatch(regexp.MustCompile(`^([-_./\\@0-9a-z]+)$`), ""), validation.StringLenBetween(1, 63)),
+			},
+			"supernet": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDRNetwork(0, 32),
+			},
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 32),
+			},
+			"block_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceYandexVPCCidrReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	networkID := d.Get("network_id").(string)
+	reservationKey := d.Get("reservation_key").(string)
+	supernet, err := netip.ParsePrefix(d.Get("supernet").(string))
+	if err != nil {
+		return fmt.Errorf("invalid supernet: %s", err)
+	}
+	prefixLength := d.Get("prefix_length").(int)
+	if err := validateVPCFreeCidrsSupernet(supernet, prefixLength); err != nil {
+		return err
+	}
+
+	mutexKV.Lock(networkID)
+	defer mutexKV.Unlock(networkID)
+
+	network, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
+		NetworkId: networkID,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Network %q: %s", networkID, err)
+	}
+
+	reserved, own := vpcFreeCidrsReservations(network.Labels, reservationKey)
+	if len(own) > 0 {
+		return fmt.Errorf("CIDR blocks %v in Network %q are already reserved for %q", own, networkID, reservationKey)
+	}
+
+	used, err := listVPCNetworkV4CidrBlocks(ctx, config, networkID)
+	if err != nil {
+		return err
+	}
+
+	blocks, err := allocateVPCFreeCidrs(supernet, prefixLength, d.Get("block_count").(int), append(used, reserved...))
+	if err != nil {
+		return fmt.Errorf("error allocating CIDR blocks in Network %q: %s", networkID, err)
+	}
+
+	if err := reserveVPCFreeCidrs(ctx, config, network, reservationKey, blocks); err != nil {
+		return err
+	}
+
+	d.SetId(constructResourceId(networkID, reservationKey))
+
+	return resourceYandexVPCCidrReservationRead(d, meta)
+}
+
+func resourceYandexVPCCidrReservationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	networkID, reservationKey, err := deconstructResourceId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	network, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
+		NetworkId: networkID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Network %q", networkID))
+	}
+
+	_, own := vpcFreeCidrsReservations(network.Labels, reservationKey)
+	if len(own) == 0 {
+		log.Printf("[WARN] CIDR reservation %q not found in Network %q, removing from state", reservationKey, networkID)
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("network_id", networkID); err != nil {
+		return err
+	}
+	if err := d.Set("reservation_key", reservationKey); err != nil {
+		return err
+	}
+	return d.Set("cidr_blocks", prefixesToStrings(own))
+}
+
+func resourceYandexVPCCidrReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	networkID := d.Get("network_id").(string)
+	reservationKey := d.Get("reservation_key").(string)
+
+	mutexKV.Lock(networkID)
+	defer mutexKV.Unlock(networkID)
+
+	network, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
+		NetworkId: networkID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Network %q", networkID))
+	}
+
+	log.Printf("[DEBUG] Releasing CIDR blocks reserved for %q in Network %q", reservationKey, networkID)
+	return reserveVPCFreeCidrs(ctx, config, network, reservationKey, nil)
+}
@@ -0,0 +1,107 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestVPCNetworkReservationLabels(t *testing.T) {
+	current := map[string]string{
+		"env":                       "stage",
+		"reserved-cidr.10.0.1.0/24": "stage",
+		"reserved-cidr.10.0.2.0/24": "prod",
+	}
+
+	if got, expected := vpcNetworkUserLabels(current), map[string]string{"env": "stage"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("vpcNetworkUserLabels() = %v, want %v", got, expected)
+	}
+
+	labels := map[string]string{
+		"env":                       "prod",
+		"reserved-cidr.10.0.3.0/24": "user",
+	}
+	expected := map[string]string{
+		"env":                       "prod",
+		"reserved-cidr.10.0.1.0/24": "stage",
+		"reserved-cidr.10.0.2.0/24": "prod",
+	}
+	if got := withVPCNetworkReservationLabels(labels, current); !reflect.DeepEqual(got, expected) {
+		t.Errorf("withVPCNetworkReservationLabels() = %v, want %v", got, expected)
+	}
+}
+
+func TestAccVPCCidrReservation_basic(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.RandomWithPrefix("tf-network")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCNetworkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCCidrReservationConfig(networkName, "env"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_reservation.stage", "cidr_blocks.#", "2"),
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_reservation.stage", "cidr_blocks.0", "172.16.1.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_reservation.stage", "cidr_blocks.1", "172.16.2.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_subnet.stage", "v4_cidr_blocks.0", "172.16.1.0/24"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_free_cidrs.free", "cidr_blocks.0", "172.16.3.0/24"),
+				),
+			},
+			{
+				// the reservations are kept when the labels of the network change
+				Config: testAccVPCCidrReservationConfig(networkName, "environment"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_vpc_network.foo", "labels.%", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_reservation.stage", "cidr_blocks.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCCidrReservationConfig(name, labelKey string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+
+  labels = {
+    %s = "test"
+  }
+}
+
+resource "yandex_vpc_subnet" "bar" {
+  network_id     = "${yandex_vpc_network.foo.id}"
+  v4_cidr_blocks = ["172.16.0.0/24"]
+}
+
+resource "yandex_vpc_cidr_reservation" "stage" {
+  network_id      = "${yandex_vpc_network.foo.id}"
+  reservation_key = "stage"
+  supernet        = "172.16.0.0/16"
+  prefix_length   = 24
+  block_count     = 2
+
+  depends_on = [yandex_vpc_subnet.bar]
+}
+
+resource "yandex_vpc_subnet" "stage" {
+  network_id     = "${yandex_vpc_network.foo.id}"
+  v4_cidr_blocks = [yandex_vpc_cidr_reservation.stage.cidr_blocks[0]]
+}
+
+data "yandex_vpc_free_cidrs" "free" {
+  network_id    = "${yandex_vpc_network.foo.id}"
+  supernet      = "172.16.0.0/16"
+  prefix_length = 24
+
+  depends_on = [yandex_vpc_cidr_reservation.stage]
+}
+`, name, labelKey)
+}
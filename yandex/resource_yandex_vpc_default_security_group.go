@@ -26,10 +26,10 @@ func yandexVPCDefaultSecurityGroupSchema() map[string]*schema.Schema {
 
 func resourceYandexVPCDefaultSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCDefaultSecurityGroupCreate,
-		Read:   resourceYandexVPCDefaultSecurityGroupRead,
-		Update: resourceYandexVPCDefaultSecurityGroupUpdate,
-		Delete: resourceYandexVPCDefaultSecurityGroupDelete,
+		CreateContext: withSecurityGroupRulesWarnings(resourceYandexVPCDefaultSecurityGroupCreate, checkSecurityGroupRulesInResourceData),
+		Read:          resourceYandexVPCDefaultSecurityGroupRead,
+		UpdateContext: withSecurityGroupRulesWarnings(resourceYandexVPCDefaultSecurityGroupUpdate, checkSecurityGroupRulesInResourceData),
+		Delete:        resourceYandexVPCDefaultSecurityGroupDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -42,6 +42,8 @@ func resourceYandexVPCDefaultSecurityGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexVPCDefaultSecurityGroupDefaultTimeout),
 		},
 
+		CustomizeDiff: securityGroupRulesCustomizeDiff,
+
 		SchemaVersion: 0,
 		Schema:        yandexVPCDefaultSecurityGroupSchema(),
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -173,7 +174,7 @@ func yandexVPCNetworkRead(d *schema.ResourceData, meta interface{}, id string) e
 		return err
 	}
 
-	return d.Set("labels", network.Labels)
+	return d.Set("labels", vpcNetworkUserLabels(network.Labels))
 }
 
 func resourceYandexVPCNetworkUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -186,13 +187,27 @@ func resourceYandexVPCNetworkUpdate(d *schema.ResourceData, meta interface{}) er
 		UpdateMask: &field_mask.FieldMask{},
 	}
 
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
 	if d.HasChange("labels") {
 		labelsProp, err := expandLabels(d.Get("labels"))
 		if err != nil {
 			return err
 		}
 
-		req.Labels = labelsProp
+		// CIDR reservations are kept in the labels, so the labels are read and updated under the same lock
+		mutexKV.Lock(d.Id())
+		defer mutexKV.Unlock(d.Id())
+
+		network, err := config.sdk.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
+			NetworkId: d.Id(),
+		})
+		if err != nil {
+			return fmt.Errorf("Error while reading Network %q: %s", d.Id(), err)
+		}
+
+		req.Labels = withVPCNetworkReservationLabels(labelsProp, network.Labels)
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "labels")
 	}
 
@@ -206,9 +221,6 @@ func resourceYandexVPCNetworkUpdate(d *schema.ResourceData, meta interface{}) er
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().Network().Update(ctx, req))
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to update Network %q: %s", d.Id(), err)
@@ -254,3 +266,26 @@ func resourceYandexVPCNetworkDelete(d *schema.ResourceData, meta interface{}) er
 	log.Printf("[DEBUG] Finished deleting Network %q", d.Id())
 	return nil
 }
+
+// vpcNetworkUserLabels returns the labels of the network without the CIDR reservations of yandex_vpc_cidr_reservation,
+// which are managed by that resource.
+func vpcNetworkUserLabels(labels map[string]string) map[string]string {
+	res := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if !strings.HasPrefix(k, vpcFreeCidrsReservationLabelPrefix) {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// withVPCNetworkReservationLabels adds the CIDR reservations of the current labels to the new ones.
+func withVPCNetworkReservationLabels(labels, current map[string]string) map[string]string {
+	res := vpcNetworkUserLabels(labels)
+	for k, v := range current {
+		if strings.HasPrefix(k, vpcFreeCidrsReservationLabelPrefix) {
+			res[k] = v
+		}
+	}
+	return res
+}
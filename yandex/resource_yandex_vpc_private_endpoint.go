@@ -0,0 +1,429 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+)
+
+const yandexVPCPrivateEndpointDefaultTimeout = 5 * time.Minute
+
+// Private endpoints are not available in the pinned go-genproto version yet, so they are managed through
+// the REST API, see rest_api.go.
+const yandexVPCPrivateEndpointsPath = "/vpc/v1/endpoints"
+
+type vpcPrivateEndpoint struct {
+	ID            string                       `json:"id"`
+	FolderID      string                       `json:"folderId"`
+	CreatedAt     string                       `json:"createdAt"`
+	Name          string                       `json:"name"`
+	Description   string                       `json:"description"`
+	Labels        map[string]string            `json:"labels"`
+	NetworkID     string                       `json:"networkId"`
+	Status        string                       `json:"status"`
+	Address       *vpcPrivateEndpointAddress   `json:"address"`
+	DNSOptions    *vpcPrivateEndpointDNSOption `json:"dnsOptions"`
+	ObjectStorage *struct{}                    `json:"objectStorage"`
+}
+
+type vpcPrivateEndpointAddress struct {
+	SubnetID  string `json:"subnetId"`
+	Address   string `json:"address"`
+	AddressID string `json:"addressId"`
+}
+
+type vpcPrivateEndpointDNSOption struct {
+	PrivateDNSRecordsEnabled bool `json:"privateDnsRecordsEnabled"`
+}
+
+type vpcPrivateEndpointAddressSpec struct {
+	AddressID               string                                     `json:"addressId,omitempty"`
+	InternalIpv4AddressSpec *vpcPrivateEndpointInternalIpv4AddressSpec `json:"internalIpv4AddressSpec,omitempty"`
+}
+
+type vpcPrivateEndpointInternalIpv4AddressSpec struct {
+	SubnetID string `json:"subnetId"`
+	Address  string `json:"address,omitempty"`
+}
+
+type vpcPrivateEndpointCreateRequest struct {
+	FolderID      string                         `json:"folderId"`
+	Name          string                         `json:"name,omitempty"`
+	Description   string                         `json:"description,omitempty"`
+	Labels        map[string]string              `json:"labels,omitempty"`
+	NetworkID     string                         `json:"networkId"`
+	AddressSpec   *vpcPrivateEndpointAddressSpec `json:"addressSpec,omitempty"`
+	DNSOptions    *vpcPrivateEndpointDNSOption   `json:"dnsOptions,omitempty"`
+	ObjectStorage *struct{}                      `json:"objectStorage,omitempty"`
+}
+
+type vpcPrivateEndpointUpdateRequest struct {
+	UpdateMask  string                       `json:"updateMask"`
+	Name        string                       `json:"name,omitempty"`
+	Description string                       `json:"description,omitempty"`
+	Labels      map[string]string            `json:"labels,omitempty"`
+	DNSOptions  *vpcPrivateEndpointDNSOption `json:"dnsOptions,omitempty"`
+}
+
+type vpcPrivateEndpointListResponse struct {
+	PrivateEndpoints []*vpcPrivateEndpoint `json:"privateEndpoints"`
+	NextPageToken    string                `json:"nextPageToken"`
+}
+
+func resourceYandexVPCPrivateEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceYandexVPCPrivateEndpointCreate,
+		Read:   resourceYandexVPCPrivateEndpointRead,
+		Update: resourceYandexVPCPrivateEndpointUpdate,
+		Delete: resourceYandexVPCPrivateEndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexVPCPrivateEndpointDefaultTimeout),
+			Read:   schema.DefaultTimeout(yandexVPCPrivateEndpointDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexVPCPrivateEndpointDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexVPCPrivateEndpointDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"object_storage": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				},
+			},
+			"folder_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dns_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"private_dns_records_enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"endpoint_address": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"address_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceYandexVPCPrivateEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("error expanding labels while creating private endpoint: %s", err)
+	}
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("error getting folder ID while creating private endpoint: %s", err)
+	}
+
+	req := &vpcPrivateEndpointCreateRequest{
+		FolderID:      folderID,
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		Labels:        labels,
+		NetworkID:     d.Get("network_id").(string),
+		AddressSpec:   expandVPCPrivateEndpointAddressSpec(d.Get("endpoint_address")),
+		DNSOptions:    expandVPCPrivateEndpointDNSOptions(d.Get("dns_options")),
+		ObjectStorage: &struct{}{},
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := doRestAPIOperation(ctx, config, ycsdk.VpcServiceID, http.MethodPost, yandexVPCPrivateEndpointsPath, req)
+	if op != nil {
+		if id := op.metadataString("privateEndpointId"); id != "" {
+			d.SetId(id)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("error while creating private endpoint: %s", err)
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("could not get private endpoint ID from create operation metadata")
+	}
+
+	return resourceYandexVPCPrivateEndpointRead(d, meta)
+}
+
+func getVPCPrivateEndpoint(ctx context.Context, config *Config, id string) (*vpcPrivateEndpoint, error) {
+	endpoint := &vpcPrivateEndpoint{}
+	err := doRestAPIRequest(ctx, config, ycsdk.VpcServiceID, http.MethodGet, yandexVPCPrivateEndpointsPath+"/"+url.PathEscape(id), nil, nil, endpoint)
+	return endpoint, err
+}
+
+func listVPCPrivateEndpoints(ctx context.Context, config *Config, folderID string) ([]*vpcPrivateEndpoint, error) {
+	var endpoints []*vpcPrivateEndpoint
+
+	query := url.Values{"folderId": []string{folderID}}
+	for {
+		resp := &vpcPrivateEndpointListResponse{}
+		if err := doRestAPIRequest(ctx, config, ycsdk.VpcServiceID, http.MethodGet, yandexVPCPrivateEndpointsPath, query, nil, resp); err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, resp.PrivateEndpoints...)
+
+		if resp.NextPageToken == "" {
+			return endpoints, nil
+		}
+		query.Set("pageToken", resp.NextPageToken)
+	}
+}
+
+func yandexVPCPrivateEndpointRead(d *schema.ResourceData, meta interface{}, id string) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	endpoint, err := getVPCPrivateEndpoint(ctx, config, id)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Private endpoint %q", id))
+	}
+
+	return flattenVPCPrivateEndpoint(d, endpoint)
+}
+
+func flattenVPCPrivateEndpoint(d *schema.ResourceData, endpoint *vpcPrivateEndpoint) error {
+	d.Set("folder_id", endpoint.FolderID)
+	d.Set("created_at", endpoint.CreatedAt)
+	d.Set("name", endpoint.Name)
+	d.Set("description", endpoint.Description)
+	d.Set("network_id", endpoint.NetworkID)
+	d.Set("status", strings.ToUpper(endpoint.Status))
+
+	if err := d.Set("labels", endpoint.Labels); err != nil {
+		return err
+	}
+
+	if err := d.Set("object_storage", flattenVPCPrivateEndpointObjectStorage(endpoint)); err != nil {
+		return err
+	}
+
+	if err := d.Set("dns_options", flattenVPCPrivateEndpointDNSOptions(endpoint.DNSOptions)); err != nil {
+		return err
+	}
+
+	return d.Set("endpoint_address", flattenVPCPrivateEndpointAddress(endpoint.Address))
+}
+
+func resourceYandexVPCPrivateEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	return yandexVPCPrivateEndpointRead(d, meta, d.Id())
+}
+
+func resourceYandexVPCPrivateEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	d.Partial(true)
+
+	req := &vpcPrivateEndpointUpdateRequest{}
+	var paths []string
+
+	if d.HasChange("name") {
+		req.Name = d.Get("name").(string)
+		paths = append(paths, "name")
+	}
+
+	if d.HasChange("description") {
+		req.Description = d.Get("description").(string)
+		paths = append(paths, "description")
+	}
+
+	if d.HasChange("labels") {
+		labels, err := expandLabels(d.Get("labels"))
+		if err != nil {
+			return err
+		}
+		req.Labels = labels
+		paths = append(paths, "labels")
+	}
+
+	if d.HasChange("dns_options") {
+		req.DNSOptions = expandVPCPrivateEndpointDNSOptions(d.Get("dns_options"))
+		paths = append(paths, "dnsOptions")
+	}
+
+	if len(paths) == 0 {
+		d.Partial(false)
+		return resourceYandexVPCPrivateEndpointRead(d, meta)
+	}
+	req.UpdateMask = strings.Join(paths, ",")
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	_, err := doRestAPIOperation(ctx, config, ycsdk.VpcServiceID, http.MethodPatch, yandexVPCPrivateEndpointsPath+"/"+url.PathEscape(d.Id()), req)
+	if err != nil {
+		return fmt.Errorf("error updating private endpoint %q: %s", d.Id(), err)
+	}
+
+	d.Partial(false)
+
+	return resourceYandexVPCPrivateEndpointRead(d, meta)
+}
+
+func resourceYandexVPCPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting private endpoint %q", d.Id())
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	_, err := doRestAPIOperation(ctx, config, ycsdk.VpcServiceID, http.MethodDelete, yandexVPCPrivateEndpointsPath+"/"+url.PathEscape(d.Id()), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Private endpoint %q", d.Id()))
+	}
+
+	log.Printf("[DEBUG] Finished deleting private endpoint %q", d.Id())
+	return nil
+}
+
+func expandVPCPrivateEndpointAddressSpec(v interface{}) *vpcPrivateEndpointAddressSpec {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	address := list[0].(map[string]interface{})
+
+	if id := address["address_id"].(string); id != "" {
+		return &vpcPrivateEndpointAddressSpec{AddressID: id}
+	}
+	if subnetID := address["subnet_id"].(string); subnetID != "" {
+		return &vpcPrivateEndpointAddressSpec{
+			InternalIpv4AddressSpec: &vpcPrivateEndpointInternalIpv4AddressSpec{
+				SubnetID: subnetID,
+				Address:  address["address"].(string),
+			},
+		}
+	}
+	return nil
+}
+
+func expandVPCPrivateEndpointDNSOptions(v interface{}) *vpcPrivateEndpointDNSOption {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return nil
+	}
+	options := list[0].(map[string]interface{})
+
+	return &vpcPrivateEndpointDNSOption{
+		PrivateDNSRecordsEnabled: options["private_dns_records_enabled"].(bool),
+	}
+}
+
+func flattenVPCPrivateEndpointAddress(address *vpcPrivateEndpointAddress) []interface{} {
+	if address == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"subnet_id":  address.SubnetID,
+			"address":    address.Address,
+			"address_id": address.AddressID,
+		},
+	}
+}
+
+func flattenVPCPrivateEndpointDNSOptions(options *vpcPrivateEndpointDNSOption) []interface{} {
+	if options == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"private_dns_records_enabled": options.PrivateDNSRecordsEnabled,
+		},
+	}
+}
+
+func flattenVPCPrivateEndpointObjectStorage(endpoint *vpcPrivateEndpoint) []interface{} {
+	if endpoint.ObjectStorage == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{}}
+}
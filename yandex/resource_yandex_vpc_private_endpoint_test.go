@@ -0,0 +1,219 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"google.golang.org/grpc/codes"
+)
+
+func TestExpandVPCPrivateEndpointAddressSpec(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		expected *vpcPrivateEndpointAddressSpec
+	}{
+		{
+			name:  "empty",
+			value: []interface{}{},
+		},
+		{
+			name: "address id",
+			value: []interface{}{map[string]interface{}{
+				"subnet_id":  "subnet",
+				"address":    "10.0.0.5",
+				"address_id": "address",
+			}},
+			expected: &vpcPrivateEndpointAddressSpec{AddressID: "address"},
+		},
+		{
+			name: "internal address",
+			value: []interface{}{map[string]interface{}{
+				"subnet_id":  "subnet",
+				"address":    "10.0.0.5",
+				"address_id": "",
+			}},
+			expected: &vpcPrivateEndpointAddressSpec{
+				InternalIpv4AddressSpec: &vpcPrivateEndpointInternalIpv4AddressSpec{
+					SubnetID: "subnet",
+					Address:  "10.0.0.5",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := expandVPCPrivateEndpointAddressSpec(tc.value); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expandVPCPrivateEndpointAddressSpec() = %+v, want %+v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRestAPIStatusError(t *testing.T) {
+	cases := []struct {
+		name     string
+		httpCode int
+		body     string
+		expected codes.Code
+	}{
+		{
+			name:     "api error",
+			httpCode: 400,
+			body:     `{"code": 5, "message": "Private endpoint not found"}`,
+			expected: codes.NotFound,
+		},
+		{
+			name:     "plain text",
+			httpCode: 404,
+			body:     "not found",
+			expected: codes.NotFound,
+		},
+		{
+			name:     "unknown",
+			httpCode: 502,
+			body:     "bad gateway",
+			expected: codes.Unknown,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := restAPIStatusError(tc.httpCode, []byte(tc.body)); !isStatusWithCode(err, tc.expected) {
+				t.Errorf("restAPIStatusError() = %v, want code %v", err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAccVPCPrivateEndpoint_basic(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.RandomWithPrefix("tf-network")
+	endpointName := acctest.RandomWithPrefix("tf-private-endpoint")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCPrivateEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPrivateEndpointConfig(networkName, endpointName, "description", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPrivateEndpointExists("yandex_vpc_private_endpoint.foo"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.foo", "name", endpointName),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.foo", "description", "description"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.foo", "dns_options.0.private_dns_records_enabled", "true"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.foo", "object_storage.#", "1"),
+					resource.TestCheckResourceAttrPair("yandex_vpc_private_endpoint.foo", "endpoint_address.0.subnet_id", "yandex_vpc_subnet.foo", "id"),
+					resource.TestCheckResourceAttrSet("yandex_vpc_private_endpoint.foo", "endpoint_address.0.address"),
+					testAccCheckCreatedAtAttr("yandex_vpc_private_endpoint.foo"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_private_endpoint.by_id", "name", "yandex_vpc_private_endpoint.foo", "name"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_private_endpoint.by_name", "private_endpoint_id", "yandex_vpc_private_endpoint.foo", "id"),
+				),
+			},
+			{
+				Config: testAccVPCPrivateEndpointConfig(networkName, endpointName, "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPrivateEndpointExists("yandex_vpc_private_endpoint.foo"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.foo", "description", "updated"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.foo", "dns_options.0.private_dns_records_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "yandex_vpc_private_endpoint.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCPrivateEndpointDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_vpc_private_endpoint" {
+			continue
+		}
+
+		_, err := getVPCPrivateEndpoint(config.Context(), config, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Private endpoint still exists")
+		}
+		if !isStatusWithCode(err, codes.NotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVPCPrivateEndpointExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := getVPCPrivateEndpoint(config.Context(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Private endpoint not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCPrivateEndpointConfig(networkName, endpointName, description string, dnsRecords bool) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_subnet" "foo" {
+  network_id     = yandex_vpc_network.foo.id
+  zone           = "ru-central1-a"
+  v4_cidr_blocks = ["10.100.0.0/24"]
+}
+
+resource "yandex_vpc_private_endpoint" "foo" {
+  name        = "%s"
+  description = "%s"
+  network_id  = yandex_vpc_network.foo.id
+
+  object_storage {}
+
+  dns_options {
+    private_dns_records_enabled = %t
+  }
+
+  endpoint_address {
+    subnet_id = yandex_vpc_subnet.foo.id
+  }
+}
+
+data "yandex_vpc_private_endpoint" "by_id" {
+  private_endpoint_id = yandex_vpc_private_endpoint.foo.id
+}
+
+data "yandex_vpc_private_endpoint" "by_name" {
+  name = yandex_vpc_private_endpoint.foo.name
+}
+`, networkName, endpointName, description, dnsRecords)
+}
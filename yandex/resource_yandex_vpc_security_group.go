@@ -81,10 +81,10 @@ func yandexVPCSecurityGroupSchema() map[string]*schema.Schema {
 
 func resourceYandexVPCSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVPCSecurityGroupCreate,
-		Read:   resourceYandexVPCSecurityGroupRead,
-		Update: resourceYandexVPCSecurityGroupUpdate,
-		Delete: resourceYandexVPCSecurityGroupDelete,
+		CreateContext: withSecurityGroupRulesWarnings(resourceYandexVPCSecurityGroupCreate, checkSecurityGroupRulesInResourceData),
+		Read:          resourceYandexVPCSecurityGroupRead,
+		UpdateContext: withSecurityGroupRulesWarnings(resourceYandexVPCSecurityGroupUpdate, checkSecurityGroupRulesInResourceData),
+		Delete:        resourceYandexVPCSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -95,6 +95,8 @@ func resourceYandexVPCSecurityGroup() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
 		},
 
+		CustomizeDiff: securityGroupRulesCustomizeDiff,
+
 		SchemaVersion: 0,
 		Schema:        yandexVPCSecurityGroupSchema(),
 	}
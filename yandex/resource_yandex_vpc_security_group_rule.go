@@ -13,10 +13,10 @@ import (
 
 func resourceYandexVpcSecurityGroupRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceYandexVpcSecurityGroupRuleCreate,
-		Read:   resourceYandexVpcSecurityGroupRuleRead,
-		Update: resourceYandexVpcSecurityGroupRuleUpdate,
-		Delete: resourceYandexVpcSecurityGroupRuleDelete,
+		CreateContext: withSecurityGroupRulesWarnings(resourceYandexVpcSecurityGroupRuleCreate, checkSecurityGroupRuleResourceData),
+		Read:          resourceYandexVpcSecurityGroupRuleRead,
+		UpdateContext: withSecurityGroupRulesWarnings(resourceYandexVpcSecurityGroupRuleUpdate, checkSecurityGroupRuleResourceData),
+		Delete:        resourceYandexVpcSecurityGroupRuleDelete,
 
 		Importer: &schema.ResourceImporter{
 			State: resourceYandexVpcSecurityGroupRuleImporterFunc,
@@ -29,6 +29,8 @@ func resourceYandexVpcSecurityGroupRule() *schema.Resource {
 			Update: schema.DefaultTimeout(yandexVPCSecurityGroupDefaultTimeout),
 		},
 
+		CustomizeDiff: securityGroupRuleCustomizeDiff,
+
 		SchemaVersion: 0,
 		Schema: map[string]*schema.Schema{
 			"direction": {
@@ -0,0 +1,49 @@
+package yandex
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common/restapi"
+)
+
+// Some API services are not available in the pinned go-genproto version yet, those are called
+// through the REST API of the same endpoints. Errors are converted to gRPC statuses, so
+// isStatusWithCode and handleNotFoundError work the same way for both.
+
+// restAPIOperation is an operation as returned by the REST API. Its metadata is kept as JSON,
+// since its type may be unknown to the pinned go-genproto.
+type restAPIOperation struct {
+	ID       string                 `json:"id"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+var restAPIStatusError = restapi.StatusError
+
+// doRestAPIRequest calls the REST API of the given service, encoding body and decoding the response into result
+// as JSON, any of them can be nil.
+func doRestAPIRequest(ctx context.Context, config *Config, serviceID ycsdk.Endpoint, method, path string, query url.Values, body, result interface{}) error {
+	return config.restClient.Do(ctx, serviceID, method, path, query, body, result)
+}
+
+// doRestAPIOperation performs a request returning an operation and waits for the operation to finish.
+// The operation is returned even if it failed, so that the created resource ID can be taken from the metadata.
+func doRestAPIOperation(ctx context.Context, config *Config, serviceID ycsdk.Endpoint, method, path string, body interface{}) (*restAPIOperation, error) {
+	restOp := &restAPIOperation{}
+	if err := doRestAPIRequest(ctx, config, serviceID, method, path, nil, body, restOp); err != nil {
+		return nil, err
+	}
+
+	op, err := config.sdk.Operation().Get(ctx, &operation.GetOperationRequest{
+		OperationId: restOp.ID,
+	})
+	return restOp, waitOperation(ctx, config, op, err)
+}
+
+func (op *restAPIOperation) metadataString(key string) string {
+	v, _ := op.Metadata[key].(string)
+	return v
+}
@@ -1042,3 +1042,20 @@ func getOrDefault[V any, T any, PT Wrapper[V, T]](wrapper PT, def V) V {
 	}
 	return wrapper.GetValue()
 }
+
+func removeRoleMembersFromBindings(role string, members []string, bindings []*access.AccessBinding) []*access.AccessBinding {
+	remove := make(map[string]bool, len(members))
+	for _, m := range members {
+		remove[m] = true
+	}
+
+	var rb []*access.AccessBinding
+	for _, b := range bindings {
+		if b.RoleId == role && remove[canonicalMember(b)] {
+			continue
+		}
+		rb = append(rb, b)
+	}
+
+	return rb
+}
@@ -0,0 +1,471 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const (
+	securityGroupRulesCheckOff   = "off"
+	securityGroupRulesCheckWarn  = "warn"
+	securityGroupRulesCheckError = "error"
+
+	securityGroupRuleAnyProtocol = "ANY"
+	securityGroupRuleMaxPort     = 65535
+)
+
+// Protocol numbers are accepted by the API as well as names, normalise them to names for comparison.
+var securityGroupRuleProtocolNumbers = map[string]string{
+	"1":  "ICMP",
+	"6":  "TCP",
+	"17": "UDP",
+	"58": "IPV6_ICMP",
+}
+
+var securityGroupRulePortProtocols = map[string]bool{
+	securityGroupRuleAnyProtocol: true,
+	"TCP":                        true,
+	"UDP":                        true,
+}
+
+// securityGroupRuleCheckEntry is a security group rule normalised for comparison:
+// protocol is upper-cased, `port` is turned into a port range, `-1` ports mean the full range
+// and CIDR blocks are masked, deduplicated and sorted.
+type securityGroupRuleCheckEntry struct {
+	id               string
+	description      string
+	direction        string
+	protocol         string
+	fromPort         int64
+	toPort           int64
+	v4CidrBlocks     []netip.Prefix
+	v6CidrBlocks     []netip.Prefix
+	securityGroupID  string
+	predefinedTarget string
+}
+
+func (e *securityGroupRuleCheckEntry) String() string {
+	var parts []string
+	if e.id != "" {
+		parts = append(parts, fmt.Sprintf("id = %s", e.id))
+	}
+	parts = append(parts, fmt.Sprintf("protocol = %s", e.protocol))
+	if e.fromPort == e.toPort {
+		parts = append(parts, fmt.Sprintf("port = %d", e.fromPort))
+	} else if !e.anyPort() {
+		parts = append(parts, fmt.Sprintf("ports = %d-%d", e.fromPort, e.toPort))
+	}
+	if len(e.v4CidrBlocks) > 0 {
+		parts = append(parts, fmt.Sprintf("v4_cidr_blocks = %v", e.v4CidrBlocks))
+	}
+	if len(e.v6CidrBlocks) > 0 {
+		parts = append(parts, fmt.Sprintf("v6_cidr_blocks = %v", e.v6CidrBlocks))
+	}
+	if e.securityGroupID != "" {
+		parts = append(parts, fmt.Sprintf("security_group_id = %s", e.securityGroupID))
+	}
+	if e.predefinedTarget != "" {
+		parts = append(parts, fmt.Sprintf("predefined_target = %s", e.predefinedTarget))
+	}
+	if e.description != "" {
+		parts = append(parts, fmt.Sprintf("description = %q", e.description))
+	}
+
+	return fmt.Sprintf("%s rule {%s}", strings.ToLower(e.direction), strings.Join(parts, ", "))
+}
+
+func (e *securityGroupRuleCheckEntry) anyPort() bool {
+	return e.fromPort == 0 && e.toPort == securityGroupRuleMaxPort
+}
+
+// key identifies rules that are equal for the API, regardless of description and labels.
+func (e *securityGroupRuleCheckEntry) key() string {
+	return fmt.Sprintf("%s|%s|%d-%d|%v|%v|%s|%s", e.direction, e.protocol, e.fromPort, e.toPort,
+		e.v4CidrBlocks, e.v6CidrBlocks, e.securityGroupID, e.predefinedTarget)
+}
+
+// covers reports whether every packet matched by other is also matched by e.
+func (e *securityGroupRuleCheckEntry) covers(other *securityGroupRuleCheckEntry) bool {
+	if e.direction != other.direction {
+		return false
+	}
+	if e.protocol != securityGroupRuleAnyProtocol && e.protocol != other.protocol {
+		return false
+	}
+	if e.fromPort > other.fromPort || e.toPort < other.toPort {
+		return false
+	}
+
+	switch {
+	case other.securityGroupID != "":
+		return e.securityGroupID == other.securityGroupID
+	case other.predefinedTarget != "":
+		return e.predefinedTarget == other.predefinedTarget
+	case len(other.v4CidrBlocks) == 0 && len(other.v6CidrBlocks) == 0:
+		return false
+	}
+
+	return prefixesCovered(e.v4CidrBlocks, other.v4CidrBlocks) && prefixesCovered(e.v6CidrBlocks, other.v6CidrBlocks)
+}
+
+func prefixesCovered(outer, inner []netip.Prefix) bool {
+	for _, in := range inner {
+		covered := false
+		for _, out := range outer {
+			if out.Bits() <= in.Bits() && out.Contains(in.Addr()) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+func newSecurityGroupRuleCheckEntry(direction string, rule map[string]interface{}) (*securityGroupRuleCheckEntry, []string) {
+	e := &securityGroupRuleCheckEntry{
+		direction: strings.ToUpper(direction),
+	}
+	e.id, _ = rule["id"].(string)
+	e.description, _ = rule["description"].(string)
+	e.securityGroupID, _ = rule["security_group_id"].(string)
+	e.predefinedTarget, _ = rule["predefined_target"].(string)
+
+	e.protocol = strings.ToUpper(strings.TrimSpace(fmt.Sprint(rule["protocol"])))
+	if rule["protocol"] == nil || e.protocol == "" {
+		e.protocol = securityGroupRuleAnyProtocol
+	}
+	if name, ok := securityGroupRuleProtocolNumbers[e.protocol]; ok {
+		e.protocol = name
+	}
+
+	var problems []string
+
+	port, fromPort, toPort := securityGroupRuleCheckInt(rule["port"]), securityGroupRuleCheckInt(rule["from_port"]), securityGroupRuleCheckInt(rule["to_port"])
+	switch {
+	case port != -1 && (fromPort != -1 || toPort != -1):
+		problems = append(problems, "`port` cannot be set together with `from_port`/`to_port`")
+		e.fromPort, e.toPort = port, port
+	case port != -1:
+		e.fromPort, e.toPort = port, port
+	case fromPort == -1 && toPort == -1:
+		e.fromPort, e.toPort = 0, securityGroupRuleMaxPort
+	case fromPort == -1 || toPort == -1:
+		problems = append(problems, "both `from_port` and `to_port` must be set")
+		e.fromPort, e.toPort = 0, securityGroupRuleMaxPort
+	case fromPort > toPort:
+		problems = append(problems, fmt.Sprintf("`from_port` %d is greater than `to_port` %d", fromPort, toPort))
+		e.fromPort, e.toPort = toPort, fromPort
+	default:
+		e.fromPort, e.toPort = fromPort, toPort
+	}
+
+	if !e.anyPort() && !securityGroupRulePortProtocols[e.protocol] {
+		problems = append(problems, fmt.Sprintf("ports cannot be used with protocol %s, only with %s", e.protocol,
+			strings.Join([]string{securityGroupRuleAnyProtocol, "TCP", "UDP"}, ", ")))
+	}
+
+	var cidrProblems []string
+	e.v4CidrBlocks, cidrProblems = normalizeSecurityGroupRuleCidrBlocks("v4_cidr_blocks", rule["v4_cidr_blocks"], true)
+	problems = append(problems, cidrProblems...)
+	e.v6CidrBlocks, cidrProblems = normalizeSecurityGroupRuleCidrBlocks("v6_cidr_blocks", rule["v6_cidr_blocks"], false)
+	problems = append(problems, cidrProblems...)
+
+	return e, problems
+}
+
+func securityGroupRuleCheckInt(v interface{}) int64 {
+	switch v := v.(type) {
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	}
+	return -1
+}
+
+func normalizeSecurityGroupRuleCidrBlocks(attr string, v interface{}, v4 bool) ([]netip.Prefix, []string) {
+	var raw []string
+	switch v := v.(type) {
+	case []interface{}:
+		for _, c := range v {
+			if s, ok := c.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	case []string:
+		raw = v
+	}
+
+	var problems []string
+	seen := map[netip.Prefix]bool{}
+	res := make([]netip.Prefix, 0, len(raw))
+	for _, c := range raw {
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(c))
+		if err != nil || prefix.Addr().Is4() != v4 {
+			problems = append(problems, fmt.Sprintf("`%s` contains invalid CIDR block %q", attr, c))
+			continue
+		}
+
+		masked := prefix.Masked()
+		if masked != prefix {
+			problems = append(problems, fmt.Sprintf("`%s` contains %q with host bits set, it matches the whole %q network", attr, c, masked))
+		}
+		if seen[masked] {
+			problems = append(problems, fmt.Sprintf("`%s` contains %q more than once", attr, masked))
+			continue
+		}
+		seen[masked] = true
+		res = append(res, masked)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Addr() != res[j].Addr() {
+			return res[i].Addr().Less(res[j].Addr())
+		}
+		return res[i].Bits() < res[j].Bits()
+	})
+
+	return res, problems
+}
+
+// checkSecurityGroupRules returns problems found in rules: invalid rules, duplicates and rules shadowed
+// by broader ones. The existing rules are only used to check the new ones against.
+func checkSecurityGroupRules(rules []*securityGroupRuleCheckEntry, existing []*securityGroupRuleCheckEntry) []string {
+	var problems []string
+
+	all := append(append([]*securityGroupRuleCheckEntry{}, rules...), existing...)
+	for i, rule := range rules {
+		for j, other := range all {
+			if i == j {
+				continue
+			}
+			mutual := rule.key() == other.key() || (other.covers(rule) && rule.covers(other))
+			if j < len(rules) && j < i && mutual {
+				// duplicates and rules shadowing each other among the checked rules are reported once
+				continue
+			}
+
+			switch {
+			case rule.key() == other.key():
+				problems = append(problems, fmt.Sprintf("%s duplicates %s", rule, other))
+			case mutual:
+				problems = append(problems, fmt.Sprintf("%s matches the same traffic as %s", rule, other))
+			case other.covers(rule):
+				problems = append(problems, fmt.Sprintf("%s is shadowed by broader %s", rule, other))
+			}
+		}
+	}
+
+	return problems
+}
+
+func securityGroupRulesCheckMode(meta interface{}) string {
+	config, ok := meta.(*Config)
+	if !ok || config.SecurityGroupRulesCheck == "" {
+		return common.DefaultSecurityGroupRulesCheck
+	}
+	return config.SecurityGroupRulesCheck
+}
+
+func securityGroupRulesFromSets(ingress, egress interface{}) ([]*securityGroupRuleCheckEntry, []string) {
+	var rules []*securityGroupRuleCheckEntry
+	var problems []string
+
+	for direction, v := range map[string]interface{}{"ingress": ingress, "egress": egress} {
+		set, ok := v.(*schema.Set)
+		if !ok {
+			continue
+		}
+		for _, r := range set.List() {
+			rule, ok := r.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			entry, ruleProblems := newSecurityGroupRuleCheckEntry(direction, rule)
+			for _, p := range ruleProblems {
+				problems = append(problems, fmt.Sprintf("%s: %s", entry, p))
+			}
+			rules = append(rules, entry)
+		}
+	}
+
+	// set iteration order is not stable, sort rules to make the messages deterministic
+	sort.Slice(rules, func(i, j int) bool { return rules[i].String() < rules[j].String() })
+
+	return rules, problems
+}
+
+func securityGroupRulesFromAPI(rules []*vpc.SecurityGroupRule, skipID string) []*securityGroupRuleCheckEntry {
+	ingress, egress := flattenSecurityGroupRulesSpec(rules)
+	res := make([]*securityGroupRuleCheckEntry, 0, len(rules))
+	for direction, set := range map[string]*schema.Set{"ingress": ingress, "egress": egress} {
+		for _, r := range set.List() {
+			rule := r.(map[string]interface{})
+			if skipID != "" && rule["id"] == skipID {
+				continue
+			}
+			entry, _ := newSecurityGroupRuleCheckEntry(direction, rule)
+			res = append(res, entry)
+		}
+	}
+	return res
+}
+
+// reportSecurityGroupRulesProblems fails the plan in "error" mode and only logs the problems otherwise,
+// since SDKv2 can't attach warnings to a plan. In "warn" mode they are reported on apply.
+func reportSecurityGroupRulesProblems(resourceName string, problems []string, meta interface{}) error {
+	if len(problems) == 0 {
+		return nil
+	}
+
+	switch securityGroupRulesCheckMode(meta) {
+	case securityGroupRulesCheckError:
+		return fmt.Errorf("problems found in rules of %s:\n  - %s", resourceName, strings.Join(problems, "\n  - "))
+	case securityGroupRulesCheckWarn:
+		for _, p := range problems {
+			log.Printf("[WARN] %s: %s", resourceName, p)
+		}
+	}
+
+	return nil
+}
+
+func securityGroupRulesWarnings(problems []string, meta interface{}) diag.Diagnostics {
+	if securityGroupRulesCheckMode(meta) != securityGroupRulesCheckWarn {
+		return nil
+	}
+
+	var diags diag.Diagnostics
+	for _, p := range problems {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Security group rule problem",
+			Detail:   p,
+		})
+	}
+	return diags
+}
+
+func checkSecurityGroupRulesInConfig(d interface{ Get(string) interface{} }) []string {
+	rules, problems := securityGroupRulesFromSets(d.Get("ingress"), d.Get("egress"))
+	return append(problems, checkSecurityGroupRules(rules, nil)...)
+}
+
+func securityGroupRulesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if securityGroupRulesCheckMode(meta) == securityGroupRulesCheckOff {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("ingress") && !d.HasChange("egress") {
+		return nil
+	}
+	if !securityGroupRulesConfigKnown(d.GetRawConfig(), "ingress", "egress") {
+		log.Printf("[DEBUG] Skipping check of security group rules with values unknown until apply")
+		return nil
+	}
+
+	return reportSecurityGroupRulesProblems("security group", checkSecurityGroupRulesInConfig(d), meta)
+}
+
+func checkSecurityGroupRuleResource(ctx context.Context, d interface {
+	Get(string) interface{}
+	Id() string
+}, meta interface{}) []string {
+	rule := map[string]interface{}{}
+	for _, key := range []string{"protocol", "description", "port", "from_port", "to_port",
+		"v4_cidr_blocks", "v6_cidr_blocks", "security_group_id", "predefined_target"} {
+		rule[key] = d.Get(key)
+	}
+	entry, problems := newSecurityGroupRuleCheckEntry(d.Get("direction").(string), rule)
+	for i, p := range problems {
+		problems[i] = fmt.Sprintf("%s: %s", entry, p)
+	}
+
+	sgID := d.Get("security_group_binding").(string)
+	config, ok := meta.(*Config)
+	if sgID == "" || !ok || config.sdk == nil {
+		return problems
+	}
+
+	sg, err := config.sdk.VPC().SecurityGroup().Get(ctx, &vpc.GetSecurityGroupRequest{
+		SecurityGroupId: sgID,
+	})
+	if err != nil {
+		log.Printf("[DEBUG] Skipping check of rules against security group %q: %s", sgID, err)
+		return problems
+	}
+
+	return append(problems, checkSecurityGroupRules([]*securityGroupRuleCheckEntry{entry}, securityGroupRulesFromAPI(sg.GetRules(), d.Id()))...)
+}
+
+func securityGroupRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Problems are reported as warnings on apply in the "warn" mode, reading the security group
+	// on every plan is only worth it when the plan may fail.
+	if securityGroupRulesCheckMode(meta) != securityGroupRulesCheckError {
+		return nil
+	}
+	// all the other attributes force a new rule
+	if d.Id() != "" && !d.HasChanges("direction", "protocol", "port", "from_port", "to_port",
+		"v4_cidr_blocks", "v6_cidr_blocks", "security_group_id", "predefined_target", "security_group_binding") {
+		return nil
+	}
+	if !securityGroupRulesConfigKnown(d.GetRawConfig(), "protocol", "port", "from_port", "to_port",
+		"v4_cidr_blocks", "v6_cidr_blocks", "security_group_id", "predefined_target", "security_group_binding") {
+		log.Printf("[DEBUG] Skipping check of security group rule with values unknown until apply")
+		return nil
+	}
+
+	return reportSecurityGroupRulesProblems("security group rule", checkSecurityGroupRuleResource(ctx, d, meta), meta)
+}
+
+func securityGroupRulesConfigKnown(config cty.Value, attrs ...string) bool {
+	if config.IsNull() || !config.IsKnown() {
+		return true
+	}
+	for _, attr := range attrs {
+		if !config.GetAttr(attr).IsWhollyKnown() {
+			return false
+		}
+	}
+	return true
+}
+
+// withSecurityGroupRulesWarnings reports the problems found in rules as warnings after applying the changes.
+func withSecurityGroupRulesWarnings(f func(*schema.ResourceData, interface{}) error,
+	check func(context.Context, *schema.ResourceData, interface{}) []string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if securityGroupRulesCheckMode(meta) == securityGroupRulesCheckWarn {
+			diags = securityGroupRulesWarnings(check(ctx, d, meta), meta)
+		}
+
+		if err := f(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func checkSecurityGroupRulesInResourceData(ctx context.Context, d *schema.ResourceData, meta interface{}) []string {
+	if d.Id() != "" && !d.HasChange("ingress") && !d.HasChange("egress") {
+		return nil
+	}
+	return checkSecurityGroupRulesInConfig(d)
+}
+
+func checkSecurityGroupRuleResourceData(ctx context.Context, d *schema.ResourceData, meta interface{}) []string {
+	return checkSecurityGroupRuleResource(ctx, d, meta)
+}
@@ -0,0 +1,177 @@
+package yandex
+
+import (
+	"strings"
+	"testing"
+)
+
+func testSecurityGroupRuleCheckEntry(t *testing.T, direction string, rule map[string]interface{}) *securityGroupRuleCheckEntry {
+	for key, def := range map[string]interface{}{"port": -1, "from_port": -1, "to_port": -1} {
+		if _, ok := rule[key]; !ok {
+			rule[key] = def
+		}
+	}
+	e, problems := newSecurityGroupRuleCheckEntry(direction, rule)
+	if len(problems) > 0 {
+		t.Fatalf("unexpected problems in rule %v: %v", rule, problems)
+	}
+	return e
+}
+
+func TestNewSecurityGroupRuleCheckEntry(t *testing.T) {
+	cases := []struct {
+		name     string
+		rule     map[string]interface{}
+		problems []string
+		protocol string
+		fromPort int64
+		toPort   int64
+	}{
+		{
+			name:     "any protocol and port",
+			rule:     map[string]interface{}{"protocol": "any", "port": -1, "from_port": -1, "to_port": -1},
+			protocol: "ANY",
+			fromPort: 0,
+			toPort:   65535,
+		},
+		{
+			name:     "protocol number",
+			rule:     map[string]interface{}{"protocol": "6", "port": 443, "from_port": -1, "to_port": -1},
+			protocol: "TCP",
+			fromPort: 443,
+			toPort:   443,
+		},
+		{
+			name:     "inverted port range",
+			rule:     map[string]interface{}{"protocol": "UDP", "port": -1, "from_port": 200, "to_port": 100},
+			problems: []string{"greater than `to_port`"},
+			protocol: "UDP",
+			fromPort: 100,
+			toPort:   200,
+		},
+		{
+			name:     "port and range",
+			rule:     map[string]interface{}{"protocol": "TCP", "port": 22, "from_port": 1, "to_port": 100},
+			problems: []string{"cannot be set together"},
+			protocol: "TCP",
+			fromPort: 22,
+			toPort:   22,
+		},
+		{
+			name:     "ports with icmp",
+			rule:     map[string]interface{}{"protocol": "ICMP", "port": 8, "from_port": -1, "to_port": -1},
+			problems: []string{"cannot be used with protocol ICMP"},
+			protocol: "ICMP",
+			fromPort: 8,
+			toPort:   8,
+		},
+		{
+			name: "bad cidrs",
+			rule: map[string]interface{}{"protocol": "TCP", "port": -1, "from_port": -1, "to_port": -1,
+				"v4_cidr_blocks": []interface{}{"10.0.0.1/8", "10.0.0.0/8", "fd00::/8"},
+				"v6_cidr_blocks": []interface{}{"10.1.0.0/16"}},
+			problems: []string{"host bits set", "more than once", "invalid CIDR block \"fd00::/8\"", "invalid CIDR block \"10.1.0.0/16\""},
+			protocol: "TCP",
+			fromPort: 0,
+			toPort:   65535,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e, problems := newSecurityGroupRuleCheckEntry("ingress", tc.rule)
+			if len(problems) != len(tc.problems) {
+				t.Fatalf("newSecurityGroupRuleCheckEntry() problems = %v, want %d problems", problems, len(tc.problems))
+			}
+			for i, p := range tc.problems {
+				if !strings.Contains(problems[i], p) {
+					t.Errorf("problem %q does not contain %q", problems[i], p)
+				}
+			}
+			if e.protocol != tc.protocol || e.fromPort != tc.fromPort || e.toPort != tc.toPort {
+				t.Errorf("newSecurityGroupRuleCheckEntry() = %s %d-%d, want %s %d-%d",
+					e.protocol, e.fromPort, e.toPort, tc.protocol, tc.fromPort, tc.toPort)
+			}
+		})
+	}
+}
+
+func TestCheckSecurityGroupRules(t *testing.T) {
+	broad := testSecurityGroupRuleCheckEntry(t, "ingress", map[string]interface{}{
+		"protocol": "ANY", "v4_cidr_blocks": []interface{}{"10.0.0.0/8"},
+	})
+	narrow := testSecurityGroupRuleCheckEntry(t, "ingress", map[string]interface{}{
+		"protocol": "TCP", "port": 22, "v4_cidr_blocks": []interface{}{"10.1.0.0/16"},
+	})
+	duplicate := testSecurityGroupRuleCheckEntry(t, "ingress", map[string]interface{}{
+		"protocol": "tcp", "from_port": 22, "to_port": 22, "v4_cidr_blocks": []interface{}{"10.1.0.0/16"},
+		"description": "ssh",
+	})
+	egress := testSecurityGroupRuleCheckEntry(t, "egress", map[string]interface{}{
+		"protocol": "TCP", "port": 22, "v4_cidr_blocks": []interface{}{"10.1.0.0/16"},
+	})
+	outside := testSecurityGroupRuleCheckEntry(t, "ingress", map[string]interface{}{
+		"protocol": "TCP", "port": 22, "v4_cidr_blocks": []interface{}{"10.1.0.0/16", "192.168.0.0/16"},
+	})
+	redundant := testSecurityGroupRuleCheckEntry(t, "ingress", map[string]interface{}{
+		"protocol": "ANY", "v4_cidr_blocks": []interface{}{"10.0.0.0/8", "10.1.0.0/16"},
+	})
+	sgRule := testSecurityGroupRuleCheckEntry(t, "ingress", map[string]interface{}{
+		"protocol": "ANY", "security_group_id": "sg1",
+	})
+	sgNarrow := testSecurityGroupRuleCheckEntry(t, "ingress", map[string]interface{}{
+		"protocol": "TCP", "port": 80, "security_group_id": "sg1",
+	})
+
+	cases := []struct {
+		name     string
+		rules    []*securityGroupRuleCheckEntry
+		existing []*securityGroupRuleCheckEntry
+		problems []string
+	}{
+		{
+			name:  "no problems",
+			rules: []*securityGroupRuleCheckEntry{broad, egress, outside, sgRule},
+		},
+		{
+			name:     "shadowed",
+			rules:    []*securityGroupRuleCheckEntry{broad, narrow},
+			problems: []string{"shadowed by broader"},
+		},
+		{
+			name:     "duplicate",
+			rules:    []*securityGroupRuleCheckEntry{narrow, duplicate},
+			problems: []string{"duplicates"},
+		},
+		{
+			name:     "shadowing each other",
+			rules:    []*securityGroupRuleCheckEntry{broad, redundant},
+			problems: []string{"matches the same traffic as"},
+		},
+		{
+			name:     "shadowed by security group rule",
+			rules:    []*securityGroupRuleCheckEntry{sgNarrow},
+			existing: []*securityGroupRuleCheckEntry{sgRule},
+			problems: []string{"shadowed by broader"},
+		},
+		{
+			name:     "existing rules are not checked",
+			rules:    []*securityGroupRuleCheckEntry{broad},
+			existing: []*securityGroupRuleCheckEntry{narrow},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			problems := checkSecurityGroupRules(tc.rules, tc.existing)
+			if len(problems) != len(tc.problems) {
+				t.Fatalf("checkSecurityGroupRules() = %v, want %d problems", problems, len(tc.problems))
+			}
+			for i, p := range tc.problems {
+				if !strings.Contains(problems[i], p) {
+					t.Errorf("problem %q does not contain %q", problems[i], p)
+				}
+			}
+		})
+	}
+}